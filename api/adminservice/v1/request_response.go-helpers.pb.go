@@ -177,6 +177,524 @@ func (this *ImportWorkflowExecutionResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type RestoreWorkflowExecutionFromArchiveRequest to the protobuf v3 wire format
+func (val *RestoreWorkflowExecutionFromArchiveRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RestoreWorkflowExecutionFromArchiveRequest from the protobuf v3 wire format
+func (val *RestoreWorkflowExecutionFromArchiveRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RestoreWorkflowExecutionFromArchiveRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RestoreWorkflowExecutionFromArchiveRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RestoreWorkflowExecutionFromArchiveRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RestoreWorkflowExecutionFromArchiveRequest
+	switch t := that.(type) {
+	case *RestoreWorkflowExecutionFromArchiveRequest:
+		that1 = t
+	case RestoreWorkflowExecutionFromArchiveRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RestoreWorkflowExecutionFromArchiveResponse to the protobuf v3 wire format
+func (val *RestoreWorkflowExecutionFromArchiveResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RestoreWorkflowExecutionFromArchiveResponse from the protobuf v3 wire format
+func (val *RestoreWorkflowExecutionFromArchiveResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RestoreWorkflowExecutionFromArchiveResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RestoreWorkflowExecutionFromArchiveResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RestoreWorkflowExecutionFromArchiveResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RestoreWorkflowExecutionFromArchiveResponse
+	switch t := that.(type) {
+	case *RestoreWorkflowExecutionFromArchiveResponse:
+		that1 = t
+	case RestoreWorkflowExecutionFromArchiveResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PreviewScheduleBackfillRequest to the protobuf v3 wire format
+func (val *PreviewScheduleBackfillRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PreviewScheduleBackfillRequest from the protobuf v3 wire format
+func (val *PreviewScheduleBackfillRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PreviewScheduleBackfillRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PreviewScheduleBackfillRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PreviewScheduleBackfillRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PreviewScheduleBackfillRequest
+	switch t := that.(type) {
+	case *PreviewScheduleBackfillRequest:
+		that1 = t
+	case PreviewScheduleBackfillRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PreviewScheduleBackfillResponse to the protobuf v3 wire format
+func (val *PreviewScheduleBackfillResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PreviewScheduleBackfillResponse from the protobuf v3 wire format
+func (val *PreviewScheduleBackfillResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PreviewScheduleBackfillResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PreviewScheduleBackfillResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PreviewScheduleBackfillResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PreviewScheduleBackfillResponse
+	switch t := that.(type) {
+	case *PreviewScheduleBackfillResponse:
+		that1 = t
+	case PreviewScheduleBackfillResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeScheduleRunHistoryRequest to the protobuf v3 wire format
+func (val *DescribeScheduleRunHistoryRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeScheduleRunHistoryRequest from the protobuf v3 wire format
+func (val *DescribeScheduleRunHistoryRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeScheduleRunHistoryRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeScheduleRunHistoryRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeScheduleRunHistoryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeScheduleRunHistoryRequest
+	switch t := that.(type) {
+	case *DescribeScheduleRunHistoryRequest:
+		that1 = t
+	case DescribeScheduleRunHistoryRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeScheduleRunHistoryResponse to the protobuf v3 wire format
+func (val *DescribeScheduleRunHistoryResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeScheduleRunHistoryResponse from the protobuf v3 wire format
+func (val *DescribeScheduleRunHistoryResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeScheduleRunHistoryResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeScheduleRunHistoryResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeScheduleRunHistoryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeScheduleRunHistoryResponse
+	switch t := that.(type) {
+	case *DescribeScheduleRunHistoryResponse:
+		that1 = t
+	case DescribeScheduleRunHistoryResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeTaskQueuePartitionRequest to the protobuf v3 wire format
+func (val *DescribeTaskQueuePartitionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeTaskQueuePartitionRequest from the protobuf v3 wire format
+func (val *DescribeTaskQueuePartitionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeTaskQueuePartitionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeTaskQueuePartitionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeTaskQueuePartitionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeTaskQueuePartitionRequest
+	switch t := that.(type) {
+	case *DescribeTaskQueuePartitionRequest:
+		that1 = t
+	case DescribeTaskQueuePartitionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeTaskQueuePartitionResponse to the protobuf v3 wire format
+func (val *DescribeTaskQueuePartitionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeTaskQueuePartitionResponse from the protobuf v3 wire format
+func (val *DescribeTaskQueuePartitionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeTaskQueuePartitionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeTaskQueuePartitionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeTaskQueuePartitionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeTaskQueuePartitionResponse
+	switch t := that.(type) {
+	case *DescribeTaskQueuePartitionResponse:
+		that1 = t
+	case DescribeTaskQueuePartitionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeTaskQueueBacklogRequest to the protobuf v3 wire format
+func (val *DescribeTaskQueueBacklogRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeTaskQueueBacklogRequest from the protobuf v3 wire format
+func (val *DescribeTaskQueueBacklogRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeTaskQueueBacklogRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeTaskQueueBacklogRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeTaskQueueBacklogRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeTaskQueueBacklogRequest
+	switch t := that.(type) {
+	case *DescribeTaskQueueBacklogRequest:
+		that1 = t
+	case DescribeTaskQueueBacklogRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeTaskQueueBacklogResponse to the protobuf v3 wire format
+func (val *DescribeTaskQueueBacklogResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeTaskQueueBacklogResponse from the protobuf v3 wire format
+func (val *DescribeTaskQueueBacklogResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeTaskQueueBacklogResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeTaskQueueBacklogResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeTaskQueueBacklogResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeTaskQueueBacklogResponse
+	switch t := that.(type) {
+	case *DescribeTaskQueueBacklogResponse:
+		that1 = t
+	case DescribeTaskQueueBacklogResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PauseTaskQueueRequest to the protobuf v3 wire format
+func (val *PauseTaskQueueRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseTaskQueueRequest from the protobuf v3 wire format
+func (val *PauseTaskQueueRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseTaskQueueRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseTaskQueueRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseTaskQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseTaskQueueRequest
+	switch t := that.(type) {
+	case *PauseTaskQueueRequest:
+		that1 = t
+	case PauseTaskQueueRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PauseTaskQueueResponse to the protobuf v3 wire format
+func (val *PauseTaskQueueResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseTaskQueueResponse from the protobuf v3 wire format
+func (val *PauseTaskQueueResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseTaskQueueResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseTaskQueueResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseTaskQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseTaskQueueResponse
+	switch t := that.(type) {
+	case *PauseTaskQueueResponse:
+		that1 = t
+	case PauseTaskQueueResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResumeTaskQueueRequest to the protobuf v3 wire format
+func (val *ResumeTaskQueueRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResumeTaskQueueRequest from the protobuf v3 wire format
+func (val *ResumeTaskQueueRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResumeTaskQueueRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResumeTaskQueueRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResumeTaskQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResumeTaskQueueRequest
+	switch t := that.(type) {
+	case *ResumeTaskQueueRequest:
+		that1 = t
+	case ResumeTaskQueueRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResumeTaskQueueResponse to the protobuf v3 wire format
+func (val *ResumeTaskQueueResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResumeTaskQueueResponse from the protobuf v3 wire format
+func (val *ResumeTaskQueueResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResumeTaskQueueResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResumeTaskQueueResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResumeTaskQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResumeTaskQueueResponse
+	switch t := that.(type) {
+	case *ResumeTaskQueueResponse:
+		that1 = t
+	case ResumeTaskQueueResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type DescribeMutableStateRequest to the protobuf v3 wire format
 func (val *DescribeMutableStateRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -42,43 +42,50 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AdminService_RebuildMutableState_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/RebuildMutableState"
-	AdminService_ImportWorkflowExecution_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution"
-	AdminService_DescribeMutableState_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState"
-	AdminService_DescribeHistoryHost_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost"
-	AdminService_GetShard_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/GetShard"
-	AdminService_CloseShard_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/CloseShard"
-	AdminService_ListHistoryTasks_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/ListHistoryTasks"
-	AdminService_RemoveTask_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/RemoveTask"
-	AdminService_GetWorkflowExecutionRawHistoryV2_FullMethodName  = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2"
-	AdminService_GetReplicationMessages_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/GetReplicationMessages"
-	AdminService_GetNamespaceReplicationMessages_FullMethodName   = "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceReplicationMessages"
-	AdminService_GetDLQReplicationMessages_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/GetDLQReplicationMessages"
-	AdminService_ReapplyEvents_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/ReapplyEvents"
-	AdminService_AddSearchAttributes_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/AddSearchAttributes"
-	AdminService_RemoveSearchAttributes_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/RemoveSearchAttributes"
-	AdminService_GetSearchAttributes_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/GetSearchAttributes"
-	AdminService_DescribeCluster_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/DescribeCluster"
-	AdminService_ListClusters_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/ListClusters"
-	AdminService_ListClusterMembers_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/ListClusterMembers"
-	AdminService_AddOrUpdateRemoteCluster_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/AddOrUpdateRemoteCluster"
-	AdminService_RemoveRemoteCluster_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/RemoveRemoteCluster"
-	AdminService_GetDLQMessages_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/GetDLQMessages"
-	AdminService_PurgeDLQMessages_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQMessages"
-	AdminService_MergeDLQMessages_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQMessages"
-	AdminService_RefreshWorkflowTasks_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/RefreshWorkflowTasks"
-	AdminService_ResendReplicationTasks_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks"
-	AdminService_GetTaskQueueTasks_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueTasks"
-	AdminService_DeleteWorkflowExecution_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution"
-	AdminService_StreamWorkflowReplicationMessages_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/StreamWorkflowReplicationMessages"
-	AdminService_GetNamespace_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/GetNamespace"
-	AdminService_GetDLQTasks_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/GetDLQTasks"
-	AdminService_PurgeDLQTasks_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQTasks"
-	AdminService_MergeDLQTasks_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQTasks"
-	AdminService_DescribeDLQJob_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/DescribeDLQJob"
-	AdminService_CancelDLQJob_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/CancelDLQJob"
-	AdminService_AddTasks_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/AddTasks"
-	AdminService_ListQueues_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/ListQueues"
+	AdminService_RebuildMutableState_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/RebuildMutableState"
+	AdminService_ImportWorkflowExecution_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution"
+	AdminService_RestoreWorkflowExecutionFromArchive_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/RestoreWorkflowExecutionFromArchive"
+	AdminService_PreviewScheduleBackfill_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/PreviewScheduleBackfill"
+	AdminService_DescribeScheduleRunHistory_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/DescribeScheduleRunHistory"
+	AdminService_DescribeTaskQueuePartition_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartition"
+	AdminService_DescribeTaskQueueBacklog_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueueBacklog"
+	AdminService_PauseTaskQueue_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/PauseTaskQueue"
+	AdminService_ResumeTaskQueue_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/ResumeTaskQueue"
+	AdminService_DescribeMutableState_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState"
+	AdminService_DescribeHistoryHost_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost"
+	AdminService_GetShard_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/GetShard"
+	AdminService_CloseShard_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/CloseShard"
+	AdminService_ListHistoryTasks_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/ListHistoryTasks"
+	AdminService_RemoveTask_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/RemoveTask"
+	AdminService_GetWorkflowExecutionRawHistoryV2_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2"
+	AdminService_GetReplicationMessages_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/GetReplicationMessages"
+	AdminService_GetNamespaceReplicationMessages_FullMethodName     = "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceReplicationMessages"
+	AdminService_GetDLQReplicationMessages_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/GetDLQReplicationMessages"
+	AdminService_ReapplyEvents_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/ReapplyEvents"
+	AdminService_AddSearchAttributes_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/AddSearchAttributes"
+	AdminService_RemoveSearchAttributes_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/RemoveSearchAttributes"
+	AdminService_GetSearchAttributes_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/GetSearchAttributes"
+	AdminService_DescribeCluster_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/DescribeCluster"
+	AdminService_ListClusters_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/ListClusters"
+	AdminService_ListClusterMembers_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/ListClusterMembers"
+	AdminService_AddOrUpdateRemoteCluster_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/AddOrUpdateRemoteCluster"
+	AdminService_RemoveRemoteCluster_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/RemoveRemoteCluster"
+	AdminService_GetDLQMessages_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/GetDLQMessages"
+	AdminService_PurgeDLQMessages_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQMessages"
+	AdminService_MergeDLQMessages_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQMessages"
+	AdminService_RefreshWorkflowTasks_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/RefreshWorkflowTasks"
+	AdminService_ResendReplicationTasks_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks"
+	AdminService_GetTaskQueueTasks_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueTasks"
+	AdminService_DeleteWorkflowExecution_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution"
+	AdminService_StreamWorkflowReplicationMessages_FullMethodName   = "/temporal.server.api.adminservice.v1.AdminService/StreamWorkflowReplicationMessages"
+	AdminService_GetNamespace_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/GetNamespace"
+	AdminService_GetDLQTasks_FullMethodName                         = "/temporal.server.api.adminservice.v1.AdminService/GetDLQTasks"
+	AdminService_PurgeDLQTasks_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQTasks"
+	AdminService_MergeDLQTasks_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQTasks"
+	AdminService_DescribeDLQJob_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/DescribeDLQJob"
+	AdminService_CancelDLQJob_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/CancelDLQJob"
+	AdminService_AddTasks_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/AddTasks"
+	AdminService_ListQueues_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/ListQueues"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -91,6 +98,28 @@ type AdminServiceClient interface {
 	// ImportWorkflowExecution attempts to import workflow according to persisted history events.
 	// NOTE: this is experimental API
 	ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error)
+	// RestoreWorkflowExecutionFromArchive reads the history of a closed workflow execution from the history archival URI
+	// of its namespace and imports it back into the persistence store. A large history is restored over several calls,
+	// each continuing with the next_page_token of the previous response. The retention of the restored workflow
+	// starts when the restore completes.
+	// NOTE: this is experimental API
+	RestoreWorkflowExecutionFromArchive(ctx context.Context, in *RestoreWorkflowExecutionFromArchiveRequest, opts ...grpc.CallOption) (*RestoreWorkflowExecutionFromArchiveResponse, error)
+	// PreviewScheduleBackfill returns the actions a backfill of a schedule would take, and what the overlap
+	// policy would do with each of them, without starting any workflows.
+	PreviewScheduleBackfill(ctx context.Context, in *PreviewScheduleBackfillRequest, opts ...grpc.CallOption) (*PreviewScheduleBackfillResponse, error)
+	// DescribeScheduleRunHistory returns the runs recently started by a schedule, with their final status.
+	DescribeScheduleRunHistory(ctx context.Context, in *DescribeScheduleRunHistoryRequest, opts ...grpc.CallOption) (*DescribeScheduleRunHistoryResponse, error)
+	// DescribeTaskQueuePartition returns the internal state of one task queue partition, including
+	// its backlog by priority and by fairness key.
+	DescribeTaskQueuePartition(ctx context.Context, in *DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionResponse, error)
+	// DescribeTaskQueueBacklog returns approximate backlog statistics of a task queue across all
+	// of its partitions, optionally broken down by build ID.
+	DescribeTaskQueueBacklog(ctx context.Context, in *DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*DescribeTaskQueueBacklogResponse, error)
+	// PauseTaskQueue stops dispatching tasks of a task queue to pollers, optionally only to pollers of a single
+	// build ID. New tasks are persisted to the backlog until the task queue is resumed.
+	PauseTaskQueue(ctx context.Context, in *PauseTaskQueueRequest, opts ...grpc.CallOption) (*PauseTaskQueueResponse, error)
+	// ResumeTaskQueue resumes dispatching tasks of a paused task queue.
+	ResumeTaskQueue(ctx context.Context, in *ResumeTaskQueueRequest, opts ...grpc.CallOption) (*ResumeTaskQueueResponse, error)
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
 	DescribeMutableState(ctx context.Context, in *DescribeMutableStateRequest, opts ...grpc.CallOption) (*DescribeMutableStateResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host
@@ -189,6 +218,69 @@ func (c *adminServiceClient) ImportWorkflowExecution(ctx context.Context, in *Im
 	return out, nil
 }
 
+func (c *adminServiceClient) RestoreWorkflowExecutionFromArchive(ctx context.Context, in *RestoreWorkflowExecutionFromArchiveRequest, opts ...grpc.CallOption) (*RestoreWorkflowExecutionFromArchiveResponse, error) {
+	out := new(RestoreWorkflowExecutionFromArchiveResponse)
+	err := c.cc.Invoke(ctx, AdminService_RestoreWorkflowExecutionFromArchive_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PreviewScheduleBackfill(ctx context.Context, in *PreviewScheduleBackfillRequest, opts ...grpc.CallOption) (*PreviewScheduleBackfillResponse, error) {
+	out := new(PreviewScheduleBackfillResponse)
+	err := c.cc.Invoke(ctx, AdminService_PreviewScheduleBackfill_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeScheduleRunHistory(ctx context.Context, in *DescribeScheduleRunHistoryRequest, opts ...grpc.CallOption) (*DescribeScheduleRunHistoryResponse, error) {
+	out := new(DescribeScheduleRunHistoryResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeScheduleRunHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeTaskQueuePartition(ctx context.Context, in *DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionResponse, error) {
+	out := new(DescribeTaskQueuePartitionResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeTaskQueuePartition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeTaskQueueBacklog(ctx context.Context, in *DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*DescribeTaskQueueBacklogResponse, error) {
+	out := new(DescribeTaskQueueBacklogResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeTaskQueueBacklog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PauseTaskQueue(ctx context.Context, in *PauseTaskQueueRequest, opts ...grpc.CallOption) (*PauseTaskQueueResponse, error) {
+	out := new(PauseTaskQueueResponse)
+	err := c.cc.Invoke(ctx, AdminService_PauseTaskQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResumeTaskQueue(ctx context.Context, in *ResumeTaskQueueRequest, opts ...grpc.CallOption) (*ResumeTaskQueueResponse, error) {
+	out := new(ResumeTaskQueueResponse)
+	err := c.cc.Invoke(ctx, AdminService_ResumeTaskQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DescribeMutableState(ctx context.Context, in *DescribeMutableStateRequest, opts ...grpc.CallOption) (*DescribeMutableStateResponse, error) {
 	out := new(DescribeMutableStateResponse)
 	err := c.cc.Invoke(ctx, AdminService_DescribeMutableState_FullMethodName, in, out, opts...)
@@ -536,6 +628,28 @@ type AdminServiceServer interface {
 	// ImportWorkflowExecution attempts to import workflow according to persisted history events.
 	// NOTE: this is experimental API
 	ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error)
+	// RestoreWorkflowExecutionFromArchive reads the history of a closed workflow execution from the history archival URI
+	// of its namespace and imports it back into the persistence store. A large history is restored over several calls,
+	// each continuing with the next_page_token of the previous response. The retention of the restored workflow
+	// starts when the restore completes.
+	// NOTE: this is experimental API
+	RestoreWorkflowExecutionFromArchive(context.Context, *RestoreWorkflowExecutionFromArchiveRequest) (*RestoreWorkflowExecutionFromArchiveResponse, error)
+	// PreviewScheduleBackfill returns the actions a backfill of a schedule would take, and what the overlap
+	// policy would do with each of them, without starting any workflows.
+	PreviewScheduleBackfill(context.Context, *PreviewScheduleBackfillRequest) (*PreviewScheduleBackfillResponse, error)
+	// DescribeScheduleRunHistory returns the runs recently started by a schedule, with their final status.
+	DescribeScheduleRunHistory(context.Context, *DescribeScheduleRunHistoryRequest) (*DescribeScheduleRunHistoryResponse, error)
+	// DescribeTaskQueuePartition returns the internal state of one task queue partition, including
+	// its backlog by priority and by fairness key.
+	DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error)
+	// DescribeTaskQueueBacklog returns approximate backlog statistics of a task queue across all
+	// of its partitions, optionally broken down by build ID.
+	DescribeTaskQueueBacklog(context.Context, *DescribeTaskQueueBacklogRequest) (*DescribeTaskQueueBacklogResponse, error)
+	// PauseTaskQueue stops dispatching tasks of a task queue to pollers, optionally only to pollers of a single
+	// build ID. New tasks are persisted to the backlog until the task queue is resumed.
+	PauseTaskQueue(context.Context, *PauseTaskQueueRequest) (*PauseTaskQueueResponse, error)
+	// ResumeTaskQueue resumes dispatching tasks of a paused task queue.
+	ResumeTaskQueue(context.Context, *ResumeTaskQueueRequest) (*ResumeTaskQueueResponse, error)
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
 	DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host
@@ -619,6 +733,27 @@ func (UnimplementedAdminServiceServer) RebuildMutableState(context.Context, *Reb
 func (UnimplementedAdminServiceServer) ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportWorkflowExecution not implemented")
 }
+func (UnimplementedAdminServiceServer) RestoreWorkflowExecutionFromArchive(context.Context, *RestoreWorkflowExecutionFromArchiveRequest) (*RestoreWorkflowExecutionFromArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreWorkflowExecutionFromArchive not implemented")
+}
+func (UnimplementedAdminServiceServer) PreviewScheduleBackfill(context.Context, *PreviewScheduleBackfillRequest) (*PreviewScheduleBackfillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewScheduleBackfill not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeScheduleRunHistory(context.Context, *DescribeScheduleRunHistoryRequest) (*DescribeScheduleRunHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeScheduleRunHistory not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueuePartition not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeTaskQueueBacklog(context.Context, *DescribeTaskQueueBacklogRequest) (*DescribeTaskQueueBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueueBacklog not implemented")
+}
+func (UnimplementedAdminServiceServer) PauseTaskQueue(context.Context, *PauseTaskQueueRequest) (*PauseTaskQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTaskQueue not implemented")
+}
+func (UnimplementedAdminServiceServer) ResumeTaskQueue(context.Context, *ResumeTaskQueueRequest) (*ResumeTaskQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTaskQueue not implemented")
+}
 func (UnimplementedAdminServiceServer) DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeMutableState not implemented")
 }
@@ -773,6 +908,132 @@ func _AdminService_ImportWorkflowExecution_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_RestoreWorkflowExecutionFromArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreWorkflowExecutionFromArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreWorkflowExecutionFromArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RestoreWorkflowExecutionFromArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreWorkflowExecutionFromArchive(ctx, req.(*RestoreWorkflowExecutionFromArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PreviewScheduleBackfill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewScheduleBackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PreviewScheduleBackfill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PreviewScheduleBackfill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PreviewScheduleBackfill(ctx, req.(*PreviewScheduleBackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeScheduleRunHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeScheduleRunHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeScheduleRunHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeScheduleRunHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeScheduleRunHistory(ctx, req.(*DescribeScheduleRunHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeTaskQueuePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskQueuePartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeTaskQueuePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeTaskQueuePartition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeTaskQueuePartition(ctx, req.(*DescribeTaskQueuePartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeTaskQueueBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskQueueBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeTaskQueueBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeTaskQueueBacklog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeTaskQueueBacklog(ctx, req.(*DescribeTaskQueueBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PauseTaskQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseTaskQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseTaskQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PauseTaskQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseTaskQueue(ctx, req.(*PauseTaskQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResumeTaskQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTaskQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResumeTaskQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResumeTaskQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResumeTaskQueue(ctx, req.(*ResumeTaskQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_DescribeMutableState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeMutableStateRequest)
 	if err := dec(in); err != nil {
@@ -1426,6 +1687,34 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportWorkflowExecution",
 			Handler:    _AdminService_ImportWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "RestoreWorkflowExecutionFromArchive",
+			Handler:    _AdminService_RestoreWorkflowExecutionFromArchive_Handler,
+		},
+		{
+			MethodName: "PreviewScheduleBackfill",
+			Handler:    _AdminService_PreviewScheduleBackfill_Handler,
+		},
+		{
+			MethodName: "DescribeScheduleRunHistory",
+			Handler:    _AdminService_DescribeScheduleRunHistory_Handler,
+		},
+		{
+			MethodName: "DescribeTaskQueuePartition",
+			Handler:    _AdminService_DescribeTaskQueuePartition_Handler,
+		},
+		{
+			MethodName: "DescribeTaskQueueBacklog",
+			Handler:    _AdminService_DescribeTaskQueueBacklog_Handler,
+		},
+		{
+			MethodName: "PauseTaskQueue",
+			Handler:    _AdminService_PauseTaskQueue_Handler,
+		},
+		{
+			MethodName: "ResumeTaskQueue",
+			Handler:    _AdminService_ResumeTaskQueue_Handler,
+		},
 		{
 			MethodName: "DescribeMutableState",
 			Handler:    _AdminService_DescribeMutableState_Handler,
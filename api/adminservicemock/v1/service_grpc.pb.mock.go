@@ -261,6 +261,66 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeScheduleRunHistory mocks base method.
+func (m *MockAdminServiceClient) DescribeScheduleRunHistory(ctx context.Context, in *adminservice.DescribeScheduleRunHistoryRequest, opts ...grpc.CallOption) (*adminservice.DescribeScheduleRunHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeScheduleRunHistory", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeScheduleRunHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeScheduleRunHistory indicates an expected call of DescribeScheduleRunHistory.
+func (mr *MockAdminServiceClientMockRecorder) DescribeScheduleRunHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScheduleRunHistory", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeScheduleRunHistory), varargs...)
+}
+
+// DescribeTaskQueueBacklog mocks base method.
+func (m *MockAdminServiceClient) DescribeTaskQueueBacklog(ctx context.Context, in *adminservice.DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskQueueBacklog", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueueBacklog indicates an expected call of DescribeTaskQueueBacklog.
+func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueueBacklog(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueBacklog", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueueBacklog), varargs...)
+}
+
+// DescribeTaskQueuePartition mocks base method.
+func (m *MockAdminServiceClient) DescribeTaskQueuePartition(ctx context.Context, in *adminservice.DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskQueuePartition", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueuePartitionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueuePartition indicates an expected call of DescribeTaskQueuePartition.
+func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueuePartition(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueuePartition), varargs...)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceClient) GetDLQMessages(ctx context.Context, in *adminservice.GetDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -601,6 +661,46 @@ func (mr *MockAdminServiceClientMockRecorder) MergeDLQTasks(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQTasks), varargs...)
 }
 
+// PauseTaskQueue mocks base method.
+func (m *MockAdminServiceClient) PauseTaskQueue(ctx context.Context, in *adminservice.PauseTaskQueueRequest, opts ...grpc.CallOption) (*adminservice.PauseTaskQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseTaskQueue", varargs...)
+	ret0, _ := ret[0].(*adminservice.PauseTaskQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseTaskQueue indicates an expected call of PauseTaskQueue.
+func (mr *MockAdminServiceClientMockRecorder) PauseTaskQueue(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseTaskQueue", reflect.TypeOf((*MockAdminServiceClient)(nil).PauseTaskQueue), varargs...)
+}
+
+// PreviewScheduleBackfill mocks base method.
+func (m *MockAdminServiceClient) PreviewScheduleBackfill(ctx context.Context, in *adminservice.PreviewScheduleBackfillRequest, opts ...grpc.CallOption) (*adminservice.PreviewScheduleBackfillResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PreviewScheduleBackfill", varargs...)
+	ret0, _ := ret[0].(*adminservice.PreviewScheduleBackfillResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewScheduleBackfill indicates an expected call of PreviewScheduleBackfill.
+func (mr *MockAdminServiceClientMockRecorder) PreviewScheduleBackfill(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewScheduleBackfill", reflect.TypeOf((*MockAdminServiceClient)(nil).PreviewScheduleBackfill), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -781,6 +881,46 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// RestoreWorkflowExecutionFromArchive mocks base method.
+func (m *MockAdminServiceClient) RestoreWorkflowExecutionFromArchive(ctx context.Context, in *adminservice.RestoreWorkflowExecutionFromArchiveRequest, opts ...grpc.CallOption) (*adminservice.RestoreWorkflowExecutionFromArchiveResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreWorkflowExecutionFromArchive", varargs...)
+	ret0, _ := ret[0].(*adminservice.RestoreWorkflowExecutionFromArchiveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreWorkflowExecutionFromArchive indicates an expected call of RestoreWorkflowExecutionFromArchive.
+func (mr *MockAdminServiceClientMockRecorder) RestoreWorkflowExecutionFromArchive(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkflowExecutionFromArchive", reflect.TypeOf((*MockAdminServiceClient)(nil).RestoreWorkflowExecutionFromArchive), varargs...)
+}
+
+// ResumeTaskQueue mocks base method.
+func (m *MockAdminServiceClient) ResumeTaskQueue(ctx context.Context, in *adminservice.ResumeTaskQueueRequest, opts ...grpc.CallOption) (*adminservice.ResumeTaskQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeTaskQueue", varargs...)
+	ret0, _ := ret[0].(*adminservice.ResumeTaskQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeTaskQueue indicates an expected call of ResumeTaskQueue.
+func (mr *MockAdminServiceClientMockRecorder) ResumeTaskQueue(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTaskQueue", reflect.TypeOf((*MockAdminServiceClient)(nil).ResumeTaskQueue), varargs...)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
@@ -1111,6 +1251,51 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeScheduleRunHistory mocks base method.
+func (m *MockAdminServiceServer) DescribeScheduleRunHistory(arg0 context.Context, arg1 *adminservice.DescribeScheduleRunHistoryRequest) (*adminservice.DescribeScheduleRunHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeScheduleRunHistory", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeScheduleRunHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeScheduleRunHistory indicates an expected call of DescribeScheduleRunHistory.
+func (mr *MockAdminServiceServerMockRecorder) DescribeScheduleRunHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScheduleRunHistory", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeScheduleRunHistory), arg0, arg1)
+}
+
+// DescribeTaskQueueBacklog mocks base method.
+func (m *MockAdminServiceServer) DescribeTaskQueueBacklog(arg0 context.Context, arg1 *adminservice.DescribeTaskQueueBacklogRequest) (*adminservice.DescribeTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskQueueBacklog", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueueBacklog indicates an expected call of DescribeTaskQueueBacklog.
+func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueueBacklog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueBacklog", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueueBacklog), arg0, arg1)
+}
+
+// DescribeTaskQueuePartition mocks base method.
+func (m *MockAdminServiceServer) DescribeTaskQueuePartition(arg0 context.Context, arg1 *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskQueuePartition", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueuePartitionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueuePartition indicates an expected call of DescribeTaskQueuePartition.
+func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueuePartition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueuePartition), arg0, arg1)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceServer) GetDLQMessages(arg0 context.Context, arg1 *adminservice.GetDLQMessagesRequest) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1366,6 +1551,36 @@ func (mr *MockAdminServiceServerMockRecorder) MergeDLQTasks(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQTasks), arg0, arg1)
 }
 
+// PauseTaskQueue mocks base method.
+func (m *MockAdminServiceServer) PauseTaskQueue(arg0 context.Context, arg1 *adminservice.PauseTaskQueueRequest) (*adminservice.PauseTaskQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseTaskQueue", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PauseTaskQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseTaskQueue indicates an expected call of PauseTaskQueue.
+func (mr *MockAdminServiceServerMockRecorder) PauseTaskQueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseTaskQueue", reflect.TypeOf((*MockAdminServiceServer)(nil).PauseTaskQueue), arg0, arg1)
+}
+
+// PreviewScheduleBackfill mocks base method.
+func (m *MockAdminServiceServer) PreviewScheduleBackfill(arg0 context.Context, arg1 *adminservice.PreviewScheduleBackfillRequest) (*adminservice.PreviewScheduleBackfillResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewScheduleBackfill", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PreviewScheduleBackfillResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewScheduleBackfill indicates an expected call of PreviewScheduleBackfill.
+func (mr *MockAdminServiceServerMockRecorder) PreviewScheduleBackfill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewScheduleBackfill", reflect.TypeOf((*MockAdminServiceServer)(nil).PreviewScheduleBackfill), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1501,6 +1716,36 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// RestoreWorkflowExecutionFromArchive mocks base method.
+func (m *MockAdminServiceServer) RestoreWorkflowExecutionFromArchive(arg0 context.Context, arg1 *adminservice.RestoreWorkflowExecutionFromArchiveRequest) (*adminservice.RestoreWorkflowExecutionFromArchiveResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreWorkflowExecutionFromArchive", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RestoreWorkflowExecutionFromArchiveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreWorkflowExecutionFromArchive indicates an expected call of RestoreWorkflowExecutionFromArchive.
+func (mr *MockAdminServiceServerMockRecorder) RestoreWorkflowExecutionFromArchive(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkflowExecutionFromArchive", reflect.TypeOf((*MockAdminServiceServer)(nil).RestoreWorkflowExecutionFromArchive), arg0, arg1)
+}
+
+// ResumeTaskQueue mocks base method.
+func (m *MockAdminServiceServer) ResumeTaskQueue(arg0 context.Context, arg1 *adminservice.ResumeTaskQueueRequest) (*adminservice.ResumeTaskQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeTaskQueue", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ResumeTaskQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeTaskQueue indicates an expected call of ResumeTaskQueue.
+func (mr *MockAdminServiceServerMockRecorder) ResumeTaskQueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTaskQueue", reflect.TypeOf((*MockAdminServiceServer)(nil).ResumeTaskQueue), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
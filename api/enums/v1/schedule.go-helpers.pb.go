@@ -0,0 +1,54 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-go-helpers. DO NOT EDIT.
+package enums
+
+import (
+	"fmt"
+)
+
+var (
+	BackfillPreviewOutcome_shorthandValue = map[string]int32{
+		"Unspecified":      0,
+		"Start":            1,
+		"StartOverlapping": 2,
+		"Buffer":           3,
+		"Skip":             4,
+		"CancelOther":      5,
+		"TerminateOther":   6,
+		"DropBufferFull":   7,
+	}
+)
+
+// BackfillPreviewOutcomeFromString parses a BackfillPreviewOutcome value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to BackfillPreviewOutcome
+func BackfillPreviewOutcomeFromString(s string) (BackfillPreviewOutcome, error) {
+	if v, ok := BackfillPreviewOutcome_value[s]; ok {
+		return BackfillPreviewOutcome(v), nil
+	} else if v, ok := BackfillPreviewOutcome_shorthandValue[s]; ok {
+		return BackfillPreviewOutcome(v), nil
+	}
+	return BackfillPreviewOutcome(0), fmt.Errorf("%s is not a valid BackfillPreviewOutcome", s)
+}
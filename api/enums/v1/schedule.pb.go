@@ -0,0 +1,201 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/enums/v1/schedule.proto
+
+package enums
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// What would happen to a backfilled action if the backfill was requested now.
+type BackfillPreviewOutcome int32
+
+const (
+	BACKFILL_PREVIEW_OUTCOME_UNSPECIFIED BackfillPreviewOutcome = 0
+	// Started right away.
+	BACKFILL_PREVIEW_OUTCOME_START BackfillPreviewOutcome = 1
+	// Started right away, overlapping with other runs (allow all overlap policy).
+	BACKFILL_PREVIEW_OUTCOME_START_OVERLAPPING BackfillPreviewOutcome = 2
+	// Buffered until the running workflow (or an earlier buffered action) closes.
+	BACKFILL_PREVIEW_OUTCOME_BUFFER BackfillPreviewOutcome = 3
+	// Skipped because of the overlap policy.
+	BACKFILL_PREVIEW_OUTCOME_SKIP BackfillPreviewOutcome = 4
+	// Buffered, and the running workflow is cancelled.
+	BACKFILL_PREVIEW_OUTCOME_CANCEL_OTHER BackfillPreviewOutcome = 5
+	// Buffered, and the running workflow is terminated.
+	BACKFILL_PREVIEW_OUTCOME_TERMINATE_OTHER BackfillPreviewOutcome = 6
+	// Dropped because the buffer of the schedule is full.
+	BACKFILL_PREVIEW_OUTCOME_DROP_BUFFER_FULL BackfillPreviewOutcome = 7
+)
+
+// Enum value maps for BackfillPreviewOutcome.
+var (
+	BackfillPreviewOutcome_name = map[int32]string{
+		0: "BACKFILL_PREVIEW_OUTCOME_UNSPECIFIED",
+		1: "BACKFILL_PREVIEW_OUTCOME_START",
+		2: "BACKFILL_PREVIEW_OUTCOME_START_OVERLAPPING",
+		3: "BACKFILL_PREVIEW_OUTCOME_BUFFER",
+		4: "BACKFILL_PREVIEW_OUTCOME_SKIP",
+		5: "BACKFILL_PREVIEW_OUTCOME_CANCEL_OTHER",
+		6: "BACKFILL_PREVIEW_OUTCOME_TERMINATE_OTHER",
+		7: "BACKFILL_PREVIEW_OUTCOME_DROP_BUFFER_FULL",
+	}
+	BackfillPreviewOutcome_value = map[string]int32{
+		"BACKFILL_PREVIEW_OUTCOME_UNSPECIFIED":       0,
+		"BACKFILL_PREVIEW_OUTCOME_START":             1,
+		"BACKFILL_PREVIEW_OUTCOME_START_OVERLAPPING": 2,
+		"BACKFILL_PREVIEW_OUTCOME_BUFFER":            3,
+		"BACKFILL_PREVIEW_OUTCOME_SKIP":              4,
+		"BACKFILL_PREVIEW_OUTCOME_CANCEL_OTHER":      5,
+		"BACKFILL_PREVIEW_OUTCOME_TERMINATE_OTHER":   6,
+		"BACKFILL_PREVIEW_OUTCOME_DROP_BUFFER_FULL":  7,
+	}
+)
+
+func (x BackfillPreviewOutcome) Enum() *BackfillPreviewOutcome {
+	p := new(BackfillPreviewOutcome)
+	*p = x
+	return p
+}
+
+func (x BackfillPreviewOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackfillPreviewOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_schedule_proto_enumTypes[0].Descriptor()
+}
+
+func (BackfillPreviewOutcome) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_schedule_proto_enumTypes[0]
+}
+
+func (x BackfillPreviewOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackfillPreviewOutcome.Descriptor instead.
+func (BackfillPreviewOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+var File_temporal_server_api_enums_v1_schedule_proto protoreflect.FileDescriptor
+
+var file_temporal_server_api_enums_v1_schedule_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x74,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2a, 0xe6, 0x02, 0x0a, 0x16,
+	0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x24, 0x42, 0x41, 0x43, 0x4b, 0x46, 0x49,
+	0x4c, 0x4c, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f,
+	0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x22, 0x0a, 0x1e, 0x42, 0x41, 0x43, 0x4b, 0x46, 0x49, 0x4c, 0x4c, 0x5f, 0x50, 0x52, 0x45,
+	0x56, 0x49, 0x45, 0x57, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x52, 0x54, 0x10, 0x01, 0x12, 0x2e, 0x0a, 0x2a, 0x42, 0x41, 0x43, 0x4b, 0x46, 0x49, 0x4c, 0x4c,
+	0x5f, 0x50, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x4c, 0x41, 0x50, 0x50, 0x49,
+	0x4e, 0x47, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x42, 0x41, 0x43, 0x4b, 0x46, 0x49, 0x4c, 0x4c,
+	0x5f, 0x50, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45,
+	0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x42, 0x41, 0x43,
+	0x4b, 0x46, 0x49, 0x4c, 0x4c, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x4f, 0x55,
+	0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x04, 0x12, 0x29, 0x0a, 0x25,
+	0x42, 0x41, 0x43, 0x4b, 0x46, 0x49, 0x4c, 0x4c, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57,
+	0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f,
+	0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x05, 0x12, 0x2c, 0x0a, 0x28, 0x42, 0x41, 0x43, 0x4b, 0x46,
+	0x49, 0x4c, 0x4c, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x4f, 0x55, 0x54, 0x43,
+	0x4f, 0x4d, 0x45, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x54,
+	0x48, 0x45, 0x52, 0x10, 0x06, 0x12, 0x2d, 0x0a, 0x29, 0x42, 0x41, 0x43, 0x4b, 0x46, 0x49, 0x4c,
+	0x4c, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d,
+	0x45, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x46, 0x55,
+	0x4c, 0x4c, 0x10, 0x07, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_temporal_server_api_enums_v1_schedule_proto_rawDescOnce sync.Once
+	file_temporal_server_api_enums_v1_schedule_proto_rawDescData = file_temporal_server_api_enums_v1_schedule_proto_rawDesc
+)
+
+func file_temporal_server_api_enums_v1_schedule_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_enums_v1_schedule_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_enums_v1_schedule_proto_rawDescData = protoimpl.X.CompressGZIP(file_temporal_server_api_enums_v1_schedule_proto_rawDescData)
+	})
+	return file_temporal_server_api_enums_v1_schedule_proto_rawDescData
+}
+
+var file_temporal_server_api_enums_v1_schedule_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_temporal_server_api_enums_v1_schedule_proto_goTypes = []interface{}{
+	(BackfillPreviewOutcome)(0), // 0: temporal.server.api.enums.v1.BackfillPreviewOutcome
+}
+var file_temporal_server_api_enums_v1_schedule_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_enums_v1_schedule_proto_init() }
+func file_temporal_server_api_enums_v1_schedule_proto_init() {
+	if File_temporal_server_api_enums_v1_schedule_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_temporal_server_api_enums_v1_schedule_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_enums_v1_schedule_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_enums_v1_schedule_proto_depIdxs,
+		EnumInfos:         file_temporal_server_api_enums_v1_schedule_proto_enumTypes,
+	}.Build()
+	File_temporal_server_api_enums_v1_schedule_proto = out.File
+	file_temporal_server_api_enums_v1_schedule_proto_rawDesc = nil
+	file_temporal_server_api_enums_v1_schedule_proto_goTypes = nil
+	file_temporal_server_api_enums_v1_schedule_proto_depIdxs = nil
+}
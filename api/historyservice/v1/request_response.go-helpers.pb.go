@@ -1287,6 +1287,80 @@ func (this *RemoveSignalMutableStateResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type UpsertWorkflowPropertiesRequest to the protobuf v3 wire format
+func (val *UpsertWorkflowPropertiesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpsertWorkflowPropertiesRequest from the protobuf v3 wire format
+func (val *UpsertWorkflowPropertiesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpsertWorkflowPropertiesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpsertWorkflowPropertiesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpsertWorkflowPropertiesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpsertWorkflowPropertiesRequest
+	switch t := that.(type) {
+	case *UpsertWorkflowPropertiesRequest:
+		that1 = t
+	case UpsertWorkflowPropertiesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpsertWorkflowPropertiesResponse to the protobuf v3 wire format
+func (val *UpsertWorkflowPropertiesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpsertWorkflowPropertiesResponse from the protobuf v3 wire format
+func (val *UpsertWorkflowPropertiesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpsertWorkflowPropertiesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpsertWorkflowPropertiesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpsertWorkflowPropertiesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpsertWorkflowPropertiesResponse
+	switch t := that.(type) {
+	case *UpsertWorkflowPropertiesResponse:
+		that1 = t
+	case UpsertWorkflowPropertiesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type TerminateWorkflowExecutionRequest to the protobuf v3 wire format
 func (val *TerminateWorkflowExecutionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
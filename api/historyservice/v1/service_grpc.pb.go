@@ -59,6 +59,7 @@ const (
 	HistoryService_SignalWorkflowExecution_FullMethodName                = "/temporal.server.api.historyservice.v1.HistoryService/SignalWorkflowExecution"
 	HistoryService_SignalWithStartWorkflowExecution_FullMethodName       = "/temporal.server.api.historyservice.v1.HistoryService/SignalWithStartWorkflowExecution"
 	HistoryService_RemoveSignalMutableState_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/RemoveSignalMutableState"
+	HistoryService_UpsertWorkflowProperties_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/UpsertWorkflowProperties"
 	HistoryService_TerminateWorkflowExecution_FullMethodName             = "/temporal.server.api.historyservice.v1.HistoryService/TerminateWorkflowExecution"
 	HistoryService_DeleteWorkflowExecution_FullMethodName                = "/temporal.server.api.historyservice.v1.HistoryService/DeleteWorkflowExecution"
 	HistoryService_ResetWorkflowExecution_FullMethodName                 = "/temporal.server.api.historyservice.v1.HistoryService/ResetWorkflowExecution"
@@ -189,6 +190,9 @@ type HistoryServiceClient interface {
 	// RemoveSignalMutableState is used to remove a signal request Id that was previously recorded.  This is currently
 	// used to clean execution info when signal workflow task finished.
 	RemoveSignalMutableState(ctx context.Context, in *RemoveSignalMutableStateRequest, opts ...grpc.CallOption) (*RemoveSignalMutableStateResponse, error)
+	// UpsertWorkflowProperties upserts the memo of a running workflow execution from outside the workflow. It records
+	// a WorkflowPropertiesModifiedExternally event and schedules a workflow task, like a signal does.
+	UpsertWorkflowProperties(ctx context.Context, in *UpsertWorkflowPropertiesRequest, opts ...grpc.CallOption) (*UpsertWorkflowPropertiesResponse, error)
 	// TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
 	// in the history and immediately terminating the execution instance.
 	TerminateWorkflowExecution(ctx context.Context, in *TerminateWorkflowExecutionRequest, opts ...grpc.CallOption) (*TerminateWorkflowExecutionResponse, error)
@@ -469,6 +473,15 @@ func (c *historyServiceClient) RemoveSignalMutableState(ctx context.Context, in
 	return out, nil
 }
 
+func (c *historyServiceClient) UpsertWorkflowProperties(ctx context.Context, in *UpsertWorkflowPropertiesRequest, opts ...grpc.CallOption) (*UpsertWorkflowPropertiesResponse, error) {
+	out := new(UpsertWorkflowPropertiesResponse)
+	err := c.cc.Invoke(ctx, HistoryService_UpsertWorkflowProperties_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) TerminateWorkflowExecution(ctx context.Context, in *TerminateWorkflowExecutionRequest, opts ...grpc.CallOption) (*TerminateWorkflowExecutionResponse, error) {
 	out := new(TerminateWorkflowExecutionResponse)
 	err := c.cc.Invoke(ctx, HistoryService_TerminateWorkflowExecution_FullMethodName, in, out, opts...)
@@ -963,6 +976,9 @@ type HistoryServiceServer interface {
 	// RemoveSignalMutableState is used to remove a signal request Id that was previously recorded.  This is currently
 	// used to clean execution info when signal workflow task finished.
 	RemoveSignalMutableState(context.Context, *RemoveSignalMutableStateRequest) (*RemoveSignalMutableStateResponse, error)
+	// UpsertWorkflowProperties upserts the memo of a running workflow execution from outside the workflow. It records
+	// a WorkflowPropertiesModifiedExternally event and schedules a workflow task, like a signal does.
+	UpsertWorkflowProperties(context.Context, *UpsertWorkflowPropertiesRequest) (*UpsertWorkflowPropertiesResponse, error)
 	// TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
 	// in the history and immediately terminating the execution instance.
 	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest) (*TerminateWorkflowExecutionResponse, error)
@@ -1138,6 +1154,9 @@ func (UnimplementedHistoryServiceServer) SignalWithStartWorkflowExecution(contex
 func (UnimplementedHistoryServiceServer) RemoveSignalMutableState(context.Context, *RemoveSignalMutableStateRequest) (*RemoveSignalMutableStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveSignalMutableState not implemented")
 }
+func (UnimplementedHistoryServiceServer) UpsertWorkflowProperties(context.Context, *UpsertWorkflowPropertiesRequest) (*UpsertWorkflowPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertWorkflowProperties not implemented")
+}
 func (UnimplementedHistoryServiceServer) TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest) (*TerminateWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TerminateWorkflowExecution not implemented")
 }
@@ -1586,6 +1605,24 @@ func _HistoryService_RemoveSignalMutableState_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_UpsertWorkflowProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertWorkflowPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpsertWorkflowProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_UpsertWorkflowProperties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpsertWorkflowProperties(ctx, req.(*UpsertWorkflowPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_TerminateWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TerminateWorkflowExecutionRequest)
 	if err := dec(in); err != nil {
@@ -2443,6 +2480,10 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveSignalMutableState",
 			Handler:    _HistoryService_RemoveSignalMutableState_Handler,
 		},
+		{
+			MethodName: "UpsertWorkflowProperties",
+			Handler:    _HistoryService_UpsertWorkflowProperties_Handler,
+		},
 		{
 			MethodName: "TerminateWorkflowExecution",
 			Handler:    _HistoryService_TerminateWorkflowExecution_Handler,
@@ -1221,6 +1221,26 @@ func (mr *MockHistoryServiceClientMockRecorder) UpdateWorkflowExecution(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpdateWorkflowExecution), varargs...)
 }
 
+// UpsertWorkflowProperties mocks base method.
+func (m *MockHistoryServiceClient) UpsertWorkflowProperties(ctx context.Context, in *historyservice.UpsertWorkflowPropertiesRequest, opts ...grpc.CallOption) (*historyservice.UpsertWorkflowPropertiesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertWorkflowProperties", varargs...)
+	ret0, _ := ret[0].(*historyservice.UpsertWorkflowPropertiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkflowProperties indicates an expected call of UpsertWorkflowProperties.
+func (mr *MockHistoryServiceClientMockRecorder) UpsertWorkflowProperties(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowProperties", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpsertWorkflowProperties), varargs...)
+}
+
 // VerifyChildExecutionCompletionRecorded mocks base method.
 func (m *MockHistoryServiceClient) VerifyChildExecutionCompletionRecorded(ctx context.Context, in *historyservice.VerifyChildExecutionCompletionRecordedRequest, opts ...grpc.CallOption) (*historyservice.VerifyChildExecutionCompletionRecordedResponse, error) {
 	m.ctrl.T.Helper()
@@ -2290,6 +2310,21 @@ func (mr *MockHistoryServiceServerMockRecorder) UpdateWorkflowExecution(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpdateWorkflowExecution), arg0, arg1)
 }
 
+// UpsertWorkflowProperties mocks base method.
+func (m *MockHistoryServiceServer) UpsertWorkflowProperties(arg0 context.Context, arg1 *historyservice.UpsertWorkflowPropertiesRequest) (*historyservice.UpsertWorkflowPropertiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowProperties", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UpsertWorkflowPropertiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkflowProperties indicates an expected call of UpsertWorkflowProperties.
+func (mr *MockHistoryServiceServerMockRecorder) UpsertWorkflowProperties(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowProperties", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpsertWorkflowProperties), arg0, arg1)
+}
+
 // VerifyChildExecutionCompletionRecorded mocks base method.
 func (m *MockHistoryServiceServer) VerifyChildExecutionCompletionRecorded(arg0 context.Context, arg1 *historyservice.VerifyChildExecutionCompletionRecordedRequest) (*historyservice.VerifyChildExecutionCompletionRecordedResponse, error) {
 	m.ctrl.T.Helper()
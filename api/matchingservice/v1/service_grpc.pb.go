@@ -50,6 +50,8 @@ const (
 	MatchingService_RespondQueryTaskCompleted_FullMethodName              = "/temporal.server.api.matchingservice.v1.MatchingService/RespondQueryTaskCompleted"
 	MatchingService_CancelOutstandingPoll_FullMethodName                  = "/temporal.server.api.matchingservice.v1.MatchingService/CancelOutstandingPoll"
 	MatchingService_DescribeTaskQueue_FullMethodName                      = "/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueue"
+	MatchingService_DescribeTaskQueueBacklog_FullMethodName               = "/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueueBacklog"
+	MatchingService_SetTaskQueuePaused_FullMethodName                     = "/temporal.server.api.matchingservice.v1.MatchingService/SetTaskQueuePaused"
 	MatchingService_ListTaskQueuePartitions_FullMethodName                = "/temporal.server.api.matchingservice.v1.MatchingService/ListTaskQueuePartitions"
 	MatchingService_UpdateWorkerBuildIdCompatibility_FullMethodName       = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateWorkerBuildIdCompatibility"
 	MatchingService_GetWorkerBuildIdCompatibility_FullMethodName          = "/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerBuildIdCompatibility"
@@ -92,6 +94,19 @@ type MatchingServiceClient interface {
 	// DescribeTaskQueue returns information about the target task queue, right now this API returns the
 	// pollers which polled this task queue in last few minutes.
 	DescribeTaskQueue(ctx context.Context, in *DescribeTaskQueueRequest, opts ...grpc.CallOption) (*DescribeTaskQueueResponse, error)
+	// DescribeTaskQueueBacklog returns backlog statistics of a task queue. On the root partition it
+	// combines the statistics of all partitions.
+	DescribeTaskQueueBacklog(ctx context.Context, in *DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*DescribeTaskQueueBacklogResponse, error)
+	// SetTaskQueuePaused pauses or resumes dispatch of tasks from a task queue, optionally only for a single build ID.
+	// The pause state is stored in the task queue user data and applies to all partitions and task queue types.
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//
+	//	aip.dev/not-precedent: SetTaskQueuePaused RPC doesn't follow Google API format. --)
+	//
+	// (-- api-linter: core::0134::method-signature=disabled
+	//
+	//	aip.dev/not-precedent: SetTaskQueuePaused RPC doesn't follow Google API format. --)
+	SetTaskQueuePaused(ctx context.Context, in *SetTaskQueuePausedRequest, opts ...grpc.CallOption) (*SetTaskQueuePausedResponse, error)
 	// ListTaskQueuePartitions returns a map of partitionKey and hostAddress for a task queue.
 	ListTaskQueuePartitions(ctx context.Context, in *ListTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*ListTaskQueuePartitionsResponse, error)
 	// (-- api-linter: core::0134::response-message-name=disabled
@@ -206,6 +221,24 @@ func (c *matchingServiceClient) DescribeTaskQueue(ctx context.Context, in *Descr
 	return out, nil
 }
 
+func (c *matchingServiceClient) DescribeTaskQueueBacklog(ctx context.Context, in *DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*DescribeTaskQueueBacklogResponse, error) {
+	out := new(DescribeTaskQueueBacklogResponse)
+	err := c.cc.Invoke(ctx, MatchingService_DescribeTaskQueueBacklog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) SetTaskQueuePaused(ctx context.Context, in *SetTaskQueuePausedRequest, opts ...grpc.CallOption) (*SetTaskQueuePausedResponse, error) {
+	out := new(SetTaskQueuePausedResponse)
+	err := c.cc.Invoke(ctx, MatchingService_SetTaskQueuePaused_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchingServiceClient) ListTaskQueuePartitions(ctx context.Context, in *ListTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*ListTaskQueuePartitionsResponse, error) {
 	out := new(ListTaskQueuePartitionsResponse)
 	err := c.cc.Invoke(ctx, MatchingService_ListTaskQueuePartitions_FullMethodName, in, out, opts...)
@@ -318,6 +351,19 @@ type MatchingServiceServer interface {
 	// DescribeTaskQueue returns information about the target task queue, right now this API returns the
 	// pollers which polled this task queue in last few minutes.
 	DescribeTaskQueue(context.Context, *DescribeTaskQueueRequest) (*DescribeTaskQueueResponse, error)
+	// DescribeTaskQueueBacklog returns backlog statistics of a task queue. On the root partition it
+	// combines the statistics of all partitions.
+	DescribeTaskQueueBacklog(context.Context, *DescribeTaskQueueBacklogRequest) (*DescribeTaskQueueBacklogResponse, error)
+	// SetTaskQueuePaused pauses or resumes dispatch of tasks from a task queue, optionally only for a single build ID.
+	// The pause state is stored in the task queue user data and applies to all partitions and task queue types.
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//
+	//	aip.dev/not-precedent: SetTaskQueuePaused RPC doesn't follow Google API format. --)
+	//
+	// (-- api-linter: core::0134::method-signature=disabled
+	//
+	//	aip.dev/not-precedent: SetTaskQueuePaused RPC doesn't follow Google API format. --)
+	SetTaskQueuePaused(context.Context, *SetTaskQueuePausedRequest) (*SetTaskQueuePausedResponse, error)
 	// ListTaskQueuePartitions returns a map of partitionKey and hostAddress for a task queue.
 	ListTaskQueuePartitions(context.Context, *ListTaskQueuePartitionsRequest) (*ListTaskQueuePartitionsResponse, error)
 	// (-- api-linter: core::0134::response-message-name=disabled
@@ -381,6 +427,12 @@ func (UnimplementedMatchingServiceServer) CancelOutstandingPoll(context.Context,
 func (UnimplementedMatchingServiceServer) DescribeTaskQueue(context.Context, *DescribeTaskQueueRequest) (*DescribeTaskQueueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueue not implemented")
 }
+func (UnimplementedMatchingServiceServer) DescribeTaskQueueBacklog(context.Context, *DescribeTaskQueueBacklogRequest) (*DescribeTaskQueueBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueueBacklog not implemented")
+}
+func (UnimplementedMatchingServiceServer) SetTaskQueuePaused(context.Context, *SetTaskQueuePausedRequest) (*SetTaskQueuePausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTaskQueuePaused not implemented")
+}
 func (UnimplementedMatchingServiceServer) ListTaskQueuePartitions(context.Context, *ListTaskQueuePartitionsRequest) (*ListTaskQueuePartitionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTaskQueuePartitions not implemented")
 }
@@ -565,6 +617,42 @@ func _MatchingService_DescribeTaskQueue_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_DescribeTaskQueueBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskQueueBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).DescribeTaskQueueBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_DescribeTaskQueueBacklog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).DescribeTaskQueueBacklog(ctx, req.(*DescribeTaskQueueBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_SetTaskQueuePaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaskQueuePausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).SetTaskQueuePaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_SetTaskQueuePaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).SetTaskQueuePaused(ctx, req.(*SetTaskQueuePausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchingService_ListTaskQueuePartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListTaskQueuePartitionsRequest)
 	if err := dec(in); err != nil {
@@ -766,6 +854,14 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeTaskQueue",
 			Handler:    _MatchingService_DescribeTaskQueue_Handler,
 		},
+		{
+			MethodName: "DescribeTaskQueueBacklog",
+			Handler:    _MatchingService_DescribeTaskQueueBacklog_Handler,
+		},
+		{
+			MethodName: "SetTaskQueuePaused",
+			Handler:    _MatchingService_SetTaskQueuePaused_Handler,
+		},
 		{
 			MethodName: "ListTaskQueuePartitions",
 			Handler:    _MatchingService_ListTaskQueuePartitions_Handler,
@@ -160,6 +160,26 @@ func (mr *MockMatchingServiceClientMockRecorder) DescribeTaskQueue(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueue", reflect.TypeOf((*MockMatchingServiceClient)(nil).DescribeTaskQueue), varargs...)
 }
 
+// DescribeTaskQueueBacklog mocks base method.
+func (m *MockMatchingServiceClient) DescribeTaskQueueBacklog(ctx context.Context, in *matchingservice.DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskQueueBacklog", varargs...)
+	ret0, _ := ret[0].(*matchingservice.DescribeTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueueBacklog indicates an expected call of DescribeTaskQueueBacklog.
+func (mr *MockMatchingServiceClientMockRecorder) DescribeTaskQueueBacklog(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueBacklog", reflect.TypeOf((*MockMatchingServiceClient)(nil).DescribeTaskQueueBacklog), varargs...)
+}
+
 // ForceUnloadTaskQueue mocks base method.
 func (m *MockMatchingServiceClient) ForceUnloadTaskQueue(ctx context.Context, in *matchingservice.ForceUnloadTaskQueueRequest, opts ...grpc.CallOption) (*matchingservice.ForceUnloadTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -360,6 +380,26 @@ func (mr *MockMatchingServiceClientMockRecorder) RespondQueryTaskCompleted(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondQueryTaskCompleted", reflect.TypeOf((*MockMatchingServiceClient)(nil).RespondQueryTaskCompleted), varargs...)
 }
 
+// SetTaskQueuePaused mocks base method.
+func (m *MockMatchingServiceClient) SetTaskQueuePaused(ctx context.Context, in *matchingservice.SetTaskQueuePausedRequest, opts ...grpc.CallOption) (*matchingservice.SetTaskQueuePausedResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetTaskQueuePaused", varargs...)
+	ret0, _ := ret[0].(*matchingservice.SetTaskQueuePausedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTaskQueuePaused indicates an expected call of SetTaskQueuePaused.
+func (mr *MockMatchingServiceClientMockRecorder) SetTaskQueuePaused(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskQueuePaused", reflect.TypeOf((*MockMatchingServiceClient)(nil).SetTaskQueuePaused), varargs...)
+}
+
 // UpdateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceClient) UpdateTaskQueueUserData(ctx context.Context, in *matchingservice.UpdateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -498,6 +538,21 @@ func (mr *MockMatchingServiceServerMockRecorder) DescribeTaskQueue(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueue", reflect.TypeOf((*MockMatchingServiceServer)(nil).DescribeTaskQueue), arg0, arg1)
 }
 
+// DescribeTaskQueueBacklog mocks base method.
+func (m *MockMatchingServiceServer) DescribeTaskQueueBacklog(arg0 context.Context, arg1 *matchingservice.DescribeTaskQueueBacklogRequest) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskQueueBacklog", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.DescribeTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueueBacklog indicates an expected call of DescribeTaskQueueBacklog.
+func (mr *MockMatchingServiceServerMockRecorder) DescribeTaskQueueBacklog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueBacklog", reflect.TypeOf((*MockMatchingServiceServer)(nil).DescribeTaskQueueBacklog), arg0, arg1)
+}
+
 // ForceUnloadTaskQueue mocks base method.
 func (m *MockMatchingServiceServer) ForceUnloadTaskQueue(arg0 context.Context, arg1 *matchingservice.ForceUnloadTaskQueueRequest) (*matchingservice.ForceUnloadTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -648,6 +703,21 @@ func (mr *MockMatchingServiceServerMockRecorder) RespondQueryTaskCompleted(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondQueryTaskCompleted", reflect.TypeOf((*MockMatchingServiceServer)(nil).RespondQueryTaskCompleted), arg0, arg1)
 }
 
+// SetTaskQueuePaused mocks base method.
+func (m *MockMatchingServiceServer) SetTaskQueuePaused(arg0 context.Context, arg1 *matchingservice.SetTaskQueuePausedRequest) (*matchingservice.SetTaskQueuePausedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTaskQueuePaused", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.SetTaskQueuePausedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTaskQueuePaused indicates an expected call of SetTaskQueuePaused.
+func (mr *MockMatchingServiceServerMockRecorder) SetTaskQueuePaused(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskQueuePaused", reflect.TypeOf((*MockMatchingServiceServer)(nil).SetTaskQueuePaused), arg0, arg1)
+}
+
 // UpdateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceServer) UpdateTaskQueueUserData(arg0 context.Context, arg1 *matchingservice.UpdateTaskQueueUserDataRequest) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
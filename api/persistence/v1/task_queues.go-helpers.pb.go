@@ -177,6 +177,80 @@ func (this *TaskQueueUserData) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type TaskQueuePauseState to the protobuf v3 wire format
+func (val *TaskQueuePauseState) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueuePauseState from the protobuf v3 wire format
+func (val *TaskQueuePauseState) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueuePauseState) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueuePauseState values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueuePauseState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueuePauseState
+	switch t := that.(type) {
+	case *TaskQueuePauseState:
+		that1 = t
+	case TaskQueuePauseState:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskQueuePartitionCount to the protobuf v3 wire format
+func (val *TaskQueuePartitionCount) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueuePartitionCount from the protobuf v3 wire format
+func (val *TaskQueuePartitionCount) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueuePartitionCount) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueuePartitionCount values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueuePartitionCount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueuePartitionCount
+	switch t := that.(type) {
+	case *TaskQueuePartitionCount:
+		that1 = t
+	case TaskQueuePartitionCount:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type VersionedTaskQueueUserData to the protobuf v3 wire format
 func (val *VersionedTaskQueueUserData) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
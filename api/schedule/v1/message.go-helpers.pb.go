@@ -103,6 +103,117 @@ func (this *InternalState) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type RunRecord to the protobuf v3 wire format
+func (val *RunRecord) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RunRecord from the protobuf v3 wire format
+func (val *RunRecord) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RunRecord) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RunRecord values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RunRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RunRecord
+	switch t := that.(type) {
+	case *RunRecord:
+		that1 = t
+	case RunRecord:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RunHistoryRequest to the protobuf v3 wire format
+func (val *RunHistoryRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RunHistoryRequest from the protobuf v3 wire format
+func (val *RunHistoryRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RunHistoryRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RunHistoryRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RunHistoryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RunHistoryRequest
+	switch t := that.(type) {
+	case *RunHistoryRequest:
+		that1 = t
+	case RunHistoryRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RunHistoryResponse to the protobuf v3 wire format
+func (val *RunHistoryResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RunHistoryResponse from the protobuf v3 wire format
+func (val *RunHistoryResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RunHistoryResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RunHistoryResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RunHistoryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RunHistoryResponse
+	switch t := that.(type) {
+	case *RunHistoryResponse:
+		that1 = t
+	case RunHistoryResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type StartScheduleArgs to the protobuf v3 wire format
 func (val *StartScheduleArgs) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -288,6 +399,80 @@ func (this *WatchWorkflowResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type WatchUpstreamScheduleRequest to the protobuf v3 wire format
+func (val *WatchUpstreamScheduleRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WatchUpstreamScheduleRequest from the protobuf v3 wire format
+func (val *WatchUpstreamScheduleRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WatchUpstreamScheduleRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WatchUpstreamScheduleRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WatchUpstreamScheduleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WatchUpstreamScheduleRequest
+	switch t := that.(type) {
+	case *WatchUpstreamScheduleRequest:
+		that1 = t
+	case WatchUpstreamScheduleRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type WatchUpstreamScheduleResponse to the protobuf v3 wire format
+func (val *WatchUpstreamScheduleResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WatchUpstreamScheduleResponse from the protobuf v3 wire format
+func (val *WatchUpstreamScheduleResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WatchUpstreamScheduleResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WatchUpstreamScheduleResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WatchUpstreamScheduleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WatchUpstreamScheduleResponse
+	switch t := that.(type) {
+	case *WatchUpstreamScheduleResponse:
+		that1 = t
+	case WatchUpstreamScheduleResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type StartWorkflowRequest to the protobuf v3 wire format
 func (val *StartWorkflowRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -435,3 +620,114 @@ func (this *TerminateWorkflowRequest) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type BackfillPreviewRequest to the protobuf v3 wire format
+func (val *BackfillPreviewRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BackfillPreviewRequest from the protobuf v3 wire format
+func (val *BackfillPreviewRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BackfillPreviewRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BackfillPreviewRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BackfillPreviewRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BackfillPreviewRequest
+	switch t := that.(type) {
+	case *BackfillPreviewRequest:
+		that1 = t
+	case BackfillPreviewRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BackfillPreviewEntry to the protobuf v3 wire format
+func (val *BackfillPreviewEntry) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BackfillPreviewEntry from the protobuf v3 wire format
+func (val *BackfillPreviewEntry) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BackfillPreviewEntry) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BackfillPreviewEntry values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BackfillPreviewEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BackfillPreviewEntry
+	switch t := that.(type) {
+	case *BackfillPreviewEntry:
+		that1 = t
+	case BackfillPreviewEntry:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BackfillPreviewResponse to the protobuf v3 wire format
+func (val *BackfillPreviewResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BackfillPreviewResponse from the protobuf v3 wire format
+func (val *BackfillPreviewResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BackfillPreviewResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BackfillPreviewResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BackfillPreviewResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BackfillPreviewResponse
+	switch t := that.(type) {
+	case *BackfillPreviewResponse:
+		that1 = t
+	case BackfillPreviewResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -65,3 +65,114 @@ func (this *TaskVersionDirective) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type PriorityBacklog to the protobuf v3 wire format
+func (val *PriorityBacklog) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PriorityBacklog from the protobuf v3 wire format
+func (val *PriorityBacklog) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PriorityBacklog) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PriorityBacklog values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PriorityBacklog) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PriorityBacklog
+	switch t := that.(type) {
+	case *PriorityBacklog:
+		that1 = t
+	case PriorityBacklog:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type FairnessBacklog to the protobuf v3 wire format
+func (val *FairnessBacklog) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type FairnessBacklog from the protobuf v3 wire format
+func (val *FairnessBacklog) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *FairnessBacklog) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two FairnessBacklog values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *FairnessBacklog) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *FairnessBacklog
+	switch t := that.(type) {
+	case *FairnessBacklog:
+		that1 = t
+	case FairnessBacklog:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BacklogStats to the protobuf v3 wire format
+func (val *BacklogStats) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BacklogStats from the protobuf v3 wire format
+func (val *BacklogStats) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BacklogStats) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BacklogStats values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BacklogStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BacklogStats
+	switch t := that.(type) {
+	case *BacklogStats:
+		that1 = t
+	case BacklogStats:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
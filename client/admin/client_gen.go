@@ -133,6 +133,36 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeScheduleRunHistory(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRunHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeScheduleRunHistoryResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeScheduleRunHistory(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueueBacklogResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeTaskQueueBacklog(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeTaskQueuePartition(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueuePartitionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -303,6 +333,26 @@ func (c *clientImpl) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) PauseTaskQueue(
+	ctx context.Context,
+	request *adminservice.PauseTaskQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseTaskQueueResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PauseTaskQueue(ctx, request, opts...)
+}
+
+func (c *clientImpl) PreviewScheduleBackfill(
+	ctx context.Context,
+	request *adminservice.PreviewScheduleBackfillRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PreviewScheduleBackfillResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PreviewScheduleBackfill(ctx, request, opts...)
+}
+
 func (c *clientImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -392,3 +442,23 @@ func (c *clientImpl) ResendReplicationTasks(
 	defer cancel()
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
+
+func (c *clientImpl) RestoreWorkflowExecutionFromArchive(
+	ctx context.Context,
+	request *adminservice.RestoreWorkflowExecutionFromArchiveRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RestoreWorkflowExecutionFromArchiveResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RestoreWorkflowExecutionFromArchive(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResumeTaskQueue(
+	ctx context.Context,
+	request *adminservice.ResumeTaskQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResumeTaskQueueResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ResumeTaskQueue(ctx, request, opts...)
+}
@@ -1088,6 +1088,26 @@ func (c *clientImpl) UpdateWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) UpsertWorkflowProperties(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowPropertiesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpsertWorkflowPropertiesResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.NamespaceId, request.GetWorkflowExecution().GetWorkflowId())
+	var response *historyservice.UpsertWorkflowPropertiesResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.UpsertWorkflowProperties(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) VerifyChildExecutionCompletionRecorded(
 	ctx context.Context,
 	request *historyservice.VerifyChildExecutionCompletionRecordedRequest,
@@ -831,6 +831,20 @@ func (c *metricClient) UpdateWorkflowExecution(
 	return c.client.UpdateWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) UpsertWorkflowProperties(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowPropertiesRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.UpsertWorkflowPropertiesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientUpsertWorkflowProperties")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpsertWorkflowProperties(ctx, request, opts...)
+}
+
 func (c *metricClient) VerifyChildExecutionCompletionRecorded(
 	ctx context.Context,
 	request *historyservice.VerifyChildExecutionCompletionRecordedRequest,
@@ -890,6 +890,21 @@ func (c *retryableClient) UpdateWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) UpsertWorkflowProperties(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowPropertiesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpsertWorkflowPropertiesResponse, error) {
+	var resp *historyservice.UpsertWorkflowPropertiesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpsertWorkflowProperties(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) VerifyChildExecutionCompletionRecorded(
 	ctx context.Context,
 	request *historyservice.VerifyChildExecutionCompletionRecordedRequest,
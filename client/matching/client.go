@@ -83,6 +83,13 @@ func (c *clientImpl) AddActivityTask(
 		enumspb.TASK_QUEUE_TYPE_ACTIVITY,
 		request.GetForwardedSource(),
 	)
+	if request.GetForwardedSource() == "" {
+		request.KnownPartitionCountVersion = c.loadBalancer.PartitionCountVersion(
+			namespace.ID(request.GetNamespaceId()),
+			request.TaskQueue,
+			enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		)
+	}
 	request.TaskQueue.Name = partition
 	client, err := c.getClientForTaskqueue(
 		request.NamespaceId,
@@ -94,7 +101,19 @@ func (c *clientImpl) AddActivityTask(
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.AddActivityTask(ctx, request, opts...)
+	resp, err := client.AddActivityTask(ctx, request, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if request.GetForwardedSource() == "" {
+		c.loadBalancer.UpdatePartitionCount(
+			namespace.ID(request.GetNamespaceId()),
+			request.TaskQueue,
+			enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+			resp.GetPartitionCount(),
+		)
+	}
+	return resp, nil
 }
 
 func (c *clientImpl) AddWorkflowTask(
@@ -107,6 +126,13 @@ func (c *clientImpl) AddWorkflowTask(
 		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
 		request.GetForwardedSource(),
 	)
+	if request.GetForwardedSource() == "" {
+		request.KnownPartitionCountVersion = c.loadBalancer.PartitionCountVersion(
+			namespace.ID(request.GetNamespaceId()),
+			request.TaskQueue,
+			enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		)
+	}
 	request.TaskQueue.Name = partition
 	client, err := c.getClientForTaskqueue(
 		request.NamespaceId,
@@ -118,7 +144,19 @@ func (c *clientImpl) AddWorkflowTask(
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.AddWorkflowTask(ctx, request, opts...)
+	resp, err := client.AddWorkflowTask(ctx, request, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if request.GetForwardedSource() == "" {
+		c.loadBalancer.UpdatePartitionCount(
+			namespace.ID(request.GetNamespaceId()),
+			request.TaskQueue,
+			enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+			resp.GetPartitionCount(),
+		)
+	}
+	return resp, nil
 }
 
 func (c *clientImpl) PollActivityTaskQueue(
@@ -133,6 +171,13 @@ func (c *clientImpl) PollActivityTaskQueue(
 	)
 	defer pollerToken.Release()
 
+	if request.GetForwardedSource() == "" {
+		request.KnownPartitionCountVersion = c.loadBalancer.PartitionCountVersion(
+			namespace.ID(request.GetNamespaceId()),
+			request.PollRequest.TaskQueue,
+			enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		)
+	}
 	request.PollRequest.TaskQueue.Name = pollerToken.GetFullName()
 	client, err := c.getClientForTaskqueue(
 		request.NamespaceId,
@@ -144,7 +189,19 @@ func (c *clientImpl) PollActivityTaskQueue(
 	}
 	ctx, cancel := c.createLongPollContext(ctx)
 	defer cancel()
-	return client.PollActivityTaskQueue(ctx, request, opts...)
+	resp, err := client.PollActivityTaskQueue(ctx, request, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if request.GetForwardedSource() == "" {
+		c.loadBalancer.UpdatePartitionCount(
+			namespace.ID(request.GetNamespaceId()),
+			request.PollRequest.TaskQueue,
+			enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+			resp.GetPartitionCount(),
+		)
+	}
+	return resp, nil
 }
 
 func (c *clientImpl) PollWorkflowTaskQueue(
@@ -159,6 +216,13 @@ func (c *clientImpl) PollWorkflowTaskQueue(
 	)
 	defer pollerToken.Release()
 
+	if request.GetForwardedSource() == "" {
+		request.KnownPartitionCountVersion = c.loadBalancer.PartitionCountVersion(
+			namespace.ID(request.GetNamespaceId()),
+			request.PollRequest.TaskQueue,
+			enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		)
+	}
 	request.PollRequest.TaskQueue.Name = pollerToken.GetFullName()
 	client, err := c.getClientForTaskqueue(
 		request.NamespaceId,
@@ -170,7 +234,19 @@ func (c *clientImpl) PollWorkflowTaskQueue(
 	}
 	ctx, cancel := c.createLongPollContext(ctx)
 	defer cancel()
-	return client.PollWorkflowTaskQueue(ctx, request, opts...)
+	resp, err := client.PollWorkflowTaskQueue(ctx, request, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if request.GetForwardedSource() == "" {
+		c.loadBalancer.UpdatePartitionCount(
+			namespace.ID(request.GetNamespaceId()),
+			request.PollRequest.TaskQueue,
+			enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+			resp.GetPartitionCount(),
+		)
+	}
+	return resp, nil
 }
 
 func (c *clientImpl) QueryWorkflow(ctx context.Context, request *matchingservice.QueryWorkflowRequest, opts ...grpc.CallOption) (*matchingservice.QueryWorkflowResponse, error) {
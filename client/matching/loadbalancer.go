@@ -27,10 +27,13 @@ package matching
 import (
 	"math/rand"
 	"sync"
+	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tqname"
@@ -63,6 +66,24 @@ type (
 			taskQueueType enumspb.TaskQueueType,
 			forwardedFrom string,
 		) *pollToken
+
+		// UpdatePartitionCount records the partition count that matching returned for a task queue. The recorded
+		// count takes precedence over dynamic config until it is reset with an empty count, or until it expires for
+		// not being confirmed. A nil count confirms the recorded count.
+		UpdatePartitionCount(
+			namespaceID namespace.ID,
+			taskQueue *taskqueuepb.TaskQueue,
+			taskQueueType enumspb.TaskQueueType,
+			partitionCount *persistencespb.TaskQueuePartitionCount,
+		)
+
+		// PartitionCountVersion returns the version of the recorded partition count of a task queue, or 0 if there is
+		// none. Matching only returns the partition count when its version differs.
+		PartitionCountVersion(
+			namespaceID namespace.ID,
+			taskQueue *taskqueuepb.TaskQueue,
+			taskQueueType enumspb.TaskQueueType,
+		) int64
 	}
 
 	defaultLoadBalancer struct {
@@ -71,16 +92,21 @@ type (
 		nWritePartitions    dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		forceReadPartition  dynamicconfig.IntPropertyFn
 		forceWritePartition dynamicconfig.IntPropertyFn
+		partitionCountTTL   dynamicconfig.DurationPropertyFn
+		timeSource          clock.TimeSource
 
 		lock         sync.RWMutex
 		taskQueueLBs map[taskQueueKey]*tqLoadBalancer
+		lastSweep    time.Time // last time expired task queue load balancers were removed
 	}
 
 	// Keeps track of polls per partition. Sends a poll to the partition with the fewest polls
 	tqLoadBalancer struct {
-		taskQueue    taskQueueKey
-		pollerCounts []int // keep track of poller count of each partition
-		lock         sync.Mutex
+		taskQueue            taskQueueKey
+		pollerCounts         []int                                   // keep track of poller count of each partition
+		partitionCount       *persistencespb.TaskQueuePartitionCount // partition count returned by matching, if any
+		partitionCountExpiry time.Time                               // when partitionCount expires unless confirmed
+		lock                 sync.Mutex
 	}
 
 	taskQueueKey struct {
@@ -108,6 +134,8 @@ func NewLoadBalancer(
 		nWritePartitions:    dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueWritePartitions),
 		forceReadPartition:  dc.GetIntProperty(dynamicconfig.TestMatchingLBForceReadPartition, -1),
 		forceWritePartition: dc.GetIntProperty(dynamicconfig.TestMatchingLBForceWritePartition, -1),
+		partitionCountTTL:   dc.GetDurationProperty(dynamicconfig.MatchingPartitionCountCacheTTL, time.Minute),
+		timeSource:          clock.NewRealTimeSource(),
 		lock:                sync.RWMutex{},
 		taskQueueLBs:        make(map[taskQueueKey]*tqLoadBalancer),
 	}
@@ -135,6 +163,12 @@ func (lb *defaultLoadBalancer) PickWritePartition(
 		return tqName.WithPartition(n).FullName()
 	}
 
+	if tqlb := lb.lookupTaskQueueLoadBalancer(namespaceID, tqName, taskQueueType); tqlb != nil {
+		if count := tqlb.getPartitionCount(lb.timeSource.Now()); count != nil {
+			return tqName.WithPartition(rand.Intn(max(1, int(count.GetWritePartitions())))).FullName()
+		}
+	}
+
 	nsName, err := lb.namespaceIDToName(namespaceID)
 	if err != nil {
 		return taskQueue.GetName()
@@ -169,14 +203,70 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	// map namespace ID to name.
 	var partitionCount = dynamicconfig.GlobalDefaultNumTaskQueuePartitions
 
-	namespaceName, err := lb.namespaceIDToName(namespaceID)
-	if err == nil {
+	if count := tqlb.getPartitionCount(lb.timeSource.Now()); count != nil {
+		partitionCount = max(1, int(count.GetReadPartitions()))
+	} else if namespaceName, err := lb.namespaceIDToName(namespaceID); err == nil {
 		partitionCount = lb.nReadPartitions(string(namespaceName), parsedName.BaseNameString(), taskQueueType)
 	}
 
 	return tqlb.pickReadPartition(partitionCount, lb.forceReadPartition())
 }
 
+func (lb *defaultLoadBalancer) UpdatePartitionCount(
+	namespaceID namespace.ID,
+	taskQueue *taskqueuepb.TaskQueue,
+	taskQueueType enumspb.TaskQueueType,
+	partitionCount *persistencespb.TaskQueuePartitionCount,
+) {
+	if taskQueue.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		return
+	}
+	parsedName, err := tqname.Parse(taskQueue.GetName())
+	if err != nil {
+		return
+	}
+	rootName := parsedName.Root()
+
+	// Only keep a load balancer around for task queues whose partitions are scaled.
+	tqlb := lb.lookupTaskQueueLoadBalancer(namespaceID, rootName, taskQueueType)
+	if tqlb == nil {
+		if partitionCount.GetVersion() == 0 {
+			return
+		}
+		tqlb = lb.getTaskQueueLoadBalancer(namespaceID, rootName, taskQueueType)
+	}
+	tqlb.setPartitionCount(partitionCount, lb.timeSource.Now().Add(lb.partitionCountTTL()))
+}
+
+func (lb *defaultLoadBalancer) PartitionCountVersion(
+	namespaceID namespace.ID,
+	taskQueue *taskqueuepb.TaskQueue,
+	taskQueueType enumspb.TaskQueueType,
+) int64 {
+	if taskQueue.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		return 0
+	}
+	parsedName, err := tqname.Parse(taskQueue.GetName())
+	if err != nil {
+		return 0
+	}
+	tqlb := lb.lookupTaskQueueLoadBalancer(namespaceID, parsedName.Root(), taskQueueType)
+	if tqlb == nil {
+		return 0
+	}
+	return tqlb.getPartitionCount(lb.timeSource.Now()).GetVersion()
+}
+
+func (lb *defaultLoadBalancer) lookupTaskQueueLoadBalancer(
+	namespaceID namespace.ID, parsedName tqname.Name, tqType enumspb.TaskQueueType,
+) *tqLoadBalancer {
+	key := taskQueueKey{NamespaceID: namespaceID, Name: parsedName, Type: tqType}
+
+	lb.lock.RLock()
+	defer lb.lock.RUnlock()
+	return lb.taskQueueLBs[key]
+}
+
 func (lb *defaultLoadBalancer) getTaskQueueLoadBalancer(
 	namespaceID namespace.ID, parsedName tqname.Name, tqType enumspb.TaskQueueType,
 ) *tqLoadBalancer {
@@ -192,6 +282,7 @@ func (lb *defaultLoadBalancer) getTaskQueueLoadBalancer(
 	lb.lock.Lock()
 	tqlb, ok = lb.taskQueueLBs[key]
 	if !ok {
+		lb.sweepLocked()
 		tqlb = newTaskQueueLoadBalancer(key)
 		lb.taskQueueLBs[key] = tqlb
 	}
@@ -199,12 +290,71 @@ func (lb *defaultLoadBalancer) getTaskQueueLoadBalancer(
 	return tqlb
 }
 
+// sweepLocked removes the load balancers of task queues that have neither an unexpired partition count nor
+// outstanding polls, at most once per partition count TTL. Caller must hold the write lock.
+func (lb *defaultLoadBalancer) sweepLocked() {
+	now := lb.timeSource.Now()
+	if now.Sub(lb.lastSweep) < lb.partitionCountTTL() {
+		return
+	}
+	lb.lastSweep = now
+	for key, tqlb := range lb.taskQueueLBs {
+		if tqlb.expired(now) {
+			delete(lb.taskQueueLBs, key)
+		}
+	}
+}
+
 func newTaskQueueLoadBalancer(key taskQueueKey) *tqLoadBalancer {
 	return &tqLoadBalancer{
 		taskQueue: key,
 	}
 }
 
+// getPartitionCount returns the partition count returned by matching, or nil if there is none or it expired.
+func (b *tqLoadBalancer) getPartitionCount(now time.Time) *persistencespb.TaskQueuePartitionCount {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if now.After(b.partitionCountExpiry) {
+		return nil
+	}
+	return b.partitionCount
+}
+
+// setPartitionCount records a partition count returned by matching until expiry. A nil count confirms the recorded
+// one and an empty count resets it.
+func (b *tqLoadBalancer) setPartitionCount(partitionCount *persistencespb.TaskQueuePartitionCount, expiry time.Time) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	switch {
+	case partitionCount == nil:
+		if b.partitionCount == nil {
+			return
+		}
+	case partitionCount.GetVersion() == 0:
+		b.partitionCount = nil
+		return
+	default:
+		b.partitionCount = partitionCount
+	}
+	b.partitionCountExpiry = expiry
+}
+
+// expired returns whether the load balancer has no unexpired partition count and no outstanding polls.
+func (b *tqLoadBalancer) expired(now time.Time) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.partitionCount != nil && !now.After(b.partitionCountExpiry) {
+		return false
+	}
+	for _, count := range b.pollerCounts {
+		if count > 0 {
+			return false
+		}
+	}
+	return true
+}
+
 func (b *tqLoadBalancer) pickReadPartition(partitionCount int, forcedPartition int) *pollToken {
 	b.lock.Lock()
 	defer b.lock.Unlock()
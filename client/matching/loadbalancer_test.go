@@ -27,9 +27,16 @@ package matching
 import (
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tqname"
 )
 
@@ -167,6 +174,75 @@ func TestLoadBalancer_ReducedPartitionCount(t *testing.T) {
 	assert.Equal(t, 2, maxPollerCount(tqlb))
 }
 
+func TestLoadBalancer_UpdatePartitionCount(t *testing.T) {
+	lb := NewLoadBalancer(
+		func(id namespace.ID) (namespace.Name, error) { return "fake-namespace", nil },
+		dynamicconfig.NewNoopCollection(),
+	)
+	nsID := namespace.ID("fake-namespace-id")
+	root := mustParseTQName("fake-taskqueue")
+	taskQueue := &taskqueuepb.TaskQueue{Name: root.FullName(), Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	tqType := enumspb.TASK_QUEUE_TYPE_ACTIVITY
+
+	// the count is learned from a response of any partition
+	assert.Zero(t, lb.PartitionCountVersion(nsID, taskQueue, tqType))
+	lb.UpdatePartitionCount(nsID, &taskqueuepb.TaskQueue{Name: root.WithPartition(2).FullName()}, tqType,
+		&persistencespb.TaskQueuePartitionCount{ReadPartitions: 1, WritePartitions: 1, Version: 3})
+	assert.EqualValues(t, 3, lb.PartitionCountVersion(nsID, taskQueue, tqType))
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, root.FullName(), lb.PickWritePartition(nsID, taskQueue, tqType, ""))
+		token := lb.PickReadPartition(nsID, taskQueue, tqType, "")
+		assert.Equal(t, root.FullName(), token.GetFullName())
+		token.Release()
+	}
+
+	// a response without a count keeps the recorded one
+	lb.UpdatePartitionCount(nsID, taskQueue, tqType, nil)
+	assert.EqualValues(t, 3, lb.PartitionCountVersion(nsID, taskQueue, tqType))
+
+	// resetting the count with an empty one falls back to dynamic config
+	lb.UpdatePartitionCount(nsID, taskQueue, tqType, &persistencespb.TaskQueuePartitionCount{})
+	assert.Zero(t, lb.PartitionCountVersion(nsID, taskQueue, tqType))
+	picked := make(map[string]bool)
+	for i := 0; i < dynamicconfig.GlobalDefaultNumTaskQueuePartitions; i++ {
+		picked[lb.PickReadPartition(nsID, taskQueue, tqType, "").GetFullName()] = true
+	}
+	assert.Len(t, picked, dynamicconfig.GlobalDefaultNumTaskQueuePartitions)
+
+	// sticky queues are not partitioned
+	sticky := &taskqueuepb.TaskQueue{Name: "sticky", Kind: enumspb.TASK_QUEUE_KIND_STICKY}
+	lb.UpdatePartitionCount(nsID, sticky, tqType, &persistencespb.TaskQueuePartitionCount{ReadPartitions: 8, WritePartitions: 8})
+	assert.Equal(t, "sticky", lb.PickWritePartition(nsID, sticky, tqType, ""))
+}
+
+func TestLoadBalancer_PartitionCountExpires(t *testing.T) {
+	lb := NewLoadBalancer(
+		func(id namespace.ID) (namespace.Name, error) { return "fake-namespace", nil },
+		dynamicconfig.NewNoopCollection(),
+	).(*defaultLoadBalancer)
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	lb.timeSource = timeSource
+	nsID := namespace.ID("fake-namespace-id")
+	taskQueue := &taskqueuepb.TaskQueue{Name: "fake-taskqueue", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	tqType := enumspb.TASK_QUEUE_TYPE_ACTIVITY
+	count := &persistencespb.TaskQueuePartitionCount{ReadPartitions: 1, WritePartitions: 1, Version: 1}
+
+	// responses without a count confirm the recorded one
+	lb.UpdatePartitionCount(nsID, taskQueue, tqType, count)
+	timeSource.Advance(time.Minute / 2)
+	lb.UpdatePartitionCount(nsID, taskQueue, tqType, nil)
+	timeSource.Advance(time.Minute / 2)
+	assert.EqualValues(t, 1, lb.PartitionCountVersion(nsID, taskQueue, tqType))
+
+	// an unconfirmed count expires
+	timeSource.Advance(time.Minute)
+	assert.Zero(t, lb.PartitionCountVersion(nsID, taskQueue, tqType))
+
+	// and its load balancer is removed when another one is created
+	lb.UpdatePartitionCount(nsID, &taskqueuepb.TaskQueue{Name: "other-taskqueue"}, tqType, count)
+	assert.Len(t, lb.taskQueueLBs, 1)
+}
+
 func mustParseTQName(baseName string) tqname.Name {
 	n, err := tqname.Parse(baseName)
 	if err != nil {
@@ -110,6 +110,21 @@ func (c *retryableClient) DescribeTaskQueue(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *matchingservice.DescribeTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+	var resp *matchingservice.DescribeTaskQueueBacklogResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeTaskQueueBacklog(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ForceUnloadTaskQueue(
 	ctx context.Context,
 	request *matchingservice.ForceUnloadTaskQueueRequest,
@@ -260,6 +275,21 @@ func (c *retryableClient) RespondQueryTaskCompleted(
 	return resp, err
 }
 
+func (c *retryableClient) SetTaskQueuePaused(
+	ctx context.Context,
+	request *matchingservice.SetTaskQueuePausedRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.SetTaskQueuePausedResponse, error) {
+	var resp *matchingservice.SetTaskQueuePausedResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetTaskQueuePaused(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
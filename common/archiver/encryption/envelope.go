@@ -0,0 +1,160 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"go.temporal.io/server/common/archiver"
+)
+
+const (
+	cipherAES256GCM = "AES256-GCM"
+)
+
+type (
+	// envelopeCipher encrypts each history archive with a random data key, which is in turn wrapped
+	// by the active key encryption key of the KeyProvider and stored in the key metadata of the archive.
+	envelopeCipher struct {
+		keyProvider KeyProvider
+	}
+
+	// keyMetadata is the JSON encoded key metadata stored in cleartext in the header of encrypted history archives
+	keyMetadata struct {
+		KeyID   string `json:"keyId"`
+		Cipher  string `json:"cipher"`
+		DataKey []byte `json:"dataKey"`
+	}
+
+	frameCipher struct {
+		aead cipher.AEAD
+	}
+)
+
+var (
+	errCiphertextTooShort = errors.New("ciphertext is too short")
+)
+
+// NewHistoryArchiveCipher creates the cipher encrypting history archives with the keys of keyProvider
+func NewHistoryArchiveCipher(keyProvider KeyProvider) archiver.HistoryArchiveCipher {
+	return &envelopeCipher{
+		keyProvider: keyProvider,
+	}
+}
+
+func (c *envelopeCipher) NewKey() (archiver.HistoryArchiveFrameCipher, []byte, error) {
+	kek, err := c.keyProvider.ActiveKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	kekAEAD, err := newAEAD(kek.Material)
+	if err != nil {
+		return nil, nil, err
+	}
+	material := make([]byte, keySize)
+	if _, err := io.ReadFull(rand.Reader, material); err != nil {
+		return nil, nil, err
+	}
+	aead, err := newAEAD(material)
+	if err != nil {
+		return nil, nil, err
+	}
+	wrapped, err := seal(kekAEAD, material, []byte(kek.ID))
+	if err != nil {
+		return nil, nil, err
+	}
+	metadata, err := json.Marshal(&keyMetadata{
+		KeyID:   kek.ID,
+		Cipher:  cipherAES256GCM,
+		DataKey: wrapped,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return &frameCipher{aead: aead}, metadata, nil
+}
+
+func (c *envelopeCipher) OpenKey(data []byte) (archiver.HistoryArchiveFrameCipher, error) {
+	var metadata keyMetadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil, fmt.Errorf("unable to decode archival encryption key metadata: %w", err)
+	}
+	if metadata.Cipher != cipherAES256GCM {
+		return nil, fmt.Errorf("unsupported archival encryption cipher %q", metadata.Cipher)
+	}
+	kek, err := c.keyProvider.GetKey(metadata.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	kekAEAD, err := newAEAD(kek.Material)
+	if err != nil {
+		return nil, err
+	}
+	material, err := open(kekAEAD, metadata.DataKey, []byte(metadata.KeyID))
+	if err != nil {
+		return nil, fmt.Errorf("unable to unwrap data key: %w", err)
+	}
+	aead, err := newAEAD(material)
+	if err != nil {
+		return nil, err
+	}
+	return &frameCipher{aead: aead}, nil
+}
+
+func (c *frameCipher) Seal(frame []byte, additionalData []byte) ([]byte, error) {
+	return seal(c.aead, frame, additionalData)
+}
+
+func (c *frameCipher) Open(sealed []byte, additionalData []byte) ([]byte, error) {
+	return open(c.aead, sealed, additionalData)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func seal(aead cipher.AEAD, plaintext []byte, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(aead cipher.AEAD, ciphertext []byte, additionalData []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errCiphertextTooShort
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
@@ -0,0 +1,298 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/filestore"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/tests/testutils"
+)
+
+const (
+	testNamespaceID          = "test-namespace-id"
+	testNamespace            = "test-namespace"
+	testWorkflowID           = "test-workflow-id"
+	testRunID                = "test-run-id"
+	testNextEventID          = 3
+	testCloseFailoverVersion = int64(100)
+	testPageSize             = 100
+	testSecret               = "top-secret-workflow-input"
+)
+
+var (
+	testBranchToken = []byte{1, 2, 3}
+)
+
+type historyArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	controller       *gomock.Controller
+	executionManager *persistence.MockExecutionManager
+	container        *archiver.HistoryBootstrapContainer
+	historyBatches   []*historypb.History
+	keyProvider      KeyProvider
+	URI              archiver.URI
+	dir              string
+}
+
+func TestHistoryArchiverSuite(t *testing.T) {
+	suite.Run(t, new(historyArchiverSuite))
+}
+
+func (s *historyArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.executionManager = persistence.NewMockExecutionManager(s.controller)
+	s.container = &archiver.HistoryBootstrapContainer{
+		ExecutionManager: s.executionManager,
+		Logger:           log.NewNoopLogger(),
+	}
+	s.historyBatches = []*historypb.History{
+		{
+			Events: []*historypb.HistoryEvent{
+				{
+					EventId:   common.FirstEventID,
+					EventTime: timestamppb.New(time.Date(2023, 11, 20, 1, 2, 3, 0, time.UTC)),
+					Version:   testCloseFailoverVersion,
+					EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+					Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+						WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+							Input: &commonpb.Payloads{
+								Payloads: []*commonpb.Payload{
+									{
+										Metadata: map[string][]byte{"encoding": []byte("json/plain")},
+										Data:     []byte(`"` + testSecret + `"`),
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					EventId:   common.FirstEventID + 1,
+					EventTime: timestamppb.New(time.Date(2023, 11, 20, 1, 2, 4, 0, time.UTC)),
+					Version:   testCloseFailoverVersion,
+					EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+					Attributes: &historypb.HistoryEvent_WorkflowTaskScheduledEventAttributes{
+						WorkflowTaskScheduledEventAttributes: &historypb.WorkflowTaskScheduledEventAttributes{},
+					},
+				},
+			},
+		},
+	}
+	s.keyProvider = s.newTestKeyProvider("key-1")
+	s.dir = testutils.MkdirTemp(s.T(), "", "TestEncryptedHistoryArchiver")
+	var err error
+	s.URI, err = archiver.NewURI("file://" + s.dir)
+	s.NoError(err)
+}
+
+func (s *historyArchiverSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *historyArchiverSuite) TestNewConfiguredHistoryArchiveCipher_NotConfigured() {
+	_, err := NewConfiguredHistoryArchiveCipher(&config.ArchivalEncryption{})
+	s.ErrorIs(err, errKeyProviderNotConfigured)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet() {
+	s.expectReadHistory()
+	historyArchiver := s.newTestHistoryArchiver(s.keyProvider)
+	s.NoError(historyArchiver.Archive(context.Background(), s.URI, s.newArchiveRequest()))
+
+	// history is archived without compression, so the secret would be in the archive if it wasn't encrypted
+	s.NotContains(string(s.readArchivedFile()), testSecret)
+
+	response, err := historyArchiver.Get(context.Background(), s.URI, s.newGetRequest())
+	s.NoError(err)
+	s.Len(response.HistoryBatches, len(s.historyBatches))
+	for i := range s.historyBatches {
+		s.True(proto.Equal(s.historyBatches[i], response.HistoryBatches[i]))
+	}
+}
+
+func (s *historyArchiverSuite) TestArchive_KeyMetadata() {
+	s.expectReadHistory()
+	historyArchiver := s.newTestHistoryArchiver(s.keyProvider)
+	s.NoError(historyArchiver.Archive(context.Background(), s.URI, s.newArchiveRequest()))
+
+	// the header of the archive is the magic, the format version, the compression and the key metadata
+	data := s.readArchivedFile()
+	s.True(bytes.HasPrefix(data, []byte("TMPRLHST")))
+	header := bytes.NewReader(data[len("TMPRLHST")+2:])
+	size, err := binary.ReadUvarint(header)
+	s.NoError(err)
+	encoded := make([]byte, size)
+	_, err = io.ReadFull(header, encoded)
+	s.NoError(err)
+	var metadata keyMetadata
+	s.NoError(json.Unmarshal(encoded, &metadata))
+	s.Equal("key-1", metadata.KeyID)
+	s.Equal(cipherAES256GCM, metadata.Cipher)
+	s.NotEmpty(metadata.DataKey)
+}
+
+func (s *historyArchiverSuite) TestGet_RotatedKey() {
+	s.expectReadHistory()
+	s.NoError(s.newTestHistoryArchiver(s.keyProvider).Archive(context.Background(), s.URI, s.newArchiveRequest()))
+
+	rotatedKeyProvider := s.newTestKeyProvider("key-2")
+	response, err := s.newTestHistoryArchiver(rotatedKeyProvider).Get(context.Background(), s.URI, s.newGetRequest())
+	s.NoError(err)
+	s.True(proto.Equal(s.historyBatches[0], response.HistoryBatches[0]))
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_KeyNotFound() {
+	s.expectReadHistory()
+	s.NoError(s.newTestHistoryArchiver(s.keyProvider).Archive(context.Background(), s.URI, s.newArchiveRequest()))
+
+	otherKeyProvider, err := NewLocalKeyFileProvider(writeTestKeyFile(s.T(), "key-3", map[string][]byte{
+		"key-3": testKeyMaterial(3),
+	}))
+	s.NoError(err)
+	response, err := s.newTestHistoryArchiver(otherKeyProvider).Get(context.Background(), s.URI, s.newGetRequest())
+	s.Nil(response)
+	s.IsType(&serviceerror.Internal{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_WrongKeyMaterial() {
+	s.expectReadHistory()
+	s.NoError(s.newTestHistoryArchiver(s.keyProvider).Archive(context.Background(), s.URI, s.newArchiveRequest()))
+
+	tamperedKeyProvider, err := NewLocalKeyFileProvider(writeTestKeyFile(s.T(), "key-1", map[string][]byte{
+		"key-1": testKeyMaterial(42),
+	}))
+	s.NoError(err)
+	response, err := s.newTestHistoryArchiver(tamperedKeyProvider).Get(context.Background(), s.URI, s.newGetRequest())
+	s.Nil(response)
+	s.IsType(&serviceerror.Internal{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_PlaintextArchive() {
+	s.expectReadHistory()
+	plaintextArchiver, err := s.newFilestoreArchiver(s.container)
+	s.NoError(err)
+	s.NoError(plaintextArchiver.Archive(context.Background(), s.URI, s.newArchiveRequest()))
+	s.Contains(string(s.readArchivedFile()), testSecret)
+
+	// history which isn't encrypted could have been written by anyone with access to the archive
+	response, err := s.newTestHistoryArchiver(s.keyProvider).Get(context.Background(), s.URI, s.newGetRequest())
+	s.Nil(response)
+	s.IsType(&serviceerror.Internal{}, err)
+}
+
+func (s *historyArchiverSuite) expectReadHistory() {
+	s.executionManager.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchByBatchResponse, error) {
+			if request.MinEventID != common.FirstEventID {
+				return nil, serviceerror.NewNotFound("no more history")
+			}
+			history := make([]*historypb.History, len(s.historyBatches))
+			for i, batch := range s.historyBatches {
+				history[i] = proto.Clone(batch).(*historypb.History)
+			}
+			return &persistence.ReadHistoryBranchByBatchResponse{History: history}, nil
+		},
+	).AnyTimes()
+}
+
+func (s *historyArchiverSuite) readArchivedFile() []byte {
+	entries, err := os.ReadDir(s.dir)
+	s.NoError(err)
+	s.Len(entries, 1)
+	data, err := os.ReadFile(filepath.Join(s.dir, entries[0].Name()))
+	s.NoError(err)
+	return data
+}
+
+func (s *historyArchiverSuite) newTestKeyProvider(activeKeyID string) KeyProvider {
+	keyProvider, err := NewLocalKeyFileProvider(writeTestKeyFile(s.T(), activeKeyID, map[string][]byte{
+		"key-1": testKeyMaterial(1),
+		"key-2": testKeyMaterial(2),
+	}))
+	s.NoError(err)
+	return keyProvider
+}
+
+func (s *historyArchiverSuite) newTestHistoryArchiver(keyProvider KeyProvider) archiver.HistoryArchiver {
+	encryptingContainer := *s.container
+	encryptingContainer.HistoryArchiveCipher = NewHistoryArchiveCipher(keyProvider)
+	historyArchiver, err := s.newFilestoreArchiver(&encryptingContainer)
+	s.NoError(err)
+	return historyArchiver
+}
+
+func (s *historyArchiverSuite) newFilestoreArchiver(container *archiver.HistoryBootstrapContainer) (archiver.HistoryArchiver, error) {
+	return filestore.NewHistoryArchiver(container, &config.FilestoreArchiver{
+		FileMode:    "0666",
+		DirMode:     "0766",
+		Compression: "none",
+	})
+}
+
+func (s *historyArchiverSuite) newArchiveRequest() *archiver.ArchiveHistoryRequest {
+	return &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+}
+
+func (s *historyArchiverSuite) newGetRequest() *archiver.GetHistoryRequest {
+	return &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	}
+}
@@ -0,0 +1,141 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	keySize = 32
+)
+
+type (
+	// Key is a key encryption key, used to wrap the data keys of archived history
+	Key struct {
+		ID       string
+		Material []byte
+	}
+
+	// KeyProvider provides the key encryption keys wrapping the data keys of encrypted history archives
+	KeyProvider interface {
+		// ActiveKey returns the key used to encrypt newly archived history
+		ActiveKey() (*Key, error)
+		// GetKey returns the key with the given ID, it is used to decrypt previously archived history
+		GetKey(keyID string) (*Key, error)
+	}
+
+	localKeyFileProvider struct {
+		activeKey *Key
+		keys      map[string]*Key
+	}
+
+	localKeyFile struct {
+		ActiveKeyID string            `yaml:"activeKeyID"`
+		Keys        map[string]string `yaml:"keys"`
+	}
+)
+
+var (
+	// ErrKeyNotFound is the error for a key ID unknown to the key provider
+	ErrKeyNotFound = errors.New("encryption key not found")
+
+	errKeyProviderNotConfigured = errors.New("no key provider is configured for archival encryption")
+	errEmptyKeyFilePath         = errors.New("key file path is empty")
+	errActiveKeyIDNotSet        = errors.New("active key ID is not set in the key file")
+)
+
+// NewKeyProvider creates the KeyProvider configured in the archival encryption config
+func NewKeyProvider(cfg *config.ArchivalEncryption) (KeyProvider, error) {
+	if cfg == nil || cfg.LocalKeyFile == nil {
+		return nil, errKeyProviderNotConfigured
+	}
+	return NewLocalKeyFileProvider(cfg.LocalKeyFile.Path)
+}
+
+// NewLocalKeyFileProvider creates a KeyProvider which reads the keys from a yaml file of the form
+//
+//	activeKeyID: "key-2"
+//	keys:
+//	  key-1: "<base64 encoded 256-bit key>"
+//	  key-2: "<base64 encoded 256-bit key>"
+//
+// Retired keys should be kept in the file for as long as history encrypted with them is retained.
+func NewLocalKeyFileProvider(path string) (KeyProvider, error) {
+	if len(path) == 0 {
+		return nil, errEmptyKeyFilePath
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read key file: %w", err)
+	}
+	var file localKeyFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("unable to parse key file: %w", err)
+	}
+	if len(file.ActiveKeyID) == 0 {
+		return nil, errActiveKeyIDNotSet
+	}
+
+	provider := &localKeyFileProvider{
+		keys: make(map[string]*Key, len(file.Keys)),
+	}
+	for keyID, encoded := range file.Keys {
+		material, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode key %q: %w", keyID, err)
+		}
+		if len(material) != keySize {
+			return nil, fmt.Errorf("key %q must be %d bytes long, got %d bytes", keyID, keySize, len(material))
+		}
+		provider.keys[keyID] = &Key{
+			ID:       keyID,
+			Material: material,
+		}
+	}
+	activeKey, ok := provider.keys[file.ActiveKeyID]
+	if !ok {
+		return nil, fmt.Errorf("active key %q: %w", file.ActiveKeyID, ErrKeyNotFound)
+	}
+	provider.activeKey = activeKey
+	return provider, nil
+}
+
+func (p *localKeyFileProvider) ActiveKey() (*Key, error) {
+	return p.activeKey, nil
+}
+
+func (p *localKeyFileProvider) GetKey(keyID string) (*Key, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key %q: %w", keyID, ErrKeyNotFound)
+	}
+	return key, nil
+}
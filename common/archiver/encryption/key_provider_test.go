@@ -0,0 +1,106 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/tests/testutils"
+)
+
+func TestNewKeyProvider_NotConfigured(t *testing.T) {
+	_, err := NewKeyProvider(nil)
+	require.ErrorIs(t, err, errKeyProviderNotConfigured)
+
+	_, err = NewKeyProvider(&config.ArchivalEncryption{})
+	require.ErrorIs(t, err, errKeyProviderNotConfigured)
+}
+
+func TestLocalKeyFileProvider(t *testing.T) {
+	oldKey := testKeyMaterial(1)
+	newKey := testKeyMaterial(2)
+	path := writeTestKeyFile(t, "key-2", map[string][]byte{
+		"key-1": oldKey,
+		"key-2": newKey,
+	})
+
+	provider, err := NewKeyProvider(&config.ArchivalEncryption{
+		LocalKeyFile: &config.LocalKeyFileConfig{Path: path},
+	})
+	require.NoError(t, err)
+
+	activeKey, err := provider.ActiveKey()
+	require.NoError(t, err)
+	require.Equal(t, &Key{ID: "key-2", Material: newKey}, activeKey)
+
+	key, err := provider.GetKey("key-1")
+	require.NoError(t, err)
+	require.Equal(t, &Key{ID: "key-1", Material: oldKey}, key)
+
+	_, err = provider.GetKey("key-3")
+	require.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestLocalKeyFileProvider_InvalidFile(t *testing.T) {
+	_, err := NewLocalKeyFileProvider("")
+	require.ErrorIs(t, err, errEmptyKeyFilePath)
+
+	_, err = NewLocalKeyFileProvider(filepath.Join(testutils.MkdirTemp(t, "", "TestLocalKeyFileProvider"), "missing.yaml"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	path := writeTestKeyFile(t, "", map[string][]byte{"key-1": testKeyMaterial(1)})
+	_, err = NewLocalKeyFileProvider(path)
+	require.ErrorIs(t, err, errActiveKeyIDNotSet)
+
+	path = writeTestKeyFile(t, "key-2", map[string][]byte{"key-1": testKeyMaterial(1)})
+	_, err = NewLocalKeyFileProvider(path)
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	path = writeTestKeyFile(t, "key-1", map[string][]byte{"key-1": []byte("too short")})
+	_, err = NewLocalKeyFileProvider(path)
+	require.Error(t, err)
+}
+
+func testKeyMaterial(seed byte) []byte {
+	material := make([]byte, keySize)
+	for i := range material {
+		material[i] = seed
+	}
+	return material
+}
+
+func writeTestKeyFile(t *testing.T, activeKeyID string, keys map[string][]byte) string {
+	content := "activeKeyID: \"" + activeKeyID + "\"\nkeys:\n"
+	for keyID, material := range keys {
+		content += "  " + keyID + ": \"" + base64.StdEncoding.EncodeToString(material) + "\"\n"
+	}
+	path := filepath.Join(testutils.MkdirTemp(t, "", "TestLocalKeyFileProvider"), "keys.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
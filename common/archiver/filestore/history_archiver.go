@@ -26,7 +26,8 @@
 
 // Each Archive() request results in a file named in the format of
 // hash(namespaceID, workflowID, runID)_version.history being created in the specified
-// directory. Workflow histories stored in that file are encoded in JSON format.
+// directory. Workflow histories are streamed into that file in the compressed history archive
+// format of the archiver package, histories archived in JSON format by older versions remain readable.
 
 // The Get() method retrieves the archived histories from the directory specified in the
 // URI. It optionally takes in a NextPageToken which specifies the workflow close failover
@@ -45,12 +46,10 @@ import (
 	"path"
 	"strconv"
 
-	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
-	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -60,16 +59,19 @@ const (
 	// URIScheme is the scheme for the filestore implementation
 	URIScheme = "file"
 
-	errEncodeHistory = "failed to encode history batches"
+	errEncodeHistory = "failed to Encode history batches"
 	errMakeDirectory = "failed to make directory"
 	errWriteFile     = "failed to write history to file"
 
+	tmpFileSuffix = ".tmp"
+
 	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
 )
 
 var (
-	errInvalidFileMode = errors.New("invalid file mode")
-	errInvalidDirMode  = errors.New("invalid directory mode")
+	errInvalidFileMode    = errors.New("invalid file mode")
+	errInvalidDirMode     = errors.New("invalid directory mode")
+	errInvalidCompression = errors.New("invalid compression")
 )
 
 type (
@@ -77,6 +79,8 @@ type (
 		container *archiver.HistoryBootstrapContainer
 		fileMode  os.FileMode
 		dirMode   os.FileMode
+		// compression of newly archived history
+		compression archiver.HistoryArchiveCompression
 
 		// only set in test code
 		historyIterator archiver.HistoryIterator
@@ -84,7 +88,11 @@ type (
 
 	getHistoryToken struct {
 		CloseFailoverVersion int64
-		NextBatchIdx         int
+		// NextBatchIdx is the index of the next history batch for history files archived in JSON format
+		NextBatchIdx int
+		// NextBatchPosition is the position of the next history batch for history files archived in the
+		// history archive format, so that pages are read without decoding the file from the start
+		NextBatchPosition *archiver.HistoryArchivePosition `json:",omitempty"`
 	}
 )
 
@@ -109,10 +117,15 @@ func newHistoryArchiver(
 	if err != nil {
 		return nil, errInvalidDirMode
 	}
+	compression, err := archiver.ParseHistoryArchiveCompression(config.Compression)
+	if err != nil {
+		return nil, errInvalidCompression
+	}
 	return &historyArchiver{
 		container:       container,
 		fileMode:        os.FileMode(fileMode),
 		dirMode:         os.FileMode(dirMode),
+		compression:     compression,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -147,7 +160,13 @@ func (h *historyArchiver) Archive(
 		historyIterator = archiver.NewHistoryIterator(request, h.container.ExecutionManager, targetHistoryBlobSize)
 	}
 
-	var historyBatches []*historypb.History
+	var historyWriter *historyFileWriter
+	defer func() {
+		// history is only visible to Get once fully written
+		if historyWriter != nil {
+			historyWriter.Abort()
+		}
+	}()
 	for historyIterator.HasNext() {
 		historyBlob, err := historyIterator.Next(ctx)
 		if err != nil {
@@ -173,27 +192,35 @@ func (h *historyArchiver) Archive(
 			return archiver.ErrHistoryMutated
 		}
 
-		historyBatches = append(historyBatches, historyBlob.Body...)
-	}
+		if historyWriter == nil {
+			dirPath := URI.Path()
+			if err = MkdirAll(dirPath, h.dirMode); err != nil {
+				logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
+				return err
+			}
 
-	encoder := codec.NewJSONPBEncoder()
-	encodedHistoryBatches, err := encoder.EncodeHistories(historyBatches)
-	if err != nil {
-		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
-		return err
-	}
+			filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+			historyWriter, err = newHistoryFileWriter(path.Join(dirPath, filename), h.fileMode, h.compression, h.container.HistoryArchiveCipher)
+			if err != nil {
+				logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+				return err
+			}
+		}
 
-	dirPath := URI.Path()
-	if err = mkdirAll(dirPath, h.dirMode); err != nil {
-		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
-		return err
+		if err := historyWriter.Write(historyBlob.Body); err != nil {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+			return err
+		}
 	}
 
-	filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
-	if err := writeFile(path.Join(dirPath, filename), encodedHistoryBatches, h.fileMode); err != nil {
+	if historyWriter == nil {
+		return nil
+	}
+	if err := historyWriter.Commit(); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 		return err
 	}
+	historyWriter = nil
 
 	return nil
 }
@@ -212,7 +239,7 @@ func (h *historyArchiver) Get(
 	}
 
 	dirPath := URI.Path()
-	exists, err := directoryExists(dirPath)
+	exists, err := DirectoryExists(dirPath)
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
@@ -252,33 +279,16 @@ func (h *historyArchiver) Get(
 		return nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
 	}
 
-	encodedHistoryBatches, err := readFile(filepath)
+	historyBatches, nextPageToken, err := readHistoryBatches(filepath, token, request.PageSize, h.container.HistoryArchiveCipher)
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
 
-	encoder := codec.NewJSONPBEncoder()
-	historyBatches, err := encoder.DecodeHistories(encodedHistoryBatches)
-	if err != nil {
-		return nil, serviceerror.NewInternal(err.Error())
+	response := &archiver.GetHistoryResponse{
+		HistoryBatches: historyBatches,
 	}
-	historyBatches = historyBatches[token.NextBatchIdx:]
-
-	response := &archiver.GetHistoryResponse{}
-	numOfEvents := 0
-	numOfBatches := 0
-	for _, batch := range historyBatches {
-		response.HistoryBatches = append(response.HistoryBatches, batch)
-		numOfBatches++
-		numOfEvents += len(batch.Events)
-		if numOfEvents >= request.PageSize {
-			break
-		}
-	}
-
-	if numOfBatches < len(historyBatches) {
-		token.NextBatchIdx += numOfBatches
-		nextToken, err := serializeToken(token)
+	if nextPageToken != nil {
+		nextToken, err := serializeToken(nextPageToken)
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
@@ -293,7 +303,7 @@ func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 		return archiver.ErrURISchemeMismatch
 	}
 
-	return validateDirPath(URI.Path())
+	return ValidateDirPath(URI.Path())
 }
 
 func getHighestVersion(dirPath string, request *archiver.GetHistoryRequest) (*int64, error) {
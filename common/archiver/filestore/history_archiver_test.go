@@ -48,6 +48,7 @@ import (
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/testing/protorequire"
 )
 
 const (
@@ -115,7 +116,7 @@ func (s *historyArchiverSuite) TestValidateURI() {
 		},
 		{
 			URI:         "file://",
-			expectedErr: errEmptyDirectoryPath,
+			expectedErr: ErrEmptyDirectoryPath,
 		},
 		{
 			URI:         "file:///a/b/c",
@@ -467,7 +468,7 @@ func (s *historyArchiverSuite) TestGet_Success_PickHighestVersion() {
 	response, err := historyArchiver.Get(context.Background(), URI, request)
 	s.NoError(err)
 	s.Nil(response.NextPageToken)
-	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+	protorequire.ProtoSliceEqual(s.T(), s.historyBatchesV100, response.HistoryBatches)
 }
 
 func (s *historyArchiverSuite) TestGet_Success_UseProvidedVersion() {
@@ -485,7 +486,7 @@ func (s *historyArchiverSuite) TestGet_Success_UseProvidedVersion() {
 	response, err := historyArchiver.Get(context.Background(), URI, request)
 	s.NoError(err)
 	s.Nil(response.NextPageToken)
-	s.Equal(s.historyBatchesV1, response.HistoryBatches)
+	protorequire.ProtoSliceEqual(s.T(), s.historyBatchesV1, response.HistoryBatches)
 }
 
 func (s *historyArchiverSuite) TestGet_Success_SmallPageSize() {
@@ -519,7 +520,7 @@ func (s *historyArchiverSuite) TestGet_Success_SmallPageSize() {
 	s.Len(response.HistoryBatches, 1)
 	combinedHistory = append(combinedHistory, response.HistoryBatches...)
 
-	s.Equal(s.historyBatchesV100, combinedHistory)
+	protorequire.ProtoSliceEqual(s.T(), s.historyBatchesV100, combinedHistory)
 }
 
 func (s *historyArchiverSuite) TestArchiveAndGet() {
@@ -568,7 +569,83 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.NoError(err)
 	s.NotNil(response)
 	s.Nil(response.NextPageToken)
-	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+	protorequire.ProtoSliceEqual(s.T(), s.historyBatchesV100, response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet_Compression() {
+	for _, compression := range []string{"none", "gzip", "zstd"} {
+		mockCtrl := gomock.NewController(s.T())
+		historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+		gomock.InOrder(
+			historyIterator.EXPECT().HasNext().Return(true),
+			historyIterator.EXPECT().Next(gomock.Any()).Return(&archiverspb.HistoryBlob{
+				Header: &archiverspb.HistoryBlobHeader{IsLast: false},
+				Body:   s.historyBatchesV100[:1],
+			}, nil),
+			historyIterator.EXPECT().HasNext().Return(true),
+			historyIterator.EXPECT().Next(gomock.Any()).Return(&archiverspb.HistoryBlob{
+				Header: &archiverspb.HistoryBlobHeader{IsLast: true},
+				Body:   s.historyBatchesV100[1:],
+			}, nil),
+			historyIterator.EXPECT().HasNext().Return(false),
+		)
+
+		dir := testutils.MkdirTemp(s.T(), "", "TestArchiveAndGet_Compression")
+		historyArchiver, err := newHistoryArchiver(s.container, &config.FilestoreArchiver{
+			FileMode:    testFileModeStr,
+			DirMode:     testDirModeStr,
+			Compression: compression,
+		}, historyIterator)
+		s.NoError(err)
+		URI, err := archiver.NewURI("file://" + dir)
+		s.NoError(err)
+		err = historyArchiver.Archive(context.Background(), URI, &archiver.ArchiveHistoryRequest{
+			NamespaceID:          testNamespaceID,
+			Namespace:            testNamespace,
+			WorkflowID:           testWorkflowID,
+			RunID:                testRunID,
+			BranchToken:          testBranchToken,
+			NextEventID:          testNextEventID,
+			CloseFailoverVersion: testCloseFailoverVersion,
+		})
+		s.NoError(err)
+		mockCtrl.Finish()
+
+		files, err := ListFiles(dir)
+		s.NoError(err)
+		s.Equal([]string{constructHistoryFilename(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)}, files)
+
+		request := &archiver.GetHistoryRequest{
+			NamespaceID: testNamespaceID,
+			WorkflowID:  testWorkflowID,
+			RunID:       testRunID,
+			PageSize:    1,
+		}
+		var combinedHistory []*historypb.History
+		response, err := historyArchiver.Get(context.Background(), URI, request)
+		s.NoError(err)
+		s.NotNil(response.NextPageToken)
+		s.Len(response.HistoryBatches, 1)
+		combinedHistory = append(combinedHistory, response.HistoryBatches...)
+
+		request.NextPageToken = response.NextPageToken
+		response, err = historyArchiver.Get(context.Background(), URI, request)
+		s.NoError(err)
+		s.Nil(response.NextPageToken)
+		s.Len(response.HistoryBatches, 1)
+		combinedHistory = append(combinedHistory, response.HistoryBatches...)
+
+		protorequire.ProtoSliceEqual(s.T(), s.historyBatchesV100, combinedHistory)
+	}
+}
+
+func (s *historyArchiverSuite) TestNewHistoryArchiver_InvalidCompression() {
+	_, err := newHistoryArchiver(s.container, &config.FilestoreArchiver{
+		FileMode:    testFileModeStr,
+		DirMode:     testDirModeStr,
+		Compression: "lz4",
+	}, nil)
+	s.ErrorIs(err, errInvalidCompression)
 }
 
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
@@ -629,7 +706,7 @@ func (s *historyArchiverSuite) writeHistoryBatchesForGetTest(historyBatches []*h
 	data, err := encodeHistories(historyBatches)
 	s.Require().NoError(err)
 	filename := constructHistoryFilename(testNamespaceID, testWorkflowID, testRunID, version)
-	err = writeFile(path.Join(s.testGetDirectory, filename), data, testFileMode)
+	err = WriteFile(path.Join(s.testGetDirectory, filename), data, testFileMode)
 	s.Require().NoError(err)
 }
 
@@ -25,10 +25,13 @@
 package filestore
 
 import (
+	"bufio"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -44,9 +47,9 @@ import (
 )
 
 var (
-	errDirectoryExpected  = errors.New("a path to a directory was expected")
+	ErrDirectoryExpected  = errors.New("a path to a directory was expected")
 	errFileExpected       = errors.New("a path to a file was expected")
-	errEmptyDirectoryPath = errors.New("directory path is empty")
+	ErrEmptyDirectoryPath = errors.New("directory path is empty")
 )
 
 // File I/O util
@@ -63,23 +66,23 @@ func fileExists(filepath string) (bool, error) {
 	return true, nil
 }
 
-func directoryExists(path string) (bool, error) {
+func DirectoryExists(path string) (bool, error) {
 	if info, err := os.Stat(path); err != nil {
 		if os.IsNotExist(err) {
 			return false, nil
 		}
 		return false, err
 	} else if !info.IsDir() {
-		return false, errDirectoryExpected
+		return false, ErrDirectoryExpected
 	}
 	return true, nil
 }
 
-func mkdirAll(path string, dirMode os.FileMode) error {
+func MkdirAll(path string, dirMode os.FileMode) error {
 	return os.MkdirAll(path, dirMode)
 }
 
-func writeFile(filepath string, data []byte, fileMode os.FileMode) (retErr error) {
+func WriteFile(filepath string, data []byte, fileMode os.FileMode) (retErr error) {
 	if err := os.Remove(filepath); err != nil && !os.IsNotExist(err) {
 		return err
 	}
@@ -102,19 +105,19 @@ func writeFile(filepath string, data []byte, fileMode os.FileMode) (retErr error
 	return nil
 }
 
-// readFile reads the contents of a file specified by filepath
+// ReadFile reads the contents of a file specified by filepath
 // WARNING: callers of this method should be extremely careful not to use it in a context where filepath is supplied by
 // the user.
-func readFile(filepath string) ([]byte, error) {
+func ReadFile(filepath string) ([]byte, error) {
 	// #nosec
 	return os.ReadFile(filepath)
 }
 
-func listFiles(dirPath string) (fileNames []string, err error) {
+func ListFiles(dirPath string) (fileNames []string, err error) {
 	if info, err := os.Stat(dirPath); err != nil {
 		return nil, err
 	} else if !info.IsDir() {
-		return nil, errDirectoryExpected
+		return nil, ErrDirectoryExpected
 	}
 
 	f, err := os.Open(dirPath)
@@ -128,7 +131,7 @@ func listFiles(dirPath string) (fileNames []string, err error) {
 }
 
 func listFilesByPrefix(dirPath string, prefix string) ([]string, error) {
-	fileNames, err := listFiles(dirPath)
+	fileNames, err := ListFiles(dirPath)
 	if err != nil {
 		return nil, err
 	}
@@ -142,9 +145,166 @@ func listFilesByPrefix(dirPath string, prefix string) ([]string, error) {
 	return filteredFileNames, nil
 }
 
+// historyFileWriter streams history batches into a history archive file. Batches are written to a
+// temporary file unique to the writer, which is only renamed to the history file once all batches
+// were written, so concurrent archivals of the same run never write to the same file.
+type historyFileWriter struct {
+	filepath string
+	file     *os.File
+	buffered *bufio.Writer
+	writer   *archiver.HistoryArchiveWriter
+}
+
+func newHistoryFileWriter(
+	filepath string,
+	fileMode os.FileMode,
+	compression archiver.HistoryArchiveCompression,
+	cipher archiver.HistoryArchiveCipher,
+) (*historyFileWriter, error) {
+	file, err := os.CreateTemp(path.Dir(filepath), path.Base(filepath)+".*"+tmpFileSuffix)
+	if err != nil {
+		return nil, err
+	}
+	if err := file.Chmod(fileMode); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	buffered := bufio.NewWriter(file)
+	writer, err := archiver.NewHistoryArchiveWriter(buffered, compression, cipher)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return &historyFileWriter{
+		filepath: filepath,
+		file:     file,
+		buffered: buffered,
+		writer:   writer,
+	}, nil
+}
+
+func (w *historyFileWriter) Write(historyBatches []*historypb.History) error {
+	for _, batch := range historyBatches {
+		if err := w.writer.Write(batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Commit flushes the history file and renames it to its final name
+func (w *historyFileWriter) Commit() error {
+	if err := w.writer.Close(); err != nil {
+		return err
+	}
+	if err := w.buffered.Flush(); err != nil {
+		return err
+	}
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(w.file.Name(), w.filepath)
+}
+
+// Abort removes the temporary history file
+func (w *historyFileWriter) Abort() {
+	_ = w.file.Close()
+	_ = os.Remove(w.file.Name())
+}
+
+// readHistoryBatches reads a page of history batches from a history file, starting at the batch of token.
+// It returns the token of the next page, or nil if the page is the last one.
+func readHistoryBatches(
+	filepath string,
+	token *getHistoryToken,
+	pageSize int,
+	cipher archiver.HistoryArchiveCipher,
+) (_ []*historypb.History, _ *getHistoryToken, retErr error) {
+	// #nosec
+	file, err := os.Open(filepath)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		retErr = multierr.Append(retErr, file.Close())
+	}()
+
+	reader := bufio.NewReader(file)
+	historyReader, err := archiver.NewHistoryArchiveReader(reader, cipher)
+	if err == archiver.ErrLegacyHistoryArchive {
+		return readLegacyHistoryBatches(reader, token, pageSize)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer historyReader.Close()
+	if token.NextBatchPosition != nil {
+		if _, err := file.Seek(token.NextBatchPosition.FrameOffset, io.SeekStart); err != nil {
+			return nil, nil, err
+		}
+		historyReader.ResumeAt(file, *token.NextBatchPosition)
+	}
+
+	var historyBatches []*historypb.History
+	numOfEvents := 0
+	for {
+		position := historyReader.Position()
+		batch, err := historyReader.Next()
+		if err == io.EOF {
+			return historyBatches, nil, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if numOfEvents >= pageSize {
+			return historyBatches, &getHistoryToken{
+				CloseFailoverVersion: token.CloseFailoverVersion,
+				NextBatchPosition:    &position,
+			}, nil
+		}
+		historyBatches = append(historyBatches, batch)
+		numOfEvents += len(batch.Events)
+	}
+}
+
+// readLegacyHistoryBatches reads a page of history batches from a history file
+// archived in JSON format, before the history archive format was introduced
+func readLegacyHistoryBatches(reader io.Reader, token *getHistoryToken, pageSize int) ([]*historypb.History, *getHistoryToken, error) {
+	encodedHistoryBatches, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	encoder := codec.NewJSONPBEncoder()
+	historyBatches, err := encoder.DecodeHistories(encodedHistoryBatches)
+	if err != nil {
+		return nil, nil, err
+	}
+	if token.NextBatchIdx > len(historyBatches) {
+		return nil, nil, nil
+	}
+	historyBatches = historyBatches[token.NextBatchIdx:]
+
+	numOfEvents := 0
+	numOfBatches := 0
+	for _, batch := range historyBatches {
+		numOfBatches++
+		numOfEvents += len(batch.Events)
+		if numOfEvents >= pageSize {
+			break
+		}
+	}
+	if numOfBatches == len(historyBatches) {
+		return historyBatches, nil, nil
+	}
+	return historyBatches[:numOfBatches], &getHistoryToken{
+		CloseFailoverVersion: token.CloseFailoverVersion,
+		NextBatchIdx:         token.NextBatchIdx + numOfBatches,
+	}, nil
+}
+
 // encoding & decoding util
 
-func encode(message proto.Message) ([]byte, error) {
+func Encode(message proto.Message) ([]byte, error) {
 	encoder := codec.NewJSONPBEncoder()
 	return encoder.Encode(message)
 }
@@ -154,7 +314,7 @@ func encodeHistories(histories []*historypb.History) ([]byte, error) {
 	return encoder.EncodeHistories(histories)
 }
 
-func decodeVisibilityRecord(data []byte) (*archiverspb.VisibilityRecord, error) {
+func DecodeVisibilityRecord(data []byte) (*archiverspb.VisibilityRecord, error) {
 	record := &archiverspb.VisibilityRecord{}
 	encoder := codec.NewJSONPBEncoder()
 	err := encoder.Decode(data, record)
@@ -204,9 +364,9 @@ func hash(s string) string {
 
 // Validation
 
-func validateDirPath(dirPath string) error {
+func ValidateDirPath(dirPath string) error {
 	if len(dirPath) == 0 {
-		return errEmptyDirectoryPath
+		return ErrEmptyDirectoryPath
 	}
 	info, err := os.Stat(dirPath)
 	if os.IsNotExist(err) {
@@ -216,7 +376,7 @@ func validateDirPath(dirPath string) error {
 		return err
 	}
 	if !info.IsDir() {
-		return errDirectoryExpected
+		return ErrDirectoryExpected
 	}
 	return nil
 }
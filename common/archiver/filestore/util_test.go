@@ -32,13 +32,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
-	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/tests/testutils"
 )
 
@@ -84,14 +84,14 @@ func (s *UtilSuite) TestDirectoryExists() {
 	s.assertDirectoryExists(dir)
 
 	subdir := "subdir"
-	exists, err := directoryExists(filepath.Join(dir, subdir))
+	exists, err := DirectoryExists(filepath.Join(dir, subdir))
 	s.NoError(err)
 	s.False(exists)
 
 	filename := "test-file-name"
 	s.createFile(dir, filename)
 	fpath := filepath.Join(dir, filename)
-	exists, err = directoryExists(fpath)
+	exists, err = DirectoryExists(fpath)
 	s.Error(err)
 	s.False(exists)
 }
@@ -100,19 +100,19 @@ func (s *UtilSuite) TestMkdirAll() {
 	dir := testutils.MkdirTemp(s.T(), "", "TestMkdirAll")
 	s.assertDirectoryExists(dir)
 
-	s.NoError(mkdirAll(dir, testDirMode))
+	s.NoError(MkdirAll(dir, testDirMode))
 	s.assertDirectoryExists(dir)
 
 	subDirPath := filepath.Join(dir, "subdir_1", "subdir_2", "subdir_3")
 	s.assertDirectoryNotExists(subDirPath)
-	s.NoError(mkdirAll(subDirPath, testDirMode))
+	s.NoError(MkdirAll(subDirPath, testDirMode))
 	s.assertDirectoryExists(subDirPath)
 	s.assertCorrectFileMode(subDirPath)
 
 	filename := "test-file-name"
 	s.createFile(dir, filename)
 	fpath := filepath.Join(dir, filename)
-	s.Error(mkdirAll(fpath, testDirMode))
+	s.Error(MkdirAll(fpath, testDirMode))
 }
 
 func (s *UtilSuite) TestWriteFile() {
@@ -121,15 +121,15 @@ func (s *UtilSuite) TestWriteFile() {
 
 	filename := "test-file-name"
 	fpath := filepath.Join(dir, filename)
-	s.NoError(writeFile(fpath, []byte("file body 1"), testFileMode))
+	s.NoError(WriteFile(fpath, []byte("file body 1"), testFileMode))
 	s.assertFileExists(fpath)
 	s.assertCorrectFileMode(fpath)
 
-	s.NoError(writeFile(fpath, []byte("file body 2"), testFileMode))
+	s.NoError(WriteFile(fpath, []byte("file body 2"), testFileMode))
 	s.assertFileExists(fpath)
 	s.assertCorrectFileMode(fpath)
 
-	s.Error(writeFile(dir, []byte(""), testFileMode))
+	s.Error(WriteFile(dir, []byte(""), testFileMode))
 	s.assertFileExists(fpath)
 }
 
@@ -139,13 +139,13 @@ func (s *UtilSuite) TestReadFile() {
 
 	filename := "test-file-name"
 	fpath := filepath.Join(dir, filename)
-	data, err := readFile(fpath)
+	data, err := ReadFile(fpath)
 	s.Error(err)
 	s.Empty(data)
 
-	err = writeFile(fpath, []byte("file contents"), testFileMode)
+	err = WriteFile(fpath, []byte("file contents"), testFileMode)
 	s.NoError(err)
-	data, err = readFile(fpath)
+	data, err = ReadFile(fpath)
 	s.NoError(err)
 	s.Equal("file contents", string(data))
 }
@@ -161,7 +161,7 @@ func (s *UtilSuite) TestListFilesByPrefix() {
 	s.Nil(files)
 
 	subDirPath := filepath.Join(dir, "subdir")
-	s.NoError(mkdirAll(subDirPath, testDirMode))
+	s.NoError(MkdirAll(subDirPath, testDirMode))
 	s.assertDirectoryExists(subDirPath)
 	expectedFileNames := []string{"file_1", "file_2", "file_3"}
 	for _, f := range expectedFileNames {
@@ -175,6 +175,34 @@ func (s *UtilSuite) TestListFilesByPrefix() {
 	s.Equal(len(expectedFileNames), len(actualFileNames))
 }
 
+func (s *UtilSuite) TestHistoryFileWriter_ConcurrentWriters() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestHistoryFileWriter_ConcurrentWriters")
+	fpath := filepath.Join(dir, constructHistoryFilename("namespaceID", "workflowID", "runID", 1))
+	historyBatches := []*historypb.History{
+		{Events: []*historypb.HistoryEvent{{EventId: common.FirstEventID, Version: 1}}},
+	}
+
+	writer1, err := newHistoryFileWriter(fpath, testFileMode, archiver.HistoryArchiveCompressionZstd, nil)
+	s.NoError(err)
+	writer2, err := newHistoryFileWriter(fpath, testFileMode, archiver.HistoryArchiveCompressionZstd, nil)
+	s.NoError(err)
+	s.NotEqual(writer1.file.Name(), writer2.file.Name())
+
+	s.NoError(writer1.Write(historyBatches))
+	s.NoError(writer2.Write(historyBatches))
+	s.NoError(writer2.Commit())
+	writer1.Abort()
+
+	s.assertCorrectFileMode(fpath)
+	readBatches, nextPageToken, err := readHistoryBatches(fpath, &getHistoryToken{CloseFailoverVersion: 1}, 100, nil)
+	s.NoError(err)
+	s.Nil(nextPageToken)
+	protorequire.ProtoSliceEqual(s.T(), historyBatches, readBatches)
+	files, err := ListFiles(dir)
+	s.NoError(err)
+	s.Len(files, 1)
+}
+
 func (s *UtilSuite) TestEncodeDecodeHistoryBatches() {
 	now := time.Date(2020, 8, 22, 1, 2, 3, 4, time.UTC)
 	historyBatches := []*historypb.History{
@@ -226,7 +254,7 @@ func (s *UtilSuite) TestValidateDirPath() {
 	}{
 		{
 			dirPath:     "",
-			expectedErr: errEmptyDirectoryPath,
+			expectedErr: ErrEmptyDirectoryPath,
 		},
 		{
 			dirPath:     "/absolute/path",
@@ -242,12 +270,12 @@ func (s *UtilSuite) TestValidateDirPath() {
 		},
 		{
 			dirPath:     fpath,
-			expectedErr: errDirectoryExpected,
+			expectedErr: ErrDirectoryExpected,
 		},
 	}
 
 	for _, tc := range testCases {
-		s.Equal(tc.expectedErr, validateDirPath(tc.dirPath))
+		s.Equal(tc.expectedErr, ValidateDirPath(tc.dirPath))
 	}
 }
 
@@ -442,13 +470,13 @@ func (s *UtilSuite) assertFileExists(filepath string) {
 }
 
 func (s *UtilSuite) assertDirectoryExists(path string) {
-	exists, err := directoryExists(path)
+	exists, err := DirectoryExists(path)
 	s.NoError(err)
 	s.True(exists)
 }
 
 func (s *UtilSuite) assertDirectoryNotExists(path string) {
-	exists, err := directoryExists(path)
+	exists, err := DirectoryExists(path)
 	s.NoError(err)
 	s.False(exists)
 }
@@ -462,7 +490,3 @@ func (s *UtilSuite) assertCorrectFileMode(path string) {
 	}
 	s.Equal(mode, info.Mode())
 }
-
-func toWorkflowExecutionStatusPtr(in enumspb.WorkflowExecutionStatus) *enumspb.WorkflowExecutionStatus {
-	return &in
-}
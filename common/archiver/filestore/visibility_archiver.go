@@ -40,6 +40,7 @@ import (
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/visibilityquery"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -47,15 +48,14 @@ import (
 )
 
 const (
-	errEncodeVisibilityRecord = "failed to encode visibility record"
+	errEncodeVisibilityRecord = "failed to Encode visibility record"
 )
 
 type (
 	visibilityArchiver struct {
-		container   *archiver.VisibilityBootstrapContainer
-		fileMode    os.FileMode
-		dirMode     os.FileMode
-		queryParser QueryParser
+		container *archiver.VisibilityBootstrapContainer
+		fileMode  os.FileMode
+		dirMode   os.FileMode
 	}
 
 	queryVisibilityToken struct {
@@ -67,7 +67,7 @@ type (
 		namespaceID   string
 		pageSize      int
 		nextPageToken []byte
-		parsedQuery   *parsedQuery
+		query         *visibilityquery.Query
 	}
 )
 
@@ -85,10 +85,9 @@ func NewVisibilityArchiver(
 		return nil, errInvalidDirMode
 	}
 	return &visibilityArchiver{
-		container:   container,
-		fileMode:    os.FileMode(fileMode),
-		dirMode:     os.FileMode(dirMode),
-		queryParser: NewQueryParser(),
+		container: container,
+		fileMode:  os.FileMode(fileMode),
+		dirMode:   os.FileMode(dirMode),
 	}, nil
 }
 
@@ -118,12 +117,12 @@ func (v *visibilityArchiver) Archive(
 	}
 
 	dirPath := path.Join(URI.Path(), request.GetNamespaceId())
-	if err = mkdirAll(dirPath, v.dirMode); err != nil {
+	if err = MkdirAll(dirPath, v.dirMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
 		return err
 	}
 
-	encodedVisibilityRecord, err := encode(request)
+	encodedVisibilityRecord, err := Encode(request)
 	if err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
 		return err
@@ -132,7 +131,7 @@ func (v *visibilityArchiver) Archive(
 	// The filename has the format: closeTimestamp_hash(runID).visibility
 	// This format allows the archiver to sort all records without reading the file contents
 	filename := constructVisibilityFilename(request.CloseTime.AsTime(), request.GetRunId())
-	if err := writeFile(path.Join(dirPath, filename), encodedVisibilityRecord, v.fileMode); err != nil {
+	if err := WriteFile(path.Join(dirPath, filename), encodedVisibilityRecord, v.fileMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 		return err
 	}
@@ -154,12 +153,12 @@ func (v *visibilityArchiver) Query(
 		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
 	}
 
-	parsedQuery, err := v.queryParser.Parse(request.Query)
+	query, err := visibilityquery.Parse(request.Query, saTypeMap)
 	if err != nil {
 		return nil, serviceerror.NewInvalidArgument(err.Error())
 	}
 
-	if parsedQuery.emptyResult {
+	if query.EmptyResult() {
 		return &archiver.QueryVisibilityResponse{}, nil
 	}
 
@@ -170,7 +169,7 @@ func (v *visibilityArchiver) Query(
 			namespaceID:   request.NamespaceID,
 			pageSize:      request.PageSize,
 			nextPageToken: request.NextPageToken,
-			parsedQuery:   parsedQuery,
+			query:         query,
 		},
 		saTypeMap,
 	)
@@ -192,7 +191,7 @@ func (v *visibilityArchiver) query(
 	}
 
 	dirPath := path.Join(URI.Path(), request.namespaceID)
-	exists, err := directoryExists(dirPath)
+	exists, err := DirectoryExists(dirPath)
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
@@ -200,12 +199,12 @@ func (v *visibilityArchiver) query(
 		return &archiver.QueryVisibilityResponse{}, nil
 	}
 
-	files, err := listFiles(dirPath)
+	files, err := ListFiles(dirPath)
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
 
-	files, err = sortAndFilterFiles(files, token)
+	files, err = sortAndFilterFiles(files, token, request.query.Ascending())
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
@@ -215,22 +214,29 @@ func (v *visibilityArchiver) query(
 
 	response := &archiver.QueryVisibilityResponse{}
 	for idx, file := range files {
-		encodedRecord, err := readFile(path.Join(dirPath, file))
+		encodedRecord, err := ReadFile(path.Join(dirPath, file))
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
 
-		record, err := decodeVisibilityRecord(encodedRecord)
+		record, err := DecodeVisibilityRecord(encodedRecord)
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
 
-		if record.CloseTime.AsTime().Before(request.parsedQuery.earliestCloseTime) {
+		// files are sorted by close time, so none of the remaining records can match
+		earliestCloseTime, latestCloseTime := request.query.CloseTimeRange()
+		if request.query.Ascending() && record.CloseTime.AsTime().After(latestCloseTime) ||
+			!request.query.Ascending() && record.CloseTime.AsTime().Before(earliestCloseTime) {
 			break
 		}
 
-		if matchQuery(record, request.parsedQuery) {
-			executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+		match, err := request.query.Match(record)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		if match {
+			executionInfo, err := ConvertToExecutionInfo(record, saTypeMap)
 			if err != nil {
 				return nil, serviceerror.NewInternal(err.Error())
 			}
@@ -261,7 +267,7 @@ func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 		return archiver.ErrURISchemeMismatch
 	}
 
-	return validateDirPath((URI.Path()))
+	return ValidateDirPath((URI.Path()))
 }
 
 type parsedVisFilename struct {
@@ -270,9 +276,10 @@ type parsedVisFilename struct {
 	hashedRunID string
 }
 
-// sortAndFilterFiles sort visibility record file names based on close timestamp (desc) and use hashed runID to break ties.
-// if a nextPageToken is give, it only returns filenames that have a smaller close timestamp
-func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]string, error) {
+// sortAndFilterFiles sort visibility record file names based on close timestamp (desc, or asc if ascending is true)
+// and use hashed runID to break ties.
+// if a nextPageToken is give, it only returns filenames that come after the token in that order
+func sortAndFilterFiles(filenames []string, token *queryVisibilityToken, ascending bool) ([]string, error) {
 	var parsedFilenames []*parsedVisFilename
 	for _, name := range filenames {
 		pieces := strings.FieldsFunc(name, func(r rune) bool {
@@ -293,21 +300,23 @@ func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]stri
 		})
 	}
 
-	sort.Slice(parsedFilenames, func(i, j int) bool {
-		if parsedFilenames[i].closeTime.Equal(parsedFilenames[j].closeTime) {
-			return parsedFilenames[i].hashedRunID > parsedFilenames[j].hashedRunID
+	// before returns whether a file with the given close time and hashed runID comes before the other one
+	before := func(closeTime time.Time, hashedRunID string, otherCloseTime time.Time, otherHashedRunID string) bool {
+		if closeTime.Equal(otherCloseTime) {
+			return ascending && hashedRunID < otherHashedRunID || !ascending && hashedRunID > otherHashedRunID
 		}
-		return parsedFilenames[i].closeTime.After(parsedFilenames[j].closeTime)
+		return ascending && closeTime.Before(otherCloseTime) || !ascending && closeTime.After(otherCloseTime)
+	}
+
+	sort.Slice(parsedFilenames, func(i, j int) bool {
+		return before(parsedFilenames[i].closeTime, parsedFilenames[i].hashedRunID, parsedFilenames[j].closeTime, parsedFilenames[j].hashedRunID)
 	})
 
 	startIdx := 0
 	if token != nil {
 		LastHashedRunID := hash(token.LastRunID)
 		startIdx = sort.Search(len(parsedFilenames), func(i int) bool {
-			if parsedFilenames[i].closeTime.Equal(token.LastCloseTime) {
-				return parsedFilenames[i].hashedRunID < LastHashedRunID
-			}
-			return parsedFilenames[i].closeTime.Before(token.LastCloseTime)
+			return before(token.LastCloseTime, LastHashedRunID, parsedFilenames[i].closeTime, parsedFilenames[i].hashedRunID)
 		})
 	}
 
@@ -322,27 +331,7 @@ func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]stri
 	return filteredFilenames, nil
 }
 
-func matchQuery(record *archiverspb.VisibilityRecord, query *parsedQuery) bool {
-	closeTime := record.CloseTime.AsTime()
-	if closeTime.Before(query.earliestCloseTime) || closeTime.After(query.latestCloseTime) {
-		return false
-	}
-	if query.workflowID != nil && record.GetWorkflowId() != *query.workflowID {
-		return false
-	}
-	if query.runID != nil && record.GetRunId() != *query.runID {
-		return false
-	}
-	if query.workflowTypeName != nil && record.WorkflowTypeName != *query.workflowTypeName {
-		return false
-	}
-	if query.status != nil && record.Status != *query.status {
-		return false
-	}
-	return true
-}
-
-func convertToExecutionInfo(record *archiverspb.VisibilityRecord, saTypeMap searchattribute.NameTypeMap) (*workflowpb.WorkflowExecutionInfo, error) {
+func ConvertToExecutionInfo(record *archiverspb.VisibilityRecord, saTypeMap searchattribute.NameTypeMap) (*workflowpb.WorkflowExecutionInfo, error) {
 	searchAttributes, err := searchattribute.Parse(record.SearchAttributes, &saTypeMap)
 	if err != nil {
 		return nil, err
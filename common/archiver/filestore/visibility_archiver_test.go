@@ -49,7 +49,6 @@ import (
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
-	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/tests/testutils"
 )
 
@@ -111,7 +110,7 @@ func (s *visibilityArchiverSuite) TestValidateURI() {
 		},
 		{
 			URI:         "file://",
-			expectedErr: errEmptyDirectoryPath,
+			expectedErr: ErrEmptyDirectoryPath,
 		},
 		{
 			URI:         "file:///a/b/c",
@@ -199,7 +198,7 @@ func (s *visibilityArchiverSuite) TestArchive_Success() {
 	filepath := path.Join(dir, testNamespaceID, expectedFilename)
 	s.assertFileExists(filepath)
 
-	data, err := readFile(filepath)
+	data, err := ReadFile(filepath)
 	s.NoError(err)
 
 	archivedRecord := &archiverspb.VisibilityRecord{}
@@ -209,94 +208,11 @@ func (s *visibilityArchiverSuite) TestArchive_Success() {
 	s.Equal(request, archivedRecord)
 }
 
-func (s *visibilityArchiverSuite) TestMatchQuery() {
-	testCases := []struct {
-		query       *parsedQuery
-		record      *archiverspb.VisibilityRecord
-		shouldMatch bool
-	}{
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-			},
-			record: &archiverspb.VisibilityRecord{
-				CloseTime: timestamp.UnixOrZeroTimePtr(1999),
-			},
-			shouldMatch: true,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-			},
-			record: &archiverspb.VisibilityRecord{
-				CloseTime: timestamp.UnixOrZeroTimePtr(999),
-			},
-			shouldMatch: false,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-				workflowID:        util.Ptr("random workflowID"),
-			},
-			record: &archiverspb.VisibilityRecord{
-				CloseTime: timestamp.UnixOrZeroTimePtr(2000),
-			},
-			shouldMatch: false,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-				workflowID:        util.Ptr("random workflowID"),
-				runID:             util.Ptr("random runID"),
-			},
-			record: &archiverspb.VisibilityRecord{
-				CloseTime:        timestamp.UnixOrZeroTimePtr(12345),
-				WorkflowId:       "random workflowID",
-				RunId:            "random runID",
-				WorkflowTypeName: "random type name",
-			},
-			shouldMatch: true,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-				workflowTypeName:  util.Ptr("some random type name"),
-			},
-			record: &archiverspb.VisibilityRecord{
-				CloseTime: timestamp.UnixOrZeroTimePtr(12345),
-			},
-			shouldMatch: false,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-				workflowTypeName:  util.Ptr("some random type name"),
-				status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW),
-			},
-			record: &archiverspb.VisibilityRecord{
-				CloseTime:        timestamp.UnixOrZeroTimePtr(12345),
-				Status:           enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW,
-				WorkflowTypeName: "some random type name",
-			},
-			shouldMatch: true,
-		},
-	}
-
-	for _, tc := range testCases {
-		s.Equal(tc.shouldMatch, matchQuery(tc.record, tc.query))
-	}
-}
-
 func (s *visibilityArchiverSuite) TestSortAndFilterFiles() {
 	testCases := []struct {
 		filenames      []string
 		token          *queryVisibilityToken
+		ascending      bool
 		expectedResult []string
 	}{
 		{
@@ -324,10 +240,23 @@ func (s *visibilityArchiverSuite) TestSortAndFilterFiles() {
 			},
 			expectedResult: []string{"5_0.vis"},
 		},
+		{
+			filenames:      []string{"9_12345.vis", "5_0.vis", "9_54321.vis", "1000_654.vis", "1000_78.vis"},
+			ascending:      true,
+			expectedResult: []string{"5_0.vis", "9_12345.vis", "9_54321.vis", "1000_654.vis", "1000_78.vis"},
+		},
+		{
+			filenames: []string{"9_12345.vis", "5_0.vis", "9_54321.vis", "1000_654.vis", "1000_78.vis"},
+			token: &queryVisibilityToken{
+				LastCloseTime: time.Unix(0, 10).UTC(),
+			},
+			ascending:      true,
+			expectedResult: []string{"1000_654.vis", "1000_78.vis"},
+		},
 	}
 
 	for i, tc := range testCases {
-		result, err := sortAndFilterFiles(tc.filenames, tc.token)
+		result, err := sortAndFilterFiles(tc.filenames, tc.token, tc.ascending)
 		s.NoError(err, "case %d", i)
 		s.Equal(tc.expectedResult, result, "case %d", i)
 	}
@@ -355,9 +284,6 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
 
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
-	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
 		NamespaceID: "some random namespaceID",
 		PageSize:    10,
@@ -369,15 +295,9 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: time.Unix(0, 1),
-		latestCloseTime:   time.Unix(0, 101),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
-		Query:       "parsed by mockParser",
+		Query:       "CloseTime >= 1 and CloseTime <= 101",
 		PageSize:    1,
 	}
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request, searchattribute.TestNameTypeMap)
@@ -389,15 +309,9 @@ func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: time.Unix(0, 1),
-		latestCloseTime:   time.Unix(0, 101),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID:   testNamespaceID,
-		Query:         "parsed by mockParser",
+		Query:         "CloseTime >= 1 and CloseTime <= 101",
 		PageSize:      1,
 		NextPageToken: []byte{1, 2, 3},
 	}
@@ -408,17 +322,10 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: time.Unix(0, 1),
-		latestCloseTime:   time.Unix(0, 10001),
-		workflowID:        util.Ptr(testWorkflowID),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
 		PageSize:    10,
-		Query:       "parsed by mockParser",
+		Query:       "WorkflowId = '" + testWorkflowID + "' and CloseTime between 1 and 10001",
 	}
 	URI, err := archiver.NewURI("file://" + s.testQueryDirectory)
 	s.NoError(err)
@@ -427,24 +334,17 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	s.NotNil(response)
 	s.Nil(response.NextPageToken)
 	s.Len(response.Executions, 1)
-	ei, err := convertToExecutionInfo(s.visibilityRecords[0], searchattribute.TestNameTypeMap)
+	ei, err := ConvertToExecutionInfo(s.visibilityRecords[0], searchattribute.TestNameTypeMap)
 	s.NoError(err)
 	s.Equal(ei, response.Executions[0])
 }
 
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: time.Unix(0, 1),
-		latestCloseTime:   time.Unix(0, 10001),
-		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
 		PageSize:    2,
-		Query:       "parsed by mockParser",
+		Query:       "ExecutionStatus = 'Failed' and CloseTime between 1 and 10001",
 	}
 	URI, err := archiver.NewURI("file://" + s.testQueryDirectory)
 	s.NoError(err)
@@ -453,10 +353,10 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.NotNil(response)
 	s.NotNil(response.NextPageToken)
 	s.Len(response.Executions, 2)
-	ei, err := convertToExecutionInfo(s.visibilityRecords[0], searchattribute.TestNameTypeMap)
+	ei, err := ConvertToExecutionInfo(s.visibilityRecords[0], searchattribute.TestNameTypeMap)
 	s.NoError(err)
 	s.Equal(ei, response.Executions[0])
-	ei, err = convertToExecutionInfo(s.visibilityRecords[1], searchattribute.TestNameTypeMap)
+	ei, err = ConvertToExecutionInfo(s.visibilityRecords[1], searchattribute.TestNameTypeMap)
 	s.NoError(err)
 	s.Equal(ei, response.Executions[1])
 
@@ -466,7 +366,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.NotNil(response)
 	s.Nil(response.NextPageToken)
 	s.Len(response.Executions, 1)
-	ei, err = convertToExecutionInfo(s.visibilityRecords[3], searchattribute.TestNameTypeMap)
+	ei, err = ConvertToExecutionInfo(s.visibilityRecords[3], searchattribute.TestNameTypeMap)
 	s.NoError(err)
 	s.Equal(ei, response.Executions[0])
 }
@@ -475,13 +375,6 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	dir := testutils.MkdirTemp(s.T(), "", "TestArchiveAndQuery")
 
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: time.Unix(0, 10),
-		latestCloseTime:   time.Unix(0, 10001),
-		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
 	URI, err := archiver.NewURI("file://" + dir)
 	s.NoError(err)
 	for _, record := range s.visibilityRecords {
@@ -492,7 +385,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
 		PageSize:    1,
-		Query:       "parsed by mockParser",
+		Query:       "ExecutionStatus = 'Failed' and CloseTime between 10 and 10001",
 	}
 	executions := []*workflowpb.WorkflowExecutionInfo{}
 	for len(executions) == 0 || request.NextPageToken != nil {
@@ -503,25 +396,48 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 		request.NextPageToken = response.NextPageToken
 	}
 	s.Len(executions, 2)
-	ei, err := convertToExecutionInfo(s.visibilityRecords[0], searchattribute.TestNameTypeMap)
+	ei, err := ConvertToExecutionInfo(s.visibilityRecords[0], searchattribute.TestNameTypeMap)
 	s.NoError(err)
 	s.Equal(ei, executions[0])
-	ei, err = convertToExecutionInfo(s.visibilityRecords[1], searchattribute.TestNameTypeMap)
+	ei, err = ConvertToExecutionInfo(s.visibilityRecords[1], searchattribute.TestNameTypeMap)
 	s.NoError(err)
 	s.Equal(ei, executions[1])
 }
 
+func (s *visibilityArchiverSuite) TestQuery_Success_OrderByCloseTime() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    2,
+		Query:       "(ExecutionStatus = 'Failed' or HistoryLength > 400) and CloseTime > 5 order by CloseTime asc",
+	}
+	URI, err := archiver.NewURI("file://" + s.testQueryDirectory)
+	s.NoError(err)
+	response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.NotNil(response.NextPageToken)
+	s.Len(response.Executions, 2)
+	ei, err := ConvertToExecutionInfo(s.visibilityRecords[2], searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Equal(ei, response.Executions[0])
+	ei, err = ConvertToExecutionInfo(s.visibilityRecords[1], searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Equal(ei, response.Executions[1])
+
+	request.NextPageToken = response.NextPageToken
+	response, err = visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 1)
+	ei, err = ConvertToExecutionInfo(s.visibilityRecords[0], searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Equal(ei, response.Executions[0])
+}
+
 func (s *visibilityArchiverSuite) TestQuery_EmptyQuery_InvalidNamespace() {
 	URI := s.testArchivalURI
 
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: time.Unix(0, 10),
-		latestCloseTime:   time.Unix(0, 10001),
-		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
 	req := &archiver.QueryVisibilityRequest{
 		NamespaceID:   "",
 		PageSize:      1,
@@ -657,11 +573,11 @@ func (s *visibilityArchiverSuite) setupVisibilityDirectory() {
 }
 
 func (s *visibilityArchiverSuite) writeVisibilityRecordForQueryTest(record *archiverspb.VisibilityRecord) {
-	data, err := encode(record)
+	data, err := Encode(record)
 	s.Require().NoError(err)
 	filename := constructVisibilityFilename(record.CloseTime.AsTime(), record.GetRunId())
 	s.Require().NoError(os.MkdirAll(path.Join(s.testQueryDirectory, record.GetNamespaceId()), testDirMode))
-	err = writeFile(path.Join(s.testQueryDirectory, record.GetNamespaceId(), filename), data, testFileMode)
+	err = WriteFile(path.Join(s.testQueryDirectory, record.GetNamespaceId(), filename), data, testFileMode)
 	s.Require().NoError(err)
 }
 
@@ -305,6 +305,30 @@ func (s *clientSuite) TestQueryWithFilter() {
 	s.Equal(strings.Join(fileNames, ", "), "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility")
 }
 
+func (s *clientSuite) TestQueryWithFilter_PageCompleted() {
+	ctx := context.Background()
+	mockBucketHandleClient := connector.NewMockBucketHandleWrapper(s.controller)
+	mockStorageClient := connector.NewMockGcloudStorageClient(s.controller)
+	mockObjectIterator := connector.NewMockObjectIteratorWrapper(s.controller)
+	storageWrapper, _ := connector.NewClientWithParams(mockStorageClient)
+
+	attr := new(storage.ObjectAttrs)
+	attr.Name = "closeTimeout_2020-02-27T09:42:28Z_12851121011173788097_4418294404690464320_15619178330501475177.visibility"
+
+	mockStorageClient.EXPECT().Bucket("my-bucket-cad").Return(mockBucketHandleClient)
+	mockBucketHandleClient.EXPECT().Objects(ctx, gomock.Any()).Return(mockObjectIterator)
+	mockObjectIterator.EXPECT().Next().Return(attr, nil).Times(3)
+
+	URI, err := archiver.NewURI("gs://my-bucket-cad/temporal_archival/development")
+	s.Require().NoError(err)
+	fileNames, completed, currentPos, err := storageWrapper.QueryWithFilters(ctx, URI, "closeTimeout_2020-02-27T09:42:28Z", 2, 0, nil)
+
+	s.Require().NoError(err)
+	s.Len(fileNames, 2)
+	s.False(completed)
+	s.Equal(2, currentPos)
+}
+
 func newWorkflowIDPrecondition(workflowID string) connector.Precondition {
 	return func(subject interface{}) bool {
 
@@ -37,7 +37,6 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/gcloud/connector"
-	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -47,6 +46,7 @@ import (
 
 var (
 	errUploadNonRetryable = errors.New("upload non-retryable error")
+	errInvalidCompression = errors.New("invalid compression")
 )
 
 const (
@@ -62,6 +62,8 @@ const (
 type historyArchiver struct {
 	container     *archiver.HistoryBootstrapContainer
 	gcloudStorage connector.Client
+	// compression of newly archived history
+	compression archiver.HistoryArchiveCompression
 
 	// only set in test code
 	historyIterator archiver.HistoryIterator
@@ -84,17 +86,24 @@ func NewHistoryArchiver(
 	container *archiver.HistoryBootstrapContainer,
 	config *config.GstorageArchiver,
 ) (archiver.HistoryArchiver, error) {
+	compression, err := archiver.ParseHistoryArchiveCompression(config.Compression)
+	if err != nil {
+		return nil, errInvalidCompression
+	}
 	storage, err := connector.NewClient(context.Background(), config)
-	if err == nil {
-		return newHistoryArchiver(container, nil, storage), nil
+	if err != nil {
+		return nil, err
 	}
-	return nil, err
+	historyArchiver := newHistoryArchiver(container, nil, storage)
+	historyArchiver.compression = compression
+	return historyArchiver, nil
 }
 
-func newHistoryArchiver(container *archiver.HistoryBootstrapContainer, historyIterator archiver.HistoryIterator, storage connector.Client) archiver.HistoryArchiver {
+func newHistoryArchiver(container *archiver.HistoryBootstrapContainer, historyIterator archiver.HistoryIterator, storage connector.Client) *historyArchiver {
 	return &historyArchiver{
 		container:       container,
 		gcloudStorage:   storage,
+		compression:     archiver.HistoryArchiveCompressionZstd,
 		historyIterator: historyIterator,
 	}
 }
@@ -146,8 +155,6 @@ func (h *historyArchiver) Archive(ctx context.Context, URI archiver.URI, request
 		historyIterator, _ = loadHistoryIterator(ctx, request, h.container.ExecutionManager, featureCatalog, &progress)
 	}
 
-	encoder := codec.NewJSONPBEncoder()
-
 	for historyIterator.HasNext() {
 		part := progress.CurrentPageNumber
 		historyBlob, err := historyIterator.Next(ctx)
@@ -175,7 +182,7 @@ func (h *historyArchiver) Archive(ctx context.Context, URI archiver.URI, request
 			return archiver.ErrHistoryMutated
 		}
 
-		encodedHistoryPart, err := encoder.EncodeHistories(historyBlob.Body)
+		encodedHistoryPart, err := encodeHistoryPart(historyBlob.Body, h.compression, h.container.HistoryArchiveCipher)
 		if err != nil {
 			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 			return errUploadNonRetryable
@@ -242,7 +249,6 @@ func (h *historyArchiver) Get(ctx context.Context, URI archiver.URI, request *ar
 	response := &archiver.GetHistoryResponse{}
 	response.HistoryBatches = []*historypb.History{}
 	numOfEvents := 0
-	encoder := codec.NewJSONPBEncoder()
 
 outer:
 	for token.CurrentPart <= token.HighestPart {
@@ -256,7 +262,7 @@ outer:
 			return nil, serviceerror.NewInternal("Fail retrieving history file: " + URI.String() + "/" + filename)
 		}
 
-		batches, err := encoder.DecodeHistories(encodedHistoryBatches)
+		batches, err := decodeHistoryPart(encodedHistoryBatches, h.container.HistoryArchiveCipher)
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
@@ -42,8 +42,10 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/gcloud/connector"
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/util"
 )
 
@@ -412,6 +414,91 @@ func (h *historyArchiverSuite) TestArchive_Success() {
 	h.NoError(err)
 }
 
+func (h *historyArchiverSuite) TestArchiveAndGet_HistoryArchiveFormat() {
+	ctx := context.Background()
+	historyBatches := []*historypb.History{
+		{
+			Events: []*historypb.HistoryEvent{
+				{
+					EventId:   common.FirstEventID + 1,
+					EventTime: timestamppb.New(time.Now().UTC()),
+					Version:   testCloseFailoverVersion,
+				},
+			},
+		},
+		{
+			Events: []*historypb.HistoryEvent{
+				{
+					EventId:   testNextEventID - 1,
+					EventTime: timestamppb.New(time.Now().UTC()),
+					Version:   testCloseFailoverVersion,
+				},
+			},
+		},
+	}
+	historyBlob := &archiverspb.HistoryBlob{
+		Header: &archiverspb.HistoryBlobHeader{
+			IsLast: true,
+		},
+		Body: historyBatches,
+	}
+
+	files := make(map[string][]byte)
+	storageWrapper := connector.NewMockClient(h.controller)
+	storageWrapper.EXPECT().Exist(ctx, h.testArchivalURI, gomock.Any()).Return(false, nil).AnyTimes()
+	storageWrapper.EXPECT().Upload(ctx, h.testArchivalURI, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ archiver.URI, fileName string, data []byte) error {
+			files[fileName] = data
+			return nil
+		})
+	storageWrapper.EXPECT().Get(ctx, h.testArchivalURI, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ archiver.URI, fileName string) ([]byte, error) {
+			return files[fileName], nil
+		})
+	historyIterator := archiver.NewMockHistoryIterator(h.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := newHistoryArchiver(h.container, historyIterator, storageWrapper)
+	request := &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	h.NoError(historyArchiver.Archive(ctx, h.testArchivalURI, request))
+	h.Len(files, 1)
+	for _, data := range files {
+		h.NotContains(string(data), "eventTime")
+	}
+
+	token, err := serializeToken(&getHistoryToken{
+		CloseFailoverVersion: testCloseFailoverVersion,
+	})
+	h.NoError(err)
+	response, err := historyArchiver.Get(ctx, h.testArchivalURI, &archiver.GetHistoryRequest{
+		NamespaceID:   testNamespaceID,
+		WorkflowID:    testWorkflowID,
+		RunID:         testRunID,
+		PageSize:      testPageSize,
+		NextPageToken: token,
+	})
+	h.NoError(err)
+	h.Nil(response.NextPageToken)
+	protorequire.ProtoSliceEqual(h.T(), historyBatches, response.HistoryBatches)
+}
+
+func (h *historyArchiverSuite) TestNewHistoryArchiver_InvalidCompression() {
+	_, err := NewHistoryArchiver(h.container, &config.GstorageArchiver{Compression: "lz4"})
+	h.ErrorIs(err, errInvalidCompression)
+}
+
 func (h *historyArchiverSuite) TestGet_Fail_InvalidURI() {
 	ctx := context.Background()
 	mockStorageClient := connector.NewMockGcloudStorageClient(h.controller)
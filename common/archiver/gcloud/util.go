@@ -25,21 +25,26 @@
 package gcloud
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/dgryski/go-farm"
 	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"google.golang.org/protobuf/proto"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/gcloud/connector"
+	"go.temporal.io/server/common/archiver/visibilityquery"
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/searchattribute"
 )
@@ -49,6 +54,54 @@ func encode(message proto.Message) ([]byte, error) {
 	return encoder.Encode(message)
 }
 
+// encodeHistoryPart encodes the history batches of a part in the history archive format
+func encodeHistoryPart(
+	historyBatches []*historypb.History,
+	compression archiver.HistoryArchiveCompression,
+	cipher archiver.HistoryArchiveCipher,
+) ([]byte, error) {
+	var part bytes.Buffer
+	writer, err := archiver.NewHistoryArchiveWriter(&part, compression, cipher)
+	if err != nil {
+		return nil, err
+	}
+	for _, batch := range historyBatches {
+		if err := writer.Write(batch); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return part.Bytes(), nil
+}
+
+// decodeHistoryPart decodes the history batches of a part, archived either in the
+// history archive format or in JSON format by older versions
+func decodeHistoryPart(data []byte, cipher archiver.HistoryArchiveCipher) ([]*historypb.History, error) {
+	reader, err := archiver.NewHistoryArchiveReader(bufio.NewReader(bytes.NewReader(data)), cipher)
+	if err == archiver.ErrLegacyHistoryArchive {
+		encoder := codec.NewJSONPBEncoder()
+		return encoder.DecodeHistories(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var historyBatches []*historypb.History
+	for {
+		batch, err := reader.Next()
+		if err == io.EOF {
+			return historyBatches, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		historyBatches = append(historyBatches, batch)
+	}
+}
+
 func constructHistoryFilenameMultipart(namespaceID, workflowID, runID string, version int64, partNumber int) string {
 	combinedHash := constructHistoryFilenamePrefix(namespaceID, workflowID, runID)
 	return fmt.Sprintf("%s_%v_%v.history", combinedHash, version, partNumber)
@@ -62,23 +115,14 @@ func constructVisibilityFilenamePrefix(namespaceID, tag string) string {
 	return fmt.Sprintf("%s/%s", namespaceID, tag)
 }
 
-func constructTimeBasedSearchKey(namespaceID, tag string, t time.Time, precision string) string {
-	var timeFormat = ""
-	switch precision {
-	case PrecisionSecond:
-		timeFormat = ":05"
-		fallthrough
-	case PrecisionMinute:
-		timeFormat = ":04" + timeFormat
-		fallthrough
-	case PrecisionHour:
-		timeFormat = "15" + timeFormat
-		fallthrough
-	case PrecisionDay:
-		timeFormat = "2006-01-02T" + timeFormat
-	}
-
-	return fmt.Sprintf("%s_%s", constructVisibilityFilenamePrefix(namespaceID, tag), t.Format(timeFormat))
+// constructTimeRangeSearchPrefix returns the filename prefix shared by all visibility files of the given tag
+// with a timestamp within [earliest, latest].
+func constructTimeRangeSearchPrefix(namespaceID, tag string, earliest, latest time.Time) string {
+	return fmt.Sprintf(
+		"%s_%s",
+		constructVisibilityFilenamePrefix(namespaceID, tag),
+		visibilityquery.TimeRangePrefix(earliest, latest, time.RFC3339),
+	)
 }
 
 func hash(s string) (result string) {
@@ -34,6 +34,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver/visibilityquery"
 	"go.temporal.io/server/common/codec"
 )
 
@@ -136,9 +137,11 @@ func (s *utilSuite) TestConstructVisibilityFilenamePrefix() {
 	s.Equal("namespaceID/startTimeout", constructVisibilityFilenamePrefix("namespaceID", indexKeyStartTimeout))
 }
 
-func (s *utilSuite) TestConstructTimeBasedSearchKey() {
-	t, _ := time.Parse(time.RFC3339, "2019-10-04T11:00:00+00:00")
-	s.Equal("namespaceID/startTimeout_2019-10-04T", constructTimeBasedSearchKey("namespaceID", indexKeyStartTimeout, t, "Day"))
+func (s *utilSuite) TestConstructTimeRangeSearchPrefix() {
+	earliest, _ := time.Parse(time.RFC3339, "2019-10-04T00:00:00+00:00")
+	latest, _ := time.Parse(time.RFC3339, "2019-10-04T23:59:59+00:00")
+	s.Equal("namespaceID/startTimeout_2019-10-04T", constructTimeRangeSearchPrefix("namespaceID", indexKeyStartTimeout, earliest, latest))
+	s.Equal("namespaceID/closeTimeout_", constructTimeRangeSearchPrefix("namespaceID", indexKeyCloseTimeout, time.Time{}, visibilityquery.MaxTime))
 }
 
 func (s *utilSuite) TestConstructVisibilityFilename() {
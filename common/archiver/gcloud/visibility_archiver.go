@@ -29,7 +29,6 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
-	"strings"
 	"time"
 
 	"go.temporal.io/api/serviceerror"
@@ -37,6 +36,7 @@ import (
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/gcloud/connector"
+	"go.temporal.io/server/common/archiver/visibilityquery"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -48,6 +48,8 @@ const (
 	indexKeyStartTimeout      = "startTimeout"
 	indexKeyCloseTimeout      = "closeTimeout"
 	timeoutInSeconds          = 5
+	// defaultListPageSize is the number of files listed at once when all records matching a query are read.
+	defaultListPageSize = 1000
 )
 
 var (
@@ -58,7 +60,6 @@ type (
 	visibilityArchiver struct {
 		container     *archiver.VisibilityBootstrapContainer
 		gcloudStorage connector.Client
-		queryParser   QueryParser
 	}
 
 	queryVisibilityToken struct {
@@ -69,7 +70,7 @@ type (
 		namespaceID   string
 		pageSize      int
 		nextPageToken []byte
-		parsedQuery   *parsedQuery
+		query         *visibilityquery.Query
 	}
 )
 
@@ -77,7 +78,6 @@ func newVisibilityArchiver(container *archiver.VisibilityBootstrapContainer, sto
 	return &visibilityArchiver{
 		container:     container,
 		gcloudStorage: storage,
-		queryParser:   NewQueryParser(),
 	}
 }
 
@@ -170,132 +170,195 @@ func (v *visibilityArchiver) Query(
 		return nil, &serviceerror.InvalidArgument{Message: archiver.ErrInvalidQueryVisibilityRequest.Error()}
 	}
 
-	if strings.TrimSpace(request.Query) == "" {
-		return v.queryAll(ctx, URI, request, saTypeMap)
-	}
-
-	parsedQuery, err := v.queryParser.Parse(request.Query)
+	query, err := visibilityquery.Parse(request.Query, saTypeMap)
 	if err != nil {
 		return nil, &serviceerror.InvalidArgument{Message: err.Error()}
 	}
 
-	if parsedQuery.emptyResult {
+	if query.EmptyResult() {
 		return &archiver.QueryVisibilityResponse{}, nil
 	}
 
-	return v.query(
-		ctx,
-		URI,
-		&queryVisibilityRequest{
-			namespaceID:   request.NamespaceID,
-			pageSize:      request.PageSize,
-			nextPageToken: request.NextPageToken,
-			parsedQuery:   parsedQuery,
-		},
-		saTypeMap,
-	)
+	queryRequest := &queryVisibilityRequest{
+		namespaceID:   request.NamespaceID,
+		pageSize:      request.PageSize,
+		nextPageToken: request.NextPageToken,
+		query:         query,
+	}
+	if query.HasOrderBy() {
+		return v.queryOrdered(ctx, URI, queryRequest, saTypeMap)
+	}
+	return v.query(ctx, URI, queryRequest, saTypeMap)
 }
 
+// query returns the workflow executions matching the query, in the order of their filenames.
 func (v *visibilityArchiver) query(
 	ctx context.Context,
 	uri archiver.URI,
 	request *queryVisibilityRequest,
 	saTypeMap searchattribute.NameTypeMap,
 ) (*archiver.QueryVisibilityResponse, error) {
-	prefix := constructVisibilityFilenamePrefix(request.namespaceID, indexKeyCloseTimeout)
-	if !request.parsedQuery.closeTime.IsZero() {
-		prefix = constructTimeBasedSearchKey(
-			request.namespaceID,
-			indexKeyCloseTimeout,
-			request.parsedQuery.closeTime,
-			*request.parsedQuery.searchPrecision,
-		)
-	}
-
-	if !request.parsedQuery.startTime.IsZero() {
-		prefix = constructTimeBasedSearchKey(
-			request.namespaceID,
-			indexKeyStartTimeout,
-			request.parsedQuery.startTime,
-			*request.parsedQuery.searchPrecision,
-		)
-	}
-
-	return v.queryPrefix(ctx, uri, request, saTypeMap, prefix)
-}
-
-func (v *visibilityArchiver) queryAll(
-	ctx context.Context,
-	URI archiver.URI,
-	request *archiver.QueryVisibilityRequest,
-	saTypeMap searchattribute.NameTypeMap,
-) (*archiver.QueryVisibilityResponse, error) {
-
-	return v.queryPrefix(ctx, URI, &queryVisibilityRequest{
-		namespaceID:   request.NamespaceID,
-		pageSize:      request.PageSize,
-		nextPageToken: request.NextPageToken,
-		parsedQuery:   &parsedQuery{},
-	}, saTypeMap, request.NamespaceID)
-}
-
-func (v *visibilityArchiver) queryPrefix(ctx context.Context, uri archiver.URI, request *queryVisibilityRequest, saTypeMap searchattribute.NameTypeMap, prefix string) (*archiver.QueryVisibilityResponse, error) {
 	token, err := v.parseToken(request.nextPageToken)
 	if err != nil {
 		return nil, err
 	}
 
-	filters := make([]connector.Precondition, 0)
-	if request.parsedQuery.workflowID != nil {
-		filters = append(filters, newWorkflowIDPrecondition(hash(*request.parsedQuery.workflowID)))
-	}
-
-	if request.parsedQuery.runID != nil {
-		filters = append(filters, newWorkflowIDPrecondition(hash(*request.parsedQuery.runID)))
-	}
-
-	if request.parsedQuery.workflowType != nil {
-		filters = append(filters, newWorkflowIDPrecondition(hash(*request.parsedQuery.workflowType)))
+	prefix, filters := searchPrefix(request.namespaceID, request.query)
+	response := &archiver.QueryVisibilityResponse{}
+	offset := token.Offset
+	// Records not matching the query are only skipped once they are read, so files are listed until
+	// a full page of matching records is found or all files are listed.
+	for {
+		remaining := request.pageSize - len(response.Executions)
+		records, completed, currentCursorPos, err := v.listRecords(ctx, uri, request.namespaceID, prefix, filters, request.query, remaining, offset)
+		if err != nil {
+			return nil, err
+		}
+		offset = currentCursorPos
+		for _, record := range records {
+			executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+			response.Executions = append(response.Executions, executionInfo)
+		}
+		if completed {
+			return response, nil
+		}
+		if len(response.Executions) >= request.pageSize {
+			break
+		}
 	}
 
-	filenames, completed, currentCursorPos, err := v.gcloudStorage.QueryWithFilters(ctx, uri, prefix, request.pageSize, token.Offset, filters)
+	encodedToken, err := serializeToken(&queryVisibilityToken{
+		Offset: offset,
+	})
 	if err != nil {
 		return nil, &serviceerror.InvalidArgument{Message: err.Error()}
 	}
+	response.NextPageToken = encodedToken
+	return response, nil
+}
 
-	response := &archiver.QueryVisibilityResponse{}
-	for _, file := range filenames {
-		encodedRecord, err := v.gcloudStorage.Get(ctx, uri, fmt.Sprintf("%s/%s", request.namespaceID, filepath.Base(file)))
+// queryOrdered returns the workflow executions matching a query with an ORDER BY clause. Filenames are
+// not sorted by close time, so all records matching the query are read before a page of them is returned.
+func (v *visibilityArchiver) queryOrdered(
+	ctx context.Context,
+	uri archiver.URI,
+	request *queryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	var token *visibilityquery.PageToken
+	if request.nextPageToken != nil {
+		var err error
+		token, err = visibilityquery.DeserializePageToken(request.nextPageToken)
 		if err != nil {
-			return nil, &serviceerror.InvalidArgument{Message: err.Error()}
+			return nil, &serviceerror.InvalidArgument{Message: archiver.ErrNextPageTokenCorrupted.Error()}
 		}
+	}
 
-		record, err := decodeVisibilityRecord(encodedRecord)
+	prefix, filters := searchPrefix(request.namespaceID, request.query)
+	var records []*archiverspb.VisibilityRecord
+	offset := 0
+	for {
+		listed, completed, currentCursorPos, err := v.listRecords(ctx, uri, request.namespaceID, prefix, filters, request.query, defaultListPageSize, offset)
 		if err != nil {
-			return nil, &serviceerror.InvalidArgument{Message: err.Error()}
+			return nil, err
+		}
+		records = append(records, listed...)
+		if completed {
+			break
 		}
+		offset = currentCursorPos
+	}
 
+	records, nextToken := request.query.Page(records, token, request.pageSize)
+	response := &archiver.QueryVisibilityResponse{}
+	for _, record := range records {
 		executionInfo, err := convertToExecutionInfo(record, saTypeMap)
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
 		response.Executions = append(response.Executions, executionInfo)
 	}
-
-	if !completed {
-		newToken := &queryVisibilityToken{
-			Offset: currentCursorPos,
-		}
-		encodedToken, err := serializeToken(newToken)
+	if nextToken != nil {
+		encodedToken, err := visibilityquery.SerializePageToken(nextToken)
 		if err != nil {
 			return nil, &serviceerror.InvalidArgument{Message: err.Error()}
 		}
 		response.NextPageToken = encodedToken
 	}
-
 	return response, nil
 }
 
+// searchPrefix returns the filename prefix under which all records matching the query are stored,
+// and filters on filenames which narrow the listed files down further.
+func searchPrefix(namespaceID string, query *visibilityquery.Query) (string, []connector.Precondition) {
+	tag := indexKeyCloseTimeout
+	earliest, latest := query.CloseTimeRange()
+	if earliest.IsZero() && latest.Equal(visibilityquery.MaxTime) {
+		startEarliest, startLatest := query.StartTimeRange()
+		if !startEarliest.IsZero() || !startLatest.Equal(visibilityquery.MaxTime) {
+			tag = indexKeyStartTimeout
+			earliest, latest = startEarliest, startLatest
+		}
+	}
+	prefix := constructTimeRangeSearchPrefix(namespaceID, tag, earliest, latest)
+
+	var filters []connector.Precondition
+	if values, ok := query.Values(searchattribute.WorkflowID); ok && len(values) == 1 {
+		filters = append(filters, newWorkflowIDPrecondition(hash(values[0])))
+	}
+	if values, ok := query.Values(searchattribute.RunID); ok && len(values) == 1 {
+		filters = append(filters, newRunIDPrecondition(hash(values[0])))
+	}
+	if values, ok := query.Values(searchattribute.WorkflowType); ok && len(values) == 1 {
+		filters = append(filters, newWorkflowTypeNamePrecondition(hash(values[0])))
+	}
+	return prefix, filters
+}
+
+// listRecords lists at most pageSize files with the prefix passing the filters, starting at offset,
+// and returns the records matching the query among them, whether all files were listed, and the
+// offset to resume listing from.
+func (v *visibilityArchiver) listRecords(
+	ctx context.Context,
+	uri archiver.URI,
+	namespaceID string,
+	prefix string,
+	filters []connector.Precondition,
+	query *visibilityquery.Query,
+	pageSize int,
+	offset int,
+) ([]*archiverspb.VisibilityRecord, bool, int, error) {
+	filenames, completed, currentCursorPos, err := v.gcloudStorage.QueryWithFilters(ctx, uri, prefix, pageSize, offset, filters)
+	if err != nil {
+		return nil, false, 0, &serviceerror.InvalidArgument{Message: err.Error()}
+	}
+
+	var records []*archiverspb.VisibilityRecord
+	for _, file := range filenames {
+		encodedRecord, err := v.gcloudStorage.Get(ctx, uri, fmt.Sprintf("%s/%s", namespaceID, filepath.Base(file)))
+		if err != nil {
+			return nil, false, 0, &serviceerror.InvalidArgument{Message: err.Error()}
+		}
+
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, false, 0, &serviceerror.InvalidArgument{Message: err.Error()}
+		}
+
+		matched, err := query.Match(record)
+		if err != nil {
+			return nil, false, 0, serviceerror.NewInternal(err.Error())
+		}
+		if matched {
+			records = append(records, record)
+		}
+	}
+	return records, completed, currentCursorPos, nil
+}
+
 func (v *visibilityArchiver) parseToken(nextPageToken []byte) (*queryVisibilityToken, error) {
 	token := new(queryVisibilityToken)
 	if nextPageToken != nil {
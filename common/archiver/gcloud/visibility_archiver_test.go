@@ -26,7 +26,7 @@ package gcloud
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -45,7 +45,6 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/testing/protorequire"
-	"go.temporal.io/server/common/util"
 )
 
 const (
@@ -207,20 +206,25 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	URI, err := archiver.NewURI("gs://my-bucket-cad/temporal_archival/visibility")
 	s.NoError(err)
 	storageWrapper := connector.NewMockClient(s.controller)
-	storageWrapper.EXPECT().Exist(gomock.Any(), URI, gomock.Any()).Return(false, nil)
+	storageWrapper.EXPECT().Exist(gomock.Any(), URI, gomock.Any()).Return(false, nil).AnyTimes()
 	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
 	s.NoError(err)
 
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
-	visibilityArchiver.queryParser = mockParser
-	response, err := visibilityArchiver.Query(ctx, URI, &archiver.QueryVisibilityRequest{
-		NamespaceID: "some random namespaceID",
-		PageSize:    10,
-		Query:       "some invalid query",
-	}, searchattribute.TestNameTypeMap)
-	s.Error(err)
-	s.Nil(response)
+	for _, query := range []string{
+		"some invalid query",
+		"WorkflowId = 'some-id' group by ExecutionStatus",
+		"UnknownField = 'some value'",
+		"WorkflowId = 'some-id' order by StartTime",
+	} {
+		response, err := visibilityArchiver.Query(ctx, URI, &archiver.QueryVisibilityRequest{
+			NamespaceID: "some random namespaceID",
+			PageSize:    10,
+			Query:       query,
+		}, searchattribute.TestNameTypeMap)
+		var svcErr *serviceerror.InvalidArgument
+		s.ErrorAs(err, &svcErr, query)
+		s.Nil(response)
+	}
 }
 
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
@@ -231,19 +235,9 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
 	s.NoError(err)
 
-	mockParser := NewMockQueryParser(s.controller)
-	startTime, _ := time.Parse(time.RFC3339, "2019-10-04T11:00:00+00:00")
-	closeTime := startTime.Add(time.Hour)
-	precision := PrecisionDay
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       closeTime,
-		startTime:       startTime,
-		searchPrecision: &precision,
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID:   testNamespaceID,
-		Query:         "parsed by mockParser",
+		Query:         "StartTime = '2019-10-04T11:00:00Z' and CloseTime = '2019-10-04T12:00:00Z' and SearchPrecision = 'Day'",
 		PageSize:      1,
 		NextPageToken: []byte{1, 2, 3},
 	}
@@ -258,27 +252,16 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	s.NoError(err)
 	storageWrapper := connector.NewMockClient(s.controller)
 	storageWrapper.EXPECT().Exist(gomock.Any(), URI, gomock.Any()).Return(false, nil)
-	storageWrapper.EXPECT().QueryWithFilters(gomock.Any(), URI, gomock.Any(), 10, 0, gomock.Any()).Return([]string{"closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, true, 1, nil)
+	storageWrapper.EXPECT().QueryWithFilters(gomock.Any(), URI, "test-namespace-id/closeTimeout_2020-02-05T", 10, 0, gomock.Len(3)).Return([]string{"closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, true, 1, nil)
 	storageWrapper.EXPECT().Get(gomock.Any(), URI, "test-namespace-id/closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility").Return([]byte(exampleVisibilityRecord), nil)
 
 	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
 	s.NoError(err)
 
-	mockParser := NewMockQueryParser(s.controller)
-	dayPrecision := "Day"
-	closeTime, _ := time.Parse(time.RFC3339, "2019-10-04T11:00:00+00:00")
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       closeTime,
-		searchPrecision: &dayPrecision,
-		workflowType:    util.Ptr("MobileOnlyWorkflow::processMobileOnly"),
-		workflowID:      util.Ptr(testWorkflowID),
-		runID:           util.Ptr(testRunID),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
 		PageSize:    10,
-		Query:       "parsed by mockParser",
+		Query:       "WorkflowType = 'test-workflow-type' and WorkflowId = 'test-workflow-id' and RunId = 'test-run-id' and CloseTime = '2020-02-05T11:00:00Z' and SearchPrecision = 'Day'",
 	}
 
 	response, err := visibilityArchiver.Query(ctx, URI, request, searchattribute.TestNameTypeMap)
@@ -307,21 +290,10 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
 	s.NoError(err)
 
-	mockParser := NewMockQueryParser(s.controller)
-	dayPrecision := "Day"
-	closeTime, _ := time.Parse(time.RFC3339, "2019-10-04T11:00:00+00:00")
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       closeTime,
-		searchPrecision: &dayPrecision,
-		workflowType:    util.Ptr("MobileOnlyWorkflow::processMobileOnly"),
-		workflowID:      util.Ptr(testWorkflowID),
-		runID:           util.Ptr(testRunID),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
 		PageSize:    pageSize,
-		Query:       "parsed by mockParser",
+		Query:       "WorkflowType = 'test-workflow-type' and WorkflowId = 'test-workflow-id' and RunId = 'test-run-id' and CloseTime = '2020-02-05T11:00:00Z' and SearchPrecision = 'Day'",
 	}
 
 	response, err := visibilityArchiver.Query(ctx, URI, request, searchattribute.TestNameTypeMap)
@@ -347,6 +319,88 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.ProtoEqual(ei, response.Executions[0])
 }
 
+func (s *visibilityArchiverSuite) TestQuery_Success_SkipUnmatchedRecords() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/temporal_archival/visibility")
+	s.NoError(err)
+	storageWrapper := connector.NewMockClient(s.controller)
+	storageWrapper.EXPECT().Exist(gomock.Any(), URI, gomock.Any()).Return(false, nil)
+	storageWrapper.EXPECT().QueryWithFilters(gomock.Any(), URI, "test-namespace-id/closeTimeout_", 1, 0, gomock.Len(0)).Return([]string{"closeTimeout_2020-02-05T09:56:15Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, false, 1, nil)
+	storageWrapper.EXPECT().QueryWithFilters(gomock.Any(), URI, "test-namespace-id/closeTimeout_", 1, 1, gomock.Len(0)).Return([]string{"closeTimeout_2020-02-05T09:56:15Z_test-workflow-id2_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, false, 2, nil)
+	storageWrapper.EXPECT().Get(gomock.Any(), URI, "test-namespace-id/closeTimeout_2020-02-05T09:56:15Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility").Return([]byte(exampleVisibilityRecord), nil)
+	storageWrapper.EXPECT().Get(gomock.Any(), URI, "test-namespace-id/closeTimeout_2020-02-05T09:56:15Z_test-workflow-id2_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility").Return([]byte(exampleVisibilityRecord2), nil)
+
+	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+		Query:       "WorkflowId != 'test-workflow-id' and ExecutionStatus = 'Completed'",
+	}
+
+	response, err := visibilityArchiver.Query(ctx, URI, request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.NotNil(response.NextPageToken)
+	s.Len(response.Executions, 1)
+	s.Equal("test-workflow-id2", response.Executions[0].GetExecution().GetWorkflowId())
+	token, err := deserializeQueryVisibilityToken(response.NextPageToken)
+	s.NoError(err)
+	s.Equal(2, token.Offset)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_OrderByCloseTime() {
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/temporal_archival/visibility")
+	s.NoError(err)
+	storageWrapper := connector.NewMockClient(s.controller)
+	storageWrapper.EXPECT().Exist(gomock.Any(), URI, gomock.Any()).Return(false, nil).Times(2)
+
+	var filenames []string
+	for i, status := range []enumspb.WorkflowExecutionStatus{
+		enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+		enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+	} {
+		record := &archiverspb.VisibilityRecord{
+			NamespaceId:      testNamespaceID,
+			WorkflowId:       testWorkflowID,
+			RunId:            fmt.Sprintf("run-%d", i),
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.UnixOrZeroTimePtr(0),
+			// records are listed out of close time order
+			CloseTime: timestamp.UnixOrZeroTimePtr(int64((i%2)*10+i) * int64(time.Second)),
+			Status:    status,
+		}
+		filename := constructVisibilityFilename(testNamespaceID, testWorkflowTypeName, testWorkflowID, record.RunId, indexKeyCloseTimeout, record.CloseTime.AsTime())
+		filenames = append(filenames, filename)
+		data, err := encode(record)
+		s.NoError(err)
+		storageWrapper.EXPECT().Get(gomock.Any(), URI, filename).Return(data, nil).Times(2)
+	}
+	storageWrapper.EXPECT().QueryWithFilters(gomock.Any(), URI, "test-namespace-id/closeTimeout_", defaultListPageSize, 0, gomock.Any()).Return(filenames, true, len(filenames), nil).Times(2)
+
+	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    2,
+		Query:       "ExecutionStatus = 'Completed' order by CloseTime asc",
+	}
+
+	var runIDs []string
+	for {
+		response, err := visibilityArchiver.Query(ctx, URI, request, searchattribute.TestNameTypeMap)
+		s.NoError(err)
+		for _, execution := range response.Executions {
+			runIDs = append(runIDs, execution.GetExecution().GetRunId())
+		}
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal([]string{"run-0", "run-2", "run-3"}, runIDs)
+}
+
 func (s *visibilityArchiverSuite) TestQuery_EmptyQuery_InvalidNamespace() {
 	URI, err := archiver.NewURI("gs://my-bucket-cad/temporal_archival/visibility")
 	s.NoError(err)
@@ -0,0 +1,629 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	historypb "go.temporal.io/api/history/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// HistoryArchiveCompression is the compression of the body of a history archive
+type HistoryArchiveCompression byte
+
+const (
+	// HistoryArchiveCompressionNone leaves the body of the history archive uncompressed
+	HistoryArchiveCompressionNone HistoryArchiveCompression = iota
+	// HistoryArchiveCompressionGzip compresses the body of the history archive with gzip
+	HistoryArchiveCompressionGzip
+	// HistoryArchiveCompressionZstd compresses the body of the history archive with zstd
+	HistoryArchiveCompressionZstd
+)
+
+const (
+	// HistoryArchiveFormatVersion is the version of the history archive format written by HistoryArchiveWriter
+	HistoryArchiveFormatVersion byte = 1
+	// MaxHistoryArchiveHeaderSize bounds the size of the header of a history archive, readers resuming at
+	// a position only need this prefix of the archive besides the frames after the position
+	MaxHistoryArchiveHeaderSize = historyArchivePrefixSize + binary.MaxVarintLen16 + maxHistoryArchiveKeyMetadataSize
+
+	// historyArchiveMagic starts every history archive, it can't be mistaken for the json
+	// encoded history written by archivers before the history archive format was introduced.
+	historyArchiveMagic = "TMPRLHST"
+	// historyArchivePrefixSize is the size of the magic, followed by the format version and the compression.
+	// The header ends with the length prefixed key metadata of the archive, which is empty if it isn't encrypted.
+	historyArchivePrefixSize         = len(historyArchiveMagic) + 2
+	maxHistoryArchiveKeyMetadataSize = 1024
+	maxHistoryArchiveRecordSize      = 256 * 1024 * 1024
+	// historyArchiveFrameSize is the uncompressed size after which a frame is ended. Readers can only
+	// seek to the start of a frame, so it bounds the data decompressed to resume reading an archive.
+	historyArchiveFrameSize    = 1024 * 1024
+	maxHistoryArchiveFrameSize = 2 * maxHistoryArchiveRecordSize
+)
+
+var (
+	// ErrLegacyHistoryArchive is the error for history archived before the history archive format was introduced
+	ErrLegacyHistoryArchive = errors.New("history is not archived in the history archive format")
+	// ErrHistoryArchiveCorrupted is the error for a history archive which can't be decoded
+	ErrHistoryArchiveCorrupted = errors.New("history archive is corrupted")
+	// ErrHistoryArchiveEncrypted is the error for reading an encrypted history archive without a cipher
+	ErrHistoryArchiveEncrypted = errors.New("history archive is encrypted but archival encryption is not configured")
+	// ErrHistoryArchiveNotEncrypted is the error for reading a history archive which isn't encrypted with a cipher
+	ErrHistoryArchiveNotEncrypted = errors.New("history archive is not encrypted but archival encryption is configured")
+
+	errUnknownHistoryArchiveCompression = errors.New("unknown history archive compression")
+)
+
+type (
+	// HistoryArchiveCipher encrypts the frames of history archives, see the encryption package.
+	// Each archive gets its own frame cipher, whose key metadata is stored in cleartext in the
+	// header of the archive and must be enough for the HistoryArchiveCipher to recover its key.
+	HistoryArchiveCipher interface {
+		// NewKey returns the frame cipher of a new archive, and its key metadata
+		NewKey() (HistoryArchiveFrameCipher, []byte, error)
+		// OpenKey returns the frame cipher of an archive from its key metadata
+		OpenKey(keyMetadata []byte) (HistoryArchiveFrameCipher, error)
+	}
+
+	// HistoryArchiveFrameCipher encrypts and decrypts the frames of a single history archive. The additional
+	// data must be authenticated along with the frame, Open fails if it differs from the one given to Seal.
+	HistoryArchiveFrameCipher interface {
+		Seal(frame []byte, additionalData []byte) ([]byte, error)
+		Open(sealed []byte, additionalData []byte) ([]byte, error)
+	}
+
+	// HistoryArchiveWriter streams history batches into the body of a history archive.
+	// A history archive is a header recording the format version, the compression and the key
+	// metadata of encrypted archives, followed by a sequence of length prefixed frames. Each frame
+	// is compressed, then encrypted, on its own and holds a sequence of length prefixed, proto encoded
+	// history batches, so that readers can resume reading at any frame without decompressing the
+	// archive from the start.
+	//
+	// Frames of encrypted archives start with a cleartext flag marking the final frame of the archive.
+	// The header, the index of the frame and the flag are authenticated along with each frame, so that
+	// frames can't be dropped, reordered, duplicated or truncated, and an archive without its final frame
+	// is rejected.
+	HistoryArchiveWriter struct {
+		w           io.Writer
+		compression HistoryArchiveCompression
+		cipher      HistoryArchiveFrameCipher
+		keyMetadata []byte
+		header      []byte
+		frameSize   int
+		// index of the next frame of the archive
+		frameIndex uint64
+
+		frame      bytes.Buffer
+		compressor io.WriteCloser
+		// uncompressed size of the batches in the current frame
+		uncompressed int
+		buf          []byte
+		size         int64
+	}
+
+	// HistoryArchiveReader reads the history batches of a history archive one at a time
+	HistoryArchiveReader struct {
+		compression HistoryArchiveCompression
+		cipher      HistoryArchiveFrameCipher
+		header      []byte
+		src         *bufio.Reader
+		// offset and index of the next frame of src from the start of the archive
+		offset     int64
+		frameIndex uint64
+		// final is set once the final frame of an encrypted archive was read
+		final bool
+
+		// frame is the decompressed current frame, it is nil before the first frame is read and once it is exhausted
+		frame           *bufio.Reader
+		frameOffset     int64
+		currentFrameIdx uint64
+		frameBatch      int
+		// skip is the number of batches to skip in the next frame, when resuming in the middle of a frame
+		skip int
+
+		compressed  []byte
+		buf         []byte
+		gzipReader  *gzip.Reader
+		zstdDecoder *zstd.Decoder
+		frameReader bytes.Reader
+		frameBuffer *bufio.Reader
+	}
+
+	// HistoryArchivePosition is the position of a history batch in a history archive,
+	// it can be used to resume reading the archive from that batch.
+	HistoryArchivePosition struct {
+		// FrameOffset is the offset of the frame of the batch from the start of the archive
+		FrameOffset int64
+		// FrameIdx is the index of the frame of the batch in the archive
+		FrameIdx uint64 `json:",omitempty"`
+		// BatchIdx is the index of the batch in its frame
+		BatchIdx int
+	}
+)
+
+// ParseHistoryArchiveCompression parses the compression configured for an archiver,
+// an empty string defaults to zstd.
+func ParseHistoryArchiveCompression(compression string) (HistoryArchiveCompression, error) {
+	switch compression {
+	case "", "zstd":
+		return HistoryArchiveCompressionZstd, nil
+	case "gzip":
+		return HistoryArchiveCompressionGzip, nil
+	case "none":
+		return HistoryArchiveCompressionNone, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", errUnknownHistoryArchiveCompression, compression)
+	}
+}
+
+// NewHistoryArchiveWriter writes the header of a new history archive to w, and returns a writer for the
+// body of the archive. The body is encrypted with a new key of cipher, unless cipher is nil.
+func NewHistoryArchiveWriter(w io.Writer, compression HistoryArchiveCompression, cipher HistoryArchiveCipher) (*HistoryArchiveWriter, error) {
+	var frameCipher HistoryArchiveFrameCipher
+	var keyMetadata []byte
+	if cipher != nil {
+		var err error
+		if frameCipher, keyMetadata, err = cipher.NewKey(); err != nil {
+			return nil, err
+		}
+		if len(keyMetadata) > maxHistoryArchiveKeyMetadataSize {
+			return nil, fmt.Errorf("history archive key metadata of %d bytes is too large", len(keyMetadata))
+		}
+	}
+	writer, err := newHistoryArchiveWriter(w, compression, frameCipher, keyMetadata, 0)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(writer.header); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// ResumeHistoryArchiveWriter returns a writer appending frames to the body of a history archive whose
+// header was already written, e.g. by a previous attempt of a multipart upload. keyMetadata and frameIndex
+// are the KeyMetadata and the FrameIndex of the writer which wrote the archive so far, after it was flushed.
+func ResumeHistoryArchiveWriter(
+	w io.Writer,
+	compression HistoryArchiveCompression,
+	cipher HistoryArchiveCipher,
+	keyMetadata []byte,
+	frameIndex uint64,
+) (*HistoryArchiveWriter, error) {
+	frameCipher, err := openHistoryArchiveKey(cipher, keyMetadata)
+	if err != nil {
+		return nil, err
+	}
+	return newHistoryArchiveWriter(w, compression, frameCipher, keyMetadata, frameIndex)
+}
+
+func newHistoryArchiveWriter(
+	w io.Writer,
+	compression HistoryArchiveCompression,
+	frameCipher HistoryArchiveFrameCipher,
+	keyMetadata []byte,
+	frameIndex uint64,
+) (*HistoryArchiveWriter, error) {
+	writer := &HistoryArchiveWriter{
+		w:           w,
+		compression: compression,
+		cipher:      frameCipher,
+		keyMetadata: keyMetadata,
+		header:      historyArchiveHeader(compression, keyMetadata),
+		frameSize:   historyArchiveFrameSize,
+		frameIndex:  frameIndex,
+	}
+	switch compression {
+	case HistoryArchiveCompressionNone:
+		writer.compressor = nopWriteCloser{Writer: &writer.frame}
+	case HistoryArchiveCompressionGzip:
+		writer.compressor = gzip.NewWriter(&writer.frame)
+	case HistoryArchiveCompressionZstd:
+		encoder, err := zstd.NewWriter(&writer.frame, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		writer.compressor = encoder
+	default:
+		return nil, errUnknownHistoryArchiveCompression
+	}
+	return writer, nil
+}
+
+// KeyMetadata returns the key metadata recorded in the header of the archive, it is nil if the archive isn't encrypted
+func (w *HistoryArchiveWriter) KeyMetadata() []byte {
+	return w.keyMetadata
+}
+
+// FrameIndex returns the index of the next frame of the archive
+func (w *HistoryArchiveWriter) FrameIndex() uint64 {
+	return w.frameIndex
+}
+
+// Write appends a history batch to the archive
+func (w *HistoryArchiveWriter) Write(history *historypb.History) error {
+	var err error
+	w.buf = binary.AppendUvarint(w.buf[:0], uint64(proto.Size(history)))
+	w.buf, err = proto.MarshalOptions{}.MarshalAppend(w.buf, history)
+	if err != nil {
+		return err
+	}
+	n, err := w.compressor.Write(w.buf)
+	w.size += int64(n)
+	w.uncompressed += n
+	if err != nil {
+		return err
+	}
+	if w.uncompressed >= w.frameSize {
+		return w.Flush()
+	}
+	return nil
+}
+
+// Flush ends the current frame and writes it to the underlying writer. More frames can be written
+// after it, either by this writer or by a writer resuming the archive.
+func (w *HistoryArchiveWriter) Flush() error {
+	if w.uncompressed == 0 {
+		return nil
+	}
+	return w.writeFrame(false)
+}
+
+// Close ends the archive. It flushes the last frame and, if the archive is encrypted, marks it as the
+// final frame, in which case no more frames may be written. It doesn't close the underlying writer.
+func (w *HistoryArchiveWriter) Close() error {
+	if w.cipher == nil {
+		return w.Flush()
+	}
+	return w.writeFrame(true)
+}
+
+func (w *HistoryArchiveWriter) writeFrame(final bool) error {
+	var frame []byte
+	// the final frame of an encrypted archive is empty if the archive was just flushed
+	if w.uncompressed > 0 {
+		if err := w.compressor.Close(); err != nil {
+			return err
+		}
+		frame = w.frame.Bytes()
+	}
+	if w.cipher != nil {
+		flag := historyArchiveFrameFlag(final)
+		sealed, err := w.cipher.Seal(frame, historyArchiveFrameAdditionalData(w.header, w.frameIndex, flag))
+		if err != nil {
+			return err
+		}
+		frame = append([]byte{flag}, sealed...)
+	}
+	w.buf = binary.AppendUvarint(w.buf[:0], uint64(len(frame)))
+	if _, err := w.w.Write(w.buf); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(frame); err != nil {
+		return err
+	}
+	w.frameIndex++
+	w.frame.Reset()
+	w.uncompressed = 0
+	switch compressor := w.compressor.(type) {
+	case *gzip.Writer:
+		compressor.Reset(&w.frame)
+	case *zstd.Encoder:
+		compressor.Reset(&w.frame)
+	}
+	return nil
+}
+
+// Size returns the uncompressed size of the history batches written so far
+func (w *HistoryArchiveWriter) Size() int64 {
+	return w.size
+}
+
+// NewHistoryArchiveReader reads the header of a history archive and returns a reader for its history batches.
+// ErrLegacyHistoryArchive is returned without consuming r if the history was archived
+// before the history archive format was introduced. cipher is nil unless archival encryption is
+// configured, in which case archives which aren't encrypted are rejected with ErrHistoryArchiveNotEncrypted.
+func NewHistoryArchiveReader(r *bufio.Reader, cipher HistoryArchiveCipher) (*HistoryArchiveReader, error) {
+	prefix, err := r.Peek(historyArchivePrefixSize)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if len(prefix) < historyArchivePrefixSize || string(prefix[:len(historyArchiveMagic)]) != historyArchiveMagic {
+		if cipher != nil {
+			return nil, ErrHistoryArchiveNotEncrypted
+		}
+		return nil, ErrLegacyHistoryArchive
+	}
+	version := prefix[len(historyArchiveMagic)]
+	compression := HistoryArchiveCompression(prefix[len(historyArchiveMagic)+1])
+	if version != HistoryArchiveFormatVersion {
+		return nil, fmt.Errorf("unsupported history archive format version %d", version)
+	}
+	switch compression {
+	case HistoryArchiveCompressionNone, HistoryArchiveCompressionGzip, HistoryArchiveCompressionZstd:
+	default:
+		return nil, errUnknownHistoryArchiveCompression
+	}
+	if _, err := r.Discard(historyArchivePrefixSize); err != nil {
+		return nil, err
+	}
+
+	keyMetadataSize, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrHistoryArchiveCorrupted, err)
+	}
+	if keyMetadataSize > maxHistoryArchiveKeyMetadataSize {
+		return nil, fmt.Errorf("%w: key metadata of %d bytes", ErrHistoryArchiveCorrupted, keyMetadataSize)
+	}
+	keyMetadata := make([]byte, keyMetadataSize)
+	if _, err := io.ReadFull(r, keyMetadata); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrHistoryArchiveCorrupted, err)
+	}
+	if len(keyMetadata) == 0 && cipher != nil {
+		return nil, ErrHistoryArchiveNotEncrypted
+	}
+	frameCipher, err := openHistoryArchiveKey(cipher, keyMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	header := historyArchiveHeader(compression, keyMetadata)
+	return &HistoryArchiveReader{
+		compression: compression,
+		cipher:      frameCipher,
+		header:      header,
+		src:         r,
+		offset:      int64(len(header)),
+	}, nil
+}
+
+// ResumeAt continues reading the archive at the given position. The body of the archive must be read
+// from r, starting at position.FrameOffset.
+func (r *HistoryArchiveReader) ResumeAt(src io.Reader, position HistoryArchivePosition) {
+	r.src = bufio.NewReader(src)
+	r.offset = position.FrameOffset
+	r.frameIndex = position.FrameIdx
+	r.final = false
+	r.frame = nil
+	r.skip = position.BatchIdx
+}
+
+// Position returns the position of the next history batch of the archive
+func (r *HistoryArchiveReader) Position() HistoryArchivePosition {
+	if r.frame == nil {
+		return HistoryArchivePosition{
+			FrameOffset: r.offset,
+			FrameIdx:    r.frameIndex,
+			BatchIdx:    r.skip,
+		}
+	}
+	return HistoryArchivePosition{
+		FrameOffset: r.frameOffset,
+		FrameIdx:    r.currentFrameIdx,
+		BatchIdx:    r.frameBatch,
+	}
+}
+
+// Next returns the next history batch of the archive, io.EOF is returned once all batches were read
+func (r *HistoryArchiveReader) Next() (*historypb.History, error) {
+	for {
+		if r.frame == nil {
+			if err := r.nextFrame(); err != nil {
+				return nil, err
+			}
+		}
+		record, err := r.nextRecord()
+		if err == io.EOF {
+			r.frame = nil
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		history := &historypb.History{}
+		if err := proto.Unmarshal(record, history); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrHistoryArchiveCorrupted, err)
+		}
+		return history, nil
+	}
+}
+
+// nextFrame reads and decompresses the next frame of the archive
+func (r *HistoryArchiveReader) nextFrame() error {
+	size, err := binary.ReadUvarint(r.src)
+	if err == io.EOF {
+		if r.cipher != nil && !r.final {
+			return fmt.Errorf("%w: final frame is missing", ErrHistoryArchiveCorrupted)
+		}
+		return io.EOF
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrHistoryArchiveCorrupted, err)
+	}
+	if r.final {
+		return fmt.Errorf("%w: frame after the final frame", ErrHistoryArchiveCorrupted)
+	}
+	if size > maxHistoryArchiveFrameSize {
+		return fmt.Errorf("%w: frame of %d bytes", ErrHistoryArchiveCorrupted, size)
+	}
+	r.compressed = grow(r.compressed, size)
+	if _, err := io.ReadFull(r.src, r.compressed); err != nil {
+		return fmt.Errorf("%w: %v", ErrHistoryArchiveCorrupted, err)
+	}
+	compressed := r.compressed
+	if r.cipher != nil {
+		if len(compressed) == 0 {
+			return fmt.Errorf("%w: frame flag is missing", ErrHistoryArchiveCorrupted)
+		}
+		flag := compressed[0]
+		if flag != historyArchiveFrameFlag(false) && flag != historyArchiveFrameFlag(true) {
+			return fmt.Errorf("%w: unknown frame flag %d", ErrHistoryArchiveCorrupted, flag)
+		}
+		additionalData := historyArchiveFrameAdditionalData(r.header, r.frameIndex, flag)
+		if compressed, err = r.cipher.Open(compressed[1:], additionalData); err != nil {
+			return fmt.Errorf("%w: %v", ErrHistoryArchiveCorrupted, err)
+		}
+		r.final = flag == historyArchiveFrameFlag(true)
+	}
+
+	r.frameReader.Reset(compressed)
+	var frame io.Reader
+	switch {
+	case len(compressed) == 0:
+		// the empty final frame of an encrypted archive
+		frame = &r.frameReader
+	case r.compression == HistoryArchiveCompressionNone:
+		frame = &r.frameReader
+	case r.compression == HistoryArchiveCompressionGzip:
+		if r.gzipReader == nil {
+			r.gzipReader = new(gzip.Reader)
+		}
+		if err := r.gzipReader.Reset(&r.frameReader); err != nil {
+			return fmt.Errorf("%w: %v", ErrHistoryArchiveCorrupted, err)
+		}
+		frame = r.gzipReader
+	case r.compression == HistoryArchiveCompressionZstd:
+		if r.zstdDecoder == nil {
+			if r.zstdDecoder, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1)); err != nil {
+				return err
+			}
+		}
+		if err := r.zstdDecoder.Reset(&r.frameReader); err != nil {
+			return fmt.Errorf("%w: %v", ErrHistoryArchiveCorrupted, err)
+		}
+		frame = r.zstdDecoder
+	}
+	if r.frameBuffer == nil {
+		r.frameBuffer = bufio.NewReader(frame)
+	} else {
+		r.frameBuffer.Reset(frame)
+	}
+
+	r.frame = r.frameBuffer
+	r.frameOffset = r.offset
+	r.currentFrameIdx = r.frameIndex
+	r.frameBatch = 0
+	r.offset += int64(uvarintSize(size)) + int64(size)
+	r.frameIndex++
+
+	for ; r.skip > 0; r.skip-- {
+		if _, err := r.nextRecord(); err != nil {
+			if err == io.EOF {
+				return fmt.Errorf("%w: frame at offset %d has %d batches", ErrHistoryArchiveCorrupted, r.frameOffset, r.frameBatch)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// nextRecord returns the next encoded history batch of the current frame, io.EOF is returned
+// once the frame is exhausted
+func (r *HistoryArchiveReader) nextRecord() ([]byte, error) {
+	size, err := binary.ReadUvarint(r.frame)
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrHistoryArchiveCorrupted, err)
+	}
+	if size > maxHistoryArchiveRecordSize {
+		return nil, fmt.Errorf("%w: history batch of %d bytes", ErrHistoryArchiveCorrupted, size)
+	}
+	r.buf = grow(r.buf, size)
+	if _, err := io.ReadFull(r.frame, r.buf); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrHistoryArchiveCorrupted, err)
+	}
+	r.frameBatch++
+	return r.buf, nil
+}
+
+// Close releases the resources of the decompressor, it doesn't close the underlying reader
+func (r *HistoryArchiveReader) Close() {
+	if r.zstdDecoder != nil {
+		r.zstdDecoder.Close()
+	}
+}
+
+func openHistoryArchiveKey(cipher HistoryArchiveCipher, keyMetadata []byte) (HistoryArchiveFrameCipher, error) {
+	if len(keyMetadata) == 0 {
+		return nil, nil
+	}
+	if cipher == nil {
+		return nil, ErrHistoryArchiveEncrypted
+	}
+	return cipher.OpenKey(keyMetadata)
+}
+
+// historyArchiveHeader returns the header of a history archive
+func historyArchiveHeader(compression HistoryArchiveCompression, keyMetadata []byte) []byte {
+	header := make([]byte, 0, historyArchivePrefixSize+binary.MaxVarintLen16+len(keyMetadata))
+	header = append(header, historyArchiveMagic...)
+	header = append(header, HistoryArchiveFormatVersion, byte(compression))
+	header = binary.AppendUvarint(header, uint64(len(keyMetadata)))
+	return append(header, keyMetadata...)
+}
+
+func historyArchiveFrameFlag(final bool) byte {
+	if final {
+		return 1
+	}
+	return 0
+}
+
+// historyArchiveFrameAdditionalData returns the data authenticated along with a frame of an encrypted
+// archive: the header of the archive, the index of the frame and its final frame flag.
+func historyArchiveFrameAdditionalData(header []byte, frameIndex uint64, flag byte) []byte {
+	additionalData := make([]byte, 0, len(header)+9)
+	additionalData = append(additionalData, header...)
+	additionalData = binary.BigEndian.AppendUint64(additionalData, frameIndex)
+	return append(additionalData, flag)
+}
+
+func grow(buf []byte, size uint64) []byte {
+	if uint64(cap(buf)) < size {
+		return make([]byte, size)
+	}
+	return buf[:size]
+}
+
+func uvarintSize(v uint64) int {
+	var buf [binary.MaxVarintLen64]byte
+	return binary.PutUvarint(buf[:], v)
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
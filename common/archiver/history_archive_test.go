@@ -0,0 +1,414 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+func TestParseHistoryArchiveCompression(t *testing.T) {
+	for compression, expected := range map[string]HistoryArchiveCompression{
+		"":     HistoryArchiveCompressionZstd,
+		"zstd": HistoryArchiveCompressionZstd,
+		"gzip": HistoryArchiveCompressionGzip,
+		"none": HistoryArchiveCompressionNone,
+	} {
+		actual, err := ParseHistoryArchiveCompression(compression)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	}
+
+	_, err := ParseHistoryArchiveCompression("lz4")
+	require.ErrorIs(t, err, errUnknownHistoryArchiveCompression)
+}
+
+func TestHistoryArchive_RoundTrip(t *testing.T) {
+	for _, compression := range []HistoryArchiveCompression{
+		HistoryArchiveCompressionNone,
+		HistoryArchiveCompressionGzip,
+		HistoryArchiveCompressionZstd,
+	} {
+		histories := testHistoryArchiveBatches(10)
+		var archive bytes.Buffer
+		writer, err := NewHistoryArchiveWriter(&archive, compression, nil)
+		require.NoError(t, err)
+		for _, history := range histories {
+			require.NoError(t, writer.Write(history))
+		}
+		require.NoError(t, writer.Close())
+		require.Positive(t, writer.Size())
+
+		protorequire.ProtoSliceEqual(t, histories, readTestHistoryArchive(t, archive.Bytes()))
+	}
+}
+
+func TestHistoryArchive_Resume(t *testing.T) {
+	for _, compression := range []HistoryArchiveCompression{
+		HistoryArchiveCompressionNone,
+		HistoryArchiveCompressionGzip,
+		HistoryArchiveCompressionZstd,
+	} {
+		histories := testHistoryArchiveBatches(6)
+		var archive bytes.Buffer
+		writer, err := NewHistoryArchiveWriter(&archive, compression, nil)
+		require.NoError(t, err)
+		for _, history := range histories[:3] {
+			require.NoError(t, writer.Write(history))
+		}
+		require.NoError(t, writer.Flush())
+
+		writer, err = ResumeHistoryArchiveWriter(&archive, compression, nil, nil, writer.FrameIndex())
+		require.NoError(t, err)
+		for _, history := range histories[3:] {
+			require.NoError(t, writer.Write(history))
+		}
+		require.NoError(t, writer.Close())
+
+		protorequire.ProtoSliceEqual(t, histories, readTestHistoryArchive(t, archive.Bytes()))
+	}
+}
+
+func TestHistoryArchive_ResumeAt(t *testing.T) {
+	for _, compression := range []HistoryArchiveCompression{
+		HistoryArchiveCompressionNone,
+		HistoryArchiveCompressionGzip,
+		HistoryArchiveCompressionZstd,
+	} {
+		histories := testHistoryArchiveBatches(10)
+		var archive bytes.Buffer
+		writer, err := NewHistoryArchiveWriter(&archive, compression, nil)
+		require.NoError(t, err)
+		// each frame holds 3 batches
+		writer.frameSize = 3 * 1024
+		for _, history := range histories {
+			require.NoError(t, writer.Write(history))
+		}
+		require.NoError(t, writer.Close())
+
+		reader, err := NewHistoryArchiveReader(bufio.NewReader(bytes.NewReader(archive.Bytes())), nil)
+		require.NoError(t, err)
+		var positions []HistoryArchivePosition
+		for {
+			positions = append(positions, reader.Position())
+			if _, err := reader.Next(); err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+		}
+		reader.Close()
+		require.Len(t, positions, len(histories)+1)
+		require.Equal(t, HistoryArchivePosition{FrameOffset: int64(historyArchivePrefixSize + 1)}, positions[0])
+		require.Equal(t, HistoryArchivePosition{FrameOffset: positions[0].FrameOffset, BatchIdx: 3}, positions[3])
+		require.Less(t, positions[3].FrameOffset, positions[4].FrameOffset)
+		require.Equal(t, uint64(1), positions[4].FrameIdx)
+
+		for i, position := range positions {
+			reader, err := NewHistoryArchiveReader(bufio.NewReader(bytes.NewReader(archive.Bytes())), nil)
+			require.NoError(t, err)
+			reader.ResumeAt(bytes.NewReader(archive.Bytes()[position.FrameOffset:]), position)
+			require.Equal(t, position, reader.Position())
+
+			var resumed []*historypb.History
+			for {
+				history, err := reader.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				resumed = append(resumed, history)
+			}
+			reader.Close()
+			protorequire.ProtoSliceEqual(t, histories[i:], resumed)
+		}
+	}
+}
+
+func TestHistoryArchive_Encrypted(t *testing.T) {
+	histories := testHistoryArchiveBatches(4)
+	cipher := newTestHistoryArchiveCipher(t)
+	var archive bytes.Buffer
+	writer, err := NewHistoryArchiveWriter(&archive, HistoryArchiveCompressionNone, cipher)
+	require.NoError(t, err)
+	require.Equal(t, []byte("test-key"), writer.KeyMetadata())
+	require.NoError(t, writer.Write(histories[0]))
+	require.NoError(t, writer.Flush())
+	writer, err = ResumeHistoryArchiveWriter(&archive, HistoryArchiveCompressionNone, cipher, writer.KeyMetadata(), writer.FrameIndex())
+	require.NoError(t, err)
+	for _, history := range histories[1:] {
+		require.NoError(t, writer.Write(history))
+	}
+	require.NoError(t, writer.Close())
+
+	// frames are encrypted after compression, the key metadata is in cleartext in the header
+	require.True(t, bytes.Contains(archive.Bytes(), []byte("test-key")))
+	require.False(t, bytes.Contains(archive.Bytes(), bytes.Repeat([]byte{1}, 1024)))
+
+	decrypted, err := readAllTestHistoryArchive(archive.Bytes(), cipher)
+	require.NoError(t, err)
+	protorequire.ProtoSliceEqual(t, histories, decrypted)
+
+	_, err = NewHistoryArchiveReader(bufio.NewReader(bytes.NewReader(archive.Bytes())), nil)
+	require.ErrorIs(t, err, ErrHistoryArchiveEncrypted)
+
+	// the final frame is written even if the archive was just flushed
+	archive.Reset()
+	writer, err = NewHistoryArchiveWriter(&archive, HistoryArchiveCompressionZstd, cipher)
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(histories[0]))
+	require.NoError(t, writer.Flush())
+	require.NoError(t, writer.Close())
+	decrypted, err = readAllTestHistoryArchive(archive.Bytes(), cipher)
+	require.NoError(t, err)
+	protorequire.ProtoSliceEqual(t, histories[:1], decrypted)
+}
+
+func TestHistoryArchive_Encrypted_RejectsPlaintext(t *testing.T) {
+	cipher := newTestHistoryArchiveCipher(t)
+	var archive bytes.Buffer
+	writer, err := NewHistoryArchiveWriter(&archive, HistoryArchiveCompressionZstd, nil)
+	require.NoError(t, err)
+	require.Nil(t, writer.KeyMetadata())
+	require.NoError(t, writer.Write(testHistoryArchiveBatches(1)[0]))
+	require.NoError(t, writer.Close())
+	_, err = NewHistoryArchiveReader(bufio.NewReader(bytes.NewReader(archive.Bytes())), cipher)
+	require.ErrorIs(t, err, ErrHistoryArchiveNotEncrypted)
+
+	encoder := codec.NewJSONPBEncoder()
+	legacy, err := encoder.EncodeHistories(testHistoryArchiveBatches(1))
+	require.NoError(t, err)
+	_, err = NewHistoryArchiveReader(bufio.NewReader(bytes.NewReader(legacy)), cipher)
+	require.ErrorIs(t, err, ErrHistoryArchiveNotEncrypted)
+}
+
+func TestHistoryArchive_Encrypted_Tampered(t *testing.T) {
+	cipher := newTestHistoryArchiveCipher(t)
+	var archive bytes.Buffer
+	writer, err := NewHistoryArchiveWriter(&archive, HistoryArchiveCompressionZstd, cipher)
+	require.NoError(t, err)
+	// each batch is in its own frame, the last one in the final frame
+	for i, history := range testHistoryArchiveBatches(3) {
+		require.NoError(t, writer.Write(history))
+		if i < 2 {
+			require.NoError(t, writer.Flush())
+		}
+	}
+	require.NoError(t, writer.Close())
+	header, frames := splitTestHistoryArchive(t, archive.Bytes())
+	require.Len(t, frames, 3)
+	_, err = readAllTestHistoryArchive(archive.Bytes(), cipher)
+	require.NoError(t, err)
+
+	join := func(header []byte, frames ...[]byte) []byte {
+		return append(bytes.Clone(header), bytes.Join(frames, nil)...)
+	}
+	_, n := binary.Uvarint(frames[2])
+	notFinal := bytes.Clone(frames[2])
+	notFinal[n] = historyArchiveFrameFlag(false)
+	tamperedHeader := bytes.Clone(header)
+	tamperedHeader[len(historyArchiveMagic)+1] = byte(HistoryArchiveCompressionGzip)
+	for name, tampered := range map[string][]byte{
+		"dropped frame":          join(header, frames[0], frames[2]),
+		"reordered frames":       join(header, frames[1], frames[0], frames[2]),
+		"duplicated frame":       join(header, frames[0], frames[0], frames[1], frames[2]),
+		"truncated archive":      join(header, frames[0], frames[1]),
+		"frame after final":      join(header, frames[0], frames[1], frames[2], frames[2]),
+		"tampered header":        join(tamperedHeader, frames...),
+		"tampered final flag":    join(header, frames[0], frames[1], notFinal),
+		"frames of other header": join(header[:len(header)-1], frames...),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := readAllTestHistoryArchive(tampered, cipher)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestHistoryArchive_Legacy(t *testing.T) {
+	encoder := codec.NewJSONPBEncoder()
+	encoded, err := encoder.EncodeHistories(testHistoryArchiveBatches(2))
+	require.NoError(t, err)
+	reader := bufio.NewReader(bytes.NewReader(encoded))
+	_, err = NewHistoryArchiveReader(reader, nil)
+	require.ErrorIs(t, err, ErrLegacyHistoryArchive)
+
+	// the legacy archive must not be consumed
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, encoded, data)
+
+	_, err = NewHistoryArchiveReader(bufio.NewReader(bytes.NewReader(nil)), nil)
+	require.ErrorIs(t, err, ErrLegacyHistoryArchive)
+}
+
+func TestHistoryArchive_Corrupted(t *testing.T) {
+	var archive bytes.Buffer
+	writer, err := NewHistoryArchiveWriter(&archive, HistoryArchiveCompressionNone, nil)
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(testHistoryArchiveBatches(1)[0]))
+	require.NoError(t, writer.Close())
+
+	truncated := archive.Bytes()[:archive.Len()-1]
+	reader, err := NewHistoryArchiveReader(bufio.NewReader(bytes.NewReader(truncated)), nil)
+	require.NoError(t, err)
+	defer reader.Close()
+	_, err = reader.Next()
+	require.ErrorIs(t, err, ErrHistoryArchiveCorrupted)
+}
+
+func TestHistoryArchive_UnsupportedVersion(t *testing.T) {
+	header := append([]byte(historyArchiveMagic), HistoryArchiveFormatVersion+1, byte(HistoryArchiveCompressionNone))
+	_, err := NewHistoryArchiveReader(bufio.NewReader(bytes.NewReader(header)), nil)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrLegacyHistoryArchive)
+}
+
+type (
+	testHistoryArchiveCipher struct {
+		aead cipher.AEAD
+	}
+	testHistoryArchiveFrameCipher struct {
+		aead cipher.AEAD
+	}
+)
+
+func newTestHistoryArchiveCipher(t *testing.T) testHistoryArchiveCipher {
+	block, err := aes.NewCipher(make([]byte, 32))
+	require.NoError(t, err)
+	aead, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	return testHistoryArchiveCipher{aead: aead}
+}
+
+func (c testHistoryArchiveCipher) NewKey() (HistoryArchiveFrameCipher, []byte, error) {
+	return testHistoryArchiveFrameCipher(c), []byte("test-key"), nil
+}
+
+func (c testHistoryArchiveCipher) OpenKey(keyMetadata []byte) (HistoryArchiveFrameCipher, error) {
+	if string(keyMetadata) != "test-key" {
+		return nil, errors.New("unknown key")
+	}
+	return testHistoryArchiveFrameCipher(c), nil
+}
+
+// Seal uses a fixed nonce, which is only fine for a test
+func (c testHistoryArchiveFrameCipher) Seal(frame []byte, additionalData []byte) ([]byte, error) {
+	return c.aead.Seal(nil, make([]byte, c.aead.NonceSize()), frame, additionalData), nil
+}
+
+func (c testHistoryArchiveFrameCipher) Open(sealed []byte, additionalData []byte) ([]byte, error) {
+	return c.aead.Open(nil, make([]byte, c.aead.NonceSize()), sealed, additionalData)
+}
+
+func readAllTestHistoryArchive(archive []byte, cipher HistoryArchiveCipher) ([]*historypb.History, error) {
+	reader, err := NewHistoryArchiveReader(bufio.NewReader(bytes.NewReader(archive)), cipher)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	var histories []*historypb.History
+	for {
+		history, err := reader.Next()
+		if err == io.EOF {
+			return histories, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		histories = append(histories, history)
+	}
+}
+
+// splitTestHistoryArchive splits an archive into its header and its length prefixed frames
+func splitTestHistoryArchive(t *testing.T, archive []byte) ([]byte, [][]byte) {
+	r := bytes.NewReader(archive)
+	_, err := r.Seek(int64(historyArchivePrefixSize), io.SeekStart)
+	require.NoError(t, err)
+	keyMetadataSize, err := binary.ReadUvarint(r)
+	require.NoError(t, err)
+	headerSize := len(archive) - r.Len() + int(keyMetadataSize)
+	header, body := archive[:headerSize], archive[headerSize:]
+	var frames [][]byte
+	for len(body) > 0 {
+		size, n := binary.Uvarint(body)
+		require.Positive(t, n)
+		frames = append(frames, body[:n+int(size)])
+		body = body[n+int(size):]
+	}
+	return header, frames
+}
+
+func readTestHistoryArchive(t *testing.T, archive []byte) []*historypb.History {
+	reader, err := NewHistoryArchiveReader(bufio.NewReader(bytes.NewReader(archive)), nil)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	var histories []*historypb.History
+	for {
+		history, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		histories = append(histories, history)
+	}
+	return histories
+}
+
+func testHistoryArchiveBatches(count int) []*historypb.History {
+	histories := make([]*historypb.History, count)
+	for i := range histories {
+		histories[i] = &historypb.History{
+			Events: []*historypb.HistoryEvent{
+				{
+					EventId:   int64(i + 1),
+					EventTime: timestamppb.New(time.Date(2023, 11, 20, 0, 0, i, 0, time.UTC)),
+					Version:   testCloseFailoverVersion,
+					Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+						ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+							Result: &commonpb.Payloads{
+								Payloads: []*commonpb.Payload{{Data: bytes.Repeat([]byte{byte(i)}, 1024)}},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	return histories
+}
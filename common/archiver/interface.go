@@ -76,6 +76,9 @@ type (
 		Logger           log.Logger
 		MetricsHandler   metrics.Handler
 		ClusterMetadata  cluster.Metadata
+		// HistoryArchiveCipher encrypts the history archives written by archivers using the history archive
+		// format, it is nil unless archival encryption is configured
+		HistoryArchiveCipher HistoryArchiveCipher
 	}
 
 	// HistoryArchiver is used to archive history and read archived history
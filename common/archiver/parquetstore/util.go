@@ -0,0 +1,346 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parquetstore
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/parquet-go/parquet-go"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.uber.org/multierr"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver/filestore"
+)
+
+const (
+	namespacePartitionKey = "namespace_id"
+	closeDatePartitionKey = "close_date"
+	closeDateLayout       = "2006-01-02"
+
+	stagingDirName      = "_staging"
+	claimDirPrefix      = "_compacting-"
+	partFilenamePrefix  = "part-"
+	parquetFileExt      = ".parquet"
+	stagedRecordFileExt = ".visibility"
+	tmpFileExt          = ".tmp"
+)
+
+type (
+	// parquetVisibilityRecord is the row layout of the parquet files written by the archiver.
+	// Memo is kept as an opaque proto blob while search attributes are written as a map column,
+	// so that analytics engines can filter on them without decoding payloads.
+	parquetVisibilityRecord struct {
+		NamespaceID        string            `parquet:"namespace_id,dict"`
+		Namespace          string            `parquet:"namespace,dict"`
+		WorkflowID         string            `parquet:"workflow_id"`
+		RunID              string            `parquet:"run_id"`
+		WorkflowTypeName   string            `parquet:"workflow_type_name,dict"`
+		StartTime          *time.Time        `parquet:"start_time,optional"`
+		ExecutionTime      *time.Time        `parquet:"execution_time,optional"`
+		CloseTime          *time.Time        `parquet:"close_time,optional"`
+		Status             string            `parquet:"status,dict"`
+		HistoryLength      int64             `parquet:"history_length"`
+		Memo               []byte            `parquet:"memo"`
+		SearchAttributes   map[string]string `parquet:"search_attributes"`
+		HistoryArchivalURI string            `parquet:"history_archival_uri"`
+	}
+
+	// partition is a single close date directory of a namespace
+	partition struct {
+		dir       string
+		closeDate time.Time
+	}
+
+	// partitionFiles are the parquet files and the claim directories of in-flight compactions of a partition
+	partitionFiles struct {
+		partFiles []string
+		claimDirs []string
+	}
+)
+
+// Partition layout
+
+func namespaceDir(root string, namespaceID string) string {
+	return path.Join(root, fmt.Sprintf("%s=%s", namespacePartitionKey, namespaceID))
+}
+
+func partitionDir(root string, namespaceID string, closeTime time.Time) string {
+	return path.Join(
+		namespaceDir(root, namespaceID),
+		fmt.Sprintf("%s=%s", closeDatePartitionKey, closeTime.UTC().Format(closeDateLayout)),
+	)
+}
+
+func parsePartitionName(name string) (time.Time, bool) {
+	value, ok := strings.CutPrefix(name, closeDatePartitionKey+"=")
+	if !ok {
+		return time.Time{}, false
+	}
+	closeDate, err := time.Parse(closeDateLayout, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return closeDate, true
+}
+
+// listPartitions returns the close date partitions of a namespace which may contain records closed
+// within [earliestCloseTime, latestCloseTime], sorted by close date (desc, or asc if ascending is true).
+func listPartitions(nsDir string, earliestCloseTime time.Time, latestCloseTime time.Time, ascending bool) ([]partition, error) {
+	names, err := filestore.ListFiles(nsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	earliestDate := truncateToDate(earliestCloseTime)
+	latestDate := truncateToDate(latestCloseTime)
+	var partitions []partition
+	for _, name := range names {
+		closeDate, ok := parsePartitionName(name)
+		if !ok {
+			continue
+		}
+		if closeDate.Before(earliestDate) || closeDate.After(latestDate) {
+			continue
+		}
+		partitions = append(partitions, partition{
+			dir:       path.Join(nsDir, name),
+			closeDate: closeDate,
+		})
+	}
+	sort.Slice(partitions, func(i, j int) bool {
+		if ascending {
+			return partitions[i].closeDate.Before(partitions[j].closeDate)
+		}
+		return partitions[i].closeDate.After(partitions[j].closeDate)
+	})
+	return partitions, nil
+}
+
+func listPartitionFiles(partDir string) (*partitionFiles, error) {
+	names, err := filestore.ListFiles(partDir)
+	if err != nil {
+		return nil, err
+	}
+	files := &partitionFiles{}
+	for _, name := range names {
+		switch {
+		case strings.HasSuffix(name, parquetFileExt):
+			files.partFiles = append(files.partFiles, name)
+		case strings.HasPrefix(name, claimDirPrefix):
+			files.claimDirs = append(files.claimDirs, name)
+		}
+	}
+	return files, nil
+}
+
+// allIn returns whether all parquet files and claim directories of f are in names
+func (f *partitionFiles) allIn(names map[string]struct{}) bool {
+	for _, files := range [][]string{f.partFiles, f.claimDirs} {
+		for _, name := range files {
+			if _, ok := names[name]; !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func truncateToDate(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func constructStagedRecordFilename(closeTime time.Time, runID string) string {
+	return fmt.Sprintf("%v_%s%s", closeTime.UnixNano(), runID, stagedRecordFileExt)
+}
+
+func constructPartFilename() string {
+	return partFilenamePrefix + uuid.NewString() + parquetFileExt
+}
+
+func constructClaimDirName() string {
+	return claimDirPrefix + uuid.NewString()
+}
+
+// File I/O util
+
+// writeFileAtomic writes data to a temporary file next to filepath and renames it into place,
+// so that concurrent readers never observe a partially written file.
+func writeFileAtomic(filepath string, data []byte, fileMode os.FileMode) error {
+	tmpFilepath := filepath + "." + uuid.NewString() + tmpFileExt
+	if err := filestore.WriteFile(tmpFilepath, data, fileMode); err != nil {
+		return multierr.Combine(err, removeIfExists(tmpFilepath))
+	}
+	if err := os.Rename(tmpFilepath, filepath); err != nil {
+		return multierr.Combine(err, removeIfExists(tmpFilepath))
+	}
+	return nil
+}
+
+func listFilesBySuffix(dirPath string, suffix string) ([]string, error) {
+	fileNames, err := filestore.ListFiles(dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var filteredFileNames []string
+	for _, name := range fileNames {
+		if strings.HasSuffix(name, suffix) {
+			filteredFileNames = append(filteredFileNames, name)
+		}
+	}
+	return filteredFileNames, nil
+}
+
+func removeIfExists(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Parquet util
+
+func writeParquetFile(filepath string, records []*archiverspb.VisibilityRecord, fileMode os.FileMode) (retErr error) {
+	rows := make([]parquetVisibilityRecord, 0, len(records))
+	for _, record := range records {
+		row, err := toParquetRecord(record)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+
+	tmpFilepath := filepath + tmpFileExt
+	f, err := os.OpenFile(tmpFilepath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fileMode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			retErr = multierr.Combine(retErr, removeIfExists(tmpFilepath))
+		}
+	}()
+	if err := parquet.Write(f, rows, parquet.Compression(&parquet.Zstd)); err != nil {
+		return multierr.Combine(err, f.Close())
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFilepath, filepath)
+}
+
+func readParquetFile(filepath string) ([]*archiverspb.VisibilityRecord, error) {
+	rows, err := parquet.ReadFile[parquetVisibilityRecord](filepath)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*archiverspb.VisibilityRecord, 0, len(rows))
+	for i := range rows {
+		record, err := fromParquetRecord(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func toParquetRecord(record *archiverspb.VisibilityRecord) (parquetVisibilityRecord, error) {
+	var memo []byte
+	if record.Memo != nil {
+		var err error
+		if memo, err = proto.Marshal(record.Memo); err != nil {
+			return parquetVisibilityRecord{}, err
+		}
+	}
+	return parquetVisibilityRecord{
+		NamespaceID:        record.GetNamespaceId(),
+		Namespace:          record.GetNamespace(),
+		WorkflowID:         record.GetWorkflowId(),
+		RunID:              record.GetRunId(),
+		WorkflowTypeName:   record.GetWorkflowTypeName(),
+		StartTime:          timeOrNil(record.GetStartTime()),
+		ExecutionTime:      timeOrNil(record.GetExecutionTime()),
+		CloseTime:          timeOrNil(record.GetCloseTime()),
+		Status:             record.GetStatus().String(),
+		HistoryLength:      record.GetHistoryLength(),
+		Memo:               memo,
+		SearchAttributes:   record.GetSearchAttributes(),
+		HistoryArchivalURI: record.GetHistoryArchivalUri(),
+	}, nil
+}
+
+func fromParquetRecord(row *parquetVisibilityRecord) (*archiverspb.VisibilityRecord, error) {
+	status, err := enumspb.WorkflowExecutionStatusFromString(row.Status)
+	if err != nil {
+		return nil, err
+	}
+	var memo *commonpb.Memo
+	if len(row.Memo) != 0 {
+		memo = &commonpb.Memo{}
+		if err := proto.Unmarshal(row.Memo, memo); err != nil {
+			return nil, err
+		}
+	}
+	return &archiverspb.VisibilityRecord{
+		NamespaceId:        row.NamespaceID,
+		Namespace:          row.Namespace,
+		WorkflowId:         row.WorkflowID,
+		RunId:              row.RunID,
+		WorkflowTypeName:   row.WorkflowTypeName,
+		StartTime:          timestampOrNil(row.StartTime),
+		ExecutionTime:      timestampOrNil(row.ExecutionTime),
+		CloseTime:          timestampOrNil(row.CloseTime),
+		Status:             status,
+		HistoryLength:      row.HistoryLength,
+		Memo:               memo,
+		SearchAttributes:   row.SearchAttributes,
+		HistoryArchivalUri: row.HistoryArchivalURI,
+	}, nil
+}
+
+func timeOrNil(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func timestampOrNil(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
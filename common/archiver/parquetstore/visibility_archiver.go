@@ -0,0 +1,570 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parquetstore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.uber.org/multierr"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/filestore"
+	"go.temporal.io/server/common/archiver/visibilityquery"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	// URIScheme is the scheme for the parquet implementation
+	URIScheme = "parquet"
+
+	errEncodeVisibilityRecord = "failed to encode visibility record"
+	errMakeDirectory          = "failed to make directory"
+	errWriteFile              = "failed to write file"
+
+	defaultBatchSize     = 1000
+	defaultMaxStagingAge = time.Hour
+	// staleClaimTimeout is how long a claimed batch may stay uncompacted before another
+	// archiver assumes its owner crashed and compacts it again.
+	staleClaimTimeout = time.Hour
+	// maxReadPartitionRounds bounds how many times a partition is listed again because
+	// compactions completed while it was read.
+	maxReadPartitionRounds = 100
+)
+
+var (
+	errInvalidFileMode  = errors.New("invalid file mode")
+	errInvalidDirMode   = errors.New("invalid directory mode")
+	errPartitionChanged = errors.New("visibility partition kept changing while it was read")
+
+	maxCloseTime = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+)
+
+type (
+	visibilityArchiver struct {
+		container  *archiver.VisibilityBootstrapContainer
+		fileMode   os.FileMode
+		dirMode    os.FileMode
+		batchSize  int
+		timeSource clock.TimeSource
+		// maxStagingAge is how long records may stay staged before their partition is compacted,
+		// all partitions of a namespace are checked at most once per maxStagingAge.
+		maxStagingAge time.Duration
+
+		sync.Mutex
+		lastSweepTime map[string]time.Time
+	}
+
+	queryVisibilityRequest struct {
+		namespaceID   string
+		pageSize      int
+		nextPageToken []byte
+		query         *visibilityquery.Query
+	}
+)
+
+// NewVisibilityArchiver creates a new archiver.VisibilityArchiver which stores visibility records
+// as parquet files partitioned by namespace and close date.
+func NewVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.ParquetArchiver,
+) (archiver.VisibilityArchiver, error) {
+	fileMode, err := strconv.ParseUint(config.FileMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidFileMode
+	}
+	dirMode, err := strconv.ParseUint(config.DirMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidDirMode
+	}
+	batchSize := config.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	maxStagingAge := config.MaxStagingAge
+	if maxStagingAge <= 0 {
+		maxStagingAge = defaultMaxStagingAge
+	}
+	return &visibilityArchiver{
+		container:     container,
+		fileMode:      os.FileMode(fileMode),
+		dirMode:       os.FileMode(dirMode),
+		batchSize:     batchSize,
+		timeSource:    clock.NewRealTimeSource(),
+		maxStagingAge: maxStagingAge,
+		lastSweepTime: make(map[string]time.Time),
+	}, nil
+}
+
+// Archive stages the record as a single file in its close date partition, and compacts the staged
+// records of the partition into one parquet file once a full batch is available. Staging makes the
+// archiver lossless: a record is durable as soon as Archive returns, even if it is not yet part of a
+// parquet file. Partitions whose records stayed staged for longer than maxStagingAge without filling
+// a batch are compacted by the periodic sweep of the namespace, whatever their close date is.
+func (v *visibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiverspb.VisibilityRecord,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	defer func() {
+		if err != nil && featureCatalog.NonRetryableError != nil {
+			err = featureCatalog.NonRetryableError()
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.container.Logger, request, URI.String())
+
+	if err := v.ValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	closeTime := request.CloseTime.AsTime()
+	partDir := partitionDir(URI.Path(), request.GetNamespaceId(), closeTime)
+	stagingDir := path.Join(partDir, stagingDirName)
+	if err = filestore.MkdirAll(stagingDir, v.dirMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
+		return err
+	}
+
+	encodedVisibilityRecord, err := filestore.Encode(request)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
+		return err
+	}
+
+	filename := constructStagedRecordFilename(closeTime, request.GetRunId())
+	if err := writeFileAtomic(path.Join(stagingDir, filename), encodedVisibilityRecord, v.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+		return err
+	}
+
+	// The record is durable at this point, so compaction failures are only logged. Staged records
+	// are still returned by Query and will be picked up by the next compaction of the partition.
+	if err := v.compactPartition(partDir); err != nil {
+		logger.Warn("failed to compact visibility partition", tag.Error(err))
+	}
+	if err := v.sweepPartitions(namespaceDir(URI.Path(), request.GetNamespaceId())); err != nil {
+		logger.Warn("failed to compact visibility partitions", tag.Error(err))
+	}
+	return nil
+}
+
+func (v *visibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
+	}
+
+	query, err := visibilityquery.Parse(request.Query, saTypeMap)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	if query.EmptyResult() {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	return v.query(
+		ctx,
+		URI,
+		&queryVisibilityRequest{
+			namespaceID:   request.NamespaceID,
+			pageSize:      request.PageSize,
+			nextPageToken: request.NextPageToken,
+			query:         query,
+		},
+		saTypeMap,
+	)
+}
+
+func (v *visibilityArchiver) query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *queryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	var token *visibilityquery.PageToken
+	if request.nextPageToken != nil {
+		var err error
+		token, err = visibilityquery.DeserializePageToken(request.nextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	}
+
+	nsDir := namespaceDir(URI.Path(), request.namespaceID)
+	exists, err := filestore.DirectoryExists(nsDir)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if !exists {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	ascending := request.query.Ascending()
+	earliestCloseTime, latestCloseTime := request.query.CloseTimeRange()
+	if token != nil {
+		if ascending && token.LastCloseTime.After(earliestCloseTime) {
+			earliestCloseTime = token.LastCloseTime
+		}
+		if !ascending && token.LastCloseTime.Before(latestCloseTime) {
+			latestCloseTime = token.LastCloseTime
+		}
+	}
+	partitions, err := listPartitions(nsDir, earliestCloseTime, latestCloseTime, ascending)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	response := &archiver.QueryVisibilityResponse{}
+	for _, partition := range partitions {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		records, err := v.readPartition(partition.dir)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		records, err = sortAndFilterRecords(records, request.query, token)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+
+		for _, record := range records {
+			executionInfo, err := filestore.ConvertToExecutionInfo(record, saTypeMap)
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+
+			response.Executions = append(response.Executions, executionInfo)
+			if len(response.Executions) == request.pageSize {
+				encodedToken, err := visibilityquery.SerializePageToken(request.query.NewPageToken(record))
+				if err != nil {
+					return nil, serviceerror.NewInternal(err.Error())
+				}
+				response.NextPageToken = encodedToken
+				return response, nil
+			}
+		}
+	}
+
+	return response, nil
+}
+
+func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+
+	return filestore.ValidateDirPath(URI.Path())
+}
+
+// readPartition returns all records of a partition, whether they are already compacted into parquet
+// files, staged, or claimed by an in-flight compaction. A record may be seen twice if a compaction
+// crashed after writing its parquet file, so records are deduplicated by run ID.
+//
+// Compactions move records from the staging directory to a claim directory, and publish their parquet
+// file before removing the claim directory. The partition is read in that same order, so a record moved
+// while the partition is read is still seen, unless it is moved to a claim directory or a parquet file
+// created after the partition was listed. Those are read by listing the partition again, until a
+// listing has no claim directory or parquet file which wasn't read yet.
+func (v *visibilityArchiver) readPartition(partDir string) ([]*archiverspb.VisibilityRecord, error) {
+	listing, err := listPartitionFiles(partDir)
+	if err != nil {
+		return nil, err
+	}
+
+	recordsByRunID := make(map[string]*archiverspb.VisibilityRecord)
+	addRecords := func(records ...*archiverspb.VisibilityRecord) {
+		for _, record := range records {
+			recordsByRunID[record.GetRunId()] = record
+		}
+	}
+	records, err := readStagedRecords(path.Join(partDir, stagingDirName))
+	if err != nil {
+		return nil, err
+	}
+	addRecords(records...)
+
+	read := make(map[string]struct{})
+	for round := 0; round < maxReadPartitionRounds; round++ {
+		for _, claimDir := range listing.claimDirs {
+			if _, ok := read[claimDir]; ok {
+				continue
+			}
+			records, err := readStagedRecords(path.Join(partDir, claimDir))
+			if err != nil {
+				return nil, err
+			}
+			addRecords(records...)
+			read[claimDir] = struct{}{}
+		}
+		for _, partFile := range listing.partFiles {
+			if _, ok := read[partFile]; ok {
+				continue
+			}
+			records, err := readParquetFile(path.Join(partDir, partFile))
+			if err != nil {
+				return nil, err
+			}
+			addRecords(records...)
+			read[partFile] = struct{}{}
+		}
+
+		if listing, err = listPartitionFiles(partDir); err != nil {
+			return nil, err
+		}
+		if listing.allIn(read) {
+			records = make([]*archiverspb.VisibilityRecord, 0, len(recordsByRunID))
+			for _, record := range recordsByRunID {
+				records = append(records, record)
+			}
+			return records, nil
+		}
+	}
+	return nil, errPartitionChanged
+}
+
+// sweepPartitions compacts the partitions of a namespace whose records stayed staged for longer than
+// maxStagingAge. It does nothing if the namespace was swept less than maxStagingAge ago.
+func (v *visibilityArchiver) sweepPartitions(nsDir string) error {
+	now := v.timeSource.Now()
+	v.Lock()
+	if lastSweepTime, ok := v.lastSweepTime[nsDir]; ok && now.Sub(lastSweepTime) < v.maxStagingAge {
+		v.Unlock()
+		return nil
+	}
+	v.lastSweepTime[nsDir] = now
+	v.Unlock()
+
+	partitions, err := listPartitions(nsDir, time.Time{}, maxCloseTime, false)
+	if err != nil {
+		return err
+	}
+	var errs error
+	for _, partition := range partitions {
+		errs = multierr.Append(errs, v.compactPartition(partition.dir))
+	}
+	return errs
+}
+
+// compactPartition writes the staged records of a partition into a new parquet file. Nothing is done
+// until at least one full batch is staged, or the oldest staged record is older than maxStagingAge.
+// Staged files are first claimed by moving them into a directory private to this compaction, so
+// concurrent archivers never compact the same record twice.
+func (v *visibilityArchiver) compactPartition(partDir string) error {
+	stagingDir := path.Join(partDir, stagingDirName)
+	exists, err := filestore.DirectoryExists(stagingDir)
+	if err != nil || !exists {
+		return err
+	}
+	staged, oldestStagedTime, err := listStagedFiles(stagingDir)
+	if err != nil {
+		return err
+	}
+	staleClaimDirs, err := v.listStaleClaimDirs(partDir)
+	if err != nil {
+		return err
+	}
+	expired := len(staged) != 0 && v.timeSource.Now().Sub(oldestStagedTime) >= v.maxStagingAge
+	if len(staleClaimDirs) == 0 && len(staged) < v.batchSize && !expired {
+		return nil
+	}
+
+	claimDir := path.Join(partDir, constructClaimDirName())
+	if err := filestore.MkdirAll(claimDir, v.dirMode); err != nil {
+		return err
+	}
+	if err := claimFiles(stagingDir, staged, claimDir); err != nil {
+		return err
+	}
+	for _, staleClaimDir := range staleClaimDirs {
+		names, err := listFilesBySuffix(staleClaimDir, stagedRecordFileExt)
+		if err != nil {
+			return err
+		}
+		if err := claimFiles(staleClaimDir, names, claimDir); err != nil {
+			return err
+		}
+		if err := os.RemoveAll(staleClaimDir); err != nil {
+			return err
+		}
+	}
+
+	records, err := readStagedRecords(claimDir)
+	if err != nil {
+		return err
+	}
+	if len(records) != 0 {
+		if err := writeParquetFile(path.Join(partDir, constructPartFilename()), records, v.fileMode); err != nil {
+			return err
+		}
+	}
+	return os.RemoveAll(claimDir)
+}
+
+// claimFiles moves the given files into claimDir. Files which have already been moved away by
+// another archiver are skipped.
+func claimFiles(srcDir string, names []string, claimDir string) error {
+	for _, name := range names {
+		if err := os.Rename(path.Join(srcDir, name), path.Join(claimDir, name)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// listStagedFiles returns the staged record files of a staging directory, and the modification time of the oldest one
+func listStagedFiles(stagingDir string) ([]string, time.Time, error) {
+	entries, err := os.ReadDir(stagingDir)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	var names []string
+	var oldestStagedTime time.Time
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), stagedRecordFileExt) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				// staged file was claimed by a compaction after it was listed
+				continue
+			}
+			return nil, time.Time{}, err
+		}
+		names = append(names, entry.Name())
+		if oldestStagedTime.IsZero() || info.ModTime().Before(oldestStagedTime) {
+			oldestStagedTime = info.ModTime()
+		}
+	}
+	return names, oldestStagedTime, nil
+}
+
+func (v *visibilityArchiver) listStaleClaimDirs(partDir string) ([]string, error) {
+	entries, err := os.ReadDir(partDir)
+	if err != nil {
+		return nil, err
+	}
+	var staleClaimDirs []string
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), claimDirPrefix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		if v.timeSource.Now().Sub(info.ModTime()) > staleClaimTimeout {
+			staleClaimDirs = append(staleClaimDirs, path.Join(partDir, entry.Name()))
+		}
+	}
+	return staleClaimDirs, nil
+}
+
+func readStagedRecords(dir string) ([]*archiverspb.VisibilityRecord, error) {
+	names, err := listFilesBySuffix(dir, stagedRecordFileExt)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// claimed directory was compacted and removed after it was listed
+			return nil, nil
+		}
+		return nil, err
+	}
+	records := make([]*archiverspb.VisibilityRecord, 0, len(names))
+	for _, name := range names {
+		encodedRecord, err := filestore.ReadFile(path.Join(dir, name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				// staged file was claimed by a compaction after it was listed
+				continue
+			}
+			return nil, err
+		}
+		record, err := filestore.DecodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// sortAndFilterRecords sorts records in the order of the query.
+// Only records matching the query and coming after the given page token are returned.
+func sortAndFilterRecords(
+	records []*archiverspb.VisibilityRecord,
+	query *visibilityquery.Query,
+	token *visibilityquery.PageToken,
+) ([]*archiverspb.VisibilityRecord, error) {
+	filtered := records[:0]
+	for _, record := range records {
+		if token != nil && !query.IsAfter(record, token) {
+			continue
+		}
+		match, err := query.Match(record)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			filtered = append(filtered, record)
+		}
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		return query.Less(filtered[i], filtered[j])
+	})
+	return filtered, nil
+}
@@ -0,0 +1,509 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parquetstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/filestore"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/tests/testutils"
+)
+
+const (
+	testNamespaceID      = "test-namespace-id"
+	testNamespace        = "test-namespace"
+	testWorkflowID       = "test-workflow-id"
+	testRunID            = "test-run-id"
+	testWorkflowTypeName = "test-workflow-type"
+	testBatchSize        = 3
+)
+
+type visibilityArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	container *archiver.VisibilityBootstrapContainer
+}
+
+func TestVisibilityArchiverSuite(t *testing.T) {
+	suite.Run(t, new(visibilityArchiverSuite))
+}
+
+func (s *visibilityArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.container = &archiver.VisibilityBootstrapContainer{
+		Logger: log.NewNoopLogger(),
+	}
+}
+
+func (s *visibilityArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme:///a/b/c",
+			expectedErr: archiver.ErrURISchemeMismatch,
+		},
+		{
+			URI:         "parquet://",
+			expectedErr: filestore.ErrEmptyDirectoryPath,
+		},
+		{
+			URI:         "parquet:///a/b/c",
+			expectedErr: nil,
+		},
+	}
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		s.NoError(err)
+		s.Equal(tc.expectedErr, visibilityArchiver.ValidateURI(URI))
+	}
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidURI() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	err = visibilityArchiver.Archive(context.Background(), URI, s.newVisibilityRecord(testRunID, time.Now().UTC()))
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	err := visibilityArchiver.Archive(context.Background(), s.newTestURI(), &archiverspb.VisibilityRecord{})
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_NonRetryableErrorOption() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	nonRetryableErr := errors.New("some non-retryable error")
+	err := visibilityArchiver.Archive(
+		context.Background(),
+		s.newTestURI(),
+		&archiverspb.VisibilityRecord{},
+		archiver.GetNonRetryableErrorOption(nonRetryableErr),
+	)
+	s.Equal(nonRetryableErr, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Success_Staged() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI := s.newTestURI()
+	closeTime := time.Now().UTC()
+	request := s.newVisibilityRecord(testRunID, closeTime)
+
+	err := visibilityArchiver.Archive(context.Background(), URI, request)
+	s.NoError(err)
+
+	partDir := partitionDir(URI.Path(), testNamespaceID, closeTime)
+	filepath := path.Join(partDir, stagingDirName, constructStagedRecordFilename(closeTime, testRunID))
+	data, err := filestore.ReadFile(filepath)
+	s.NoError(err)
+	archivedRecord, err := filestore.DecodeVisibilityRecord(data)
+	s.NoError(err)
+	s.Equal(request, archivedRecord)
+
+	parquetFiles, err := listFilesBySuffix(partDir, parquetFileExt)
+	s.NoError(err)
+	s.Empty(parquetFiles)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Success_Compacted() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI := s.newTestURI()
+	closeTime := time.Date(2023, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	var requests []*archiverspb.VisibilityRecord
+	for i := 0; i < testBatchSize; i++ {
+		request := s.newVisibilityRecord(fmt.Sprintf("run-%d", i), closeTime.Add(time.Duration(i)*time.Minute))
+		requests = append(requests, request)
+		s.NoError(visibilityArchiver.Archive(context.Background(), URI, request))
+	}
+
+	partDir := partitionDir(URI.Path(), testNamespaceID, closeTime)
+	staged, err := listFilesBySuffix(path.Join(partDir, stagingDirName), stagedRecordFileExt)
+	s.NoError(err)
+	s.Empty(staged)
+	parquetFiles, err := listFilesBySuffix(partDir, parquetFileExt)
+	s.NoError(err)
+	s.Len(parquetFiles, 1)
+
+	records, err := readParquetFile(path.Join(partDir, parquetFiles[0]))
+	s.NoError(err)
+	s.Len(records, len(requests))
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].GetRunId() < records[j].GetRunId()
+	})
+	for i, record := range records {
+		s.True(proto.Equal(requests[i], record))
+	}
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Success_LateRecordStaysStaged() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI := s.newTestURI()
+	closeTime := time.Date(2023, 11, 20, 23, 0, 0, 0, time.UTC)
+
+	s.NoError(visibilityArchiver.Archive(context.Background(), URI, s.newVisibilityRecord("run-0", closeTime)))
+	s.NoError(visibilityArchiver.Archive(context.Background(), URI, s.newVisibilityRecord("run-1", closeTime.Add(2*time.Hour))))
+	s.NoError(visibilityArchiver.Archive(context.Background(), URI, s.newVisibilityRecord("run-2", closeTime.Add(-time.Hour))))
+
+	// records of an ended day are not compacted into small parquet files until they are old enough
+	s.assertPartition(URI, closeTime, 2, 0)
+	s.assertPartition(URI, closeTime.Add(2*time.Hour), 1, 0)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Success_CompactsExpiredPartitions() {
+	visibilityArchiver := s.newTestVisibilityArchiver().(*visibilityArchiver)
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	visibilityArchiver.timeSource = timeSource
+	URI := s.newTestURI()
+	// no record closes on the days after the close date of the first record
+	closeTime := time.Date(2023, 11, 20, 12, 0, 0, 0, time.UTC)
+	laterCloseTime := closeTime.AddDate(0, 0, 3)
+
+	s.NoError(visibilityArchiver.Archive(context.Background(), URI, s.newVisibilityRecord("run-0", closeTime)))
+	s.NoError(visibilityArchiver.Archive(context.Background(), URI, s.newVisibilityRecord("run-1", laterCloseTime)))
+	s.assertPartition(URI, closeTime, 1, 0)
+	s.assertPartition(URI, laterCloseTime, 1, 0)
+
+	timeSource.Advance(defaultMaxStagingAge / 2)
+	s.NoError(visibilityArchiver.Archive(context.Background(), URI, s.newVisibilityRecord("run-2", laterCloseTime)))
+	s.assertPartition(URI, closeTime, 1, 0)
+	s.assertPartition(URI, laterCloseTime, 2, 0)
+
+	// staged files are written a bit after the time source was set
+	timeSource.Advance(defaultMaxStagingAge/2 + time.Minute)
+	s.NoError(visibilityArchiver.Archive(context.Background(), URI, s.newVisibilityRecord("run-3", closeTime.AddDate(0, 0, 5))))
+	s.assertPartition(URI, closeTime, 0, 1)
+	s.assertPartition(URI, laterCloseTime, 0, 1)
+
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+	}
+	response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Len(response.Executions, 4)
+}
+
+func (s *visibilityArchiverSuite) TestCompactPartition_StaleClaim() {
+	visibilityArchiver := s.newTestVisibilityArchiver().(*visibilityArchiver)
+	URI := s.newTestURI()
+	closeTime := time.Date(2023, 11, 20, 12, 0, 0, 0, time.UTC)
+	s.NoError(visibilityArchiver.Archive(context.Background(), URI, s.newVisibilityRecord("run-0", closeTime)))
+
+	// simulate an archiver which crashed after claiming the staged record
+	partDir := partitionDir(URI.Path(), testNamespaceID, closeTime)
+	claimDir := path.Join(partDir, constructClaimDirName())
+	s.NoError(filestore.MkdirAll(claimDir, visibilityArchiver.dirMode))
+	staged, err := listFilesBySuffix(path.Join(partDir, stagingDirName), stagedRecordFileExt)
+	s.NoError(err)
+	s.NoError(claimFiles(path.Join(partDir, stagingDirName), staged, claimDir))
+	staleTime := time.Now().Add(-2 * staleClaimTimeout)
+	s.NoError(os.Chtimes(claimDir, staleTime, staleTime))
+
+	s.NoError(visibilityArchiver.compactPartition(partDir))
+
+	parquetFiles, err := listFilesBySuffix(partDir, parquetFileExt)
+	s.NoError(err)
+	s.Len(parquetFiles, 1)
+	exists, err := filestore.DirectoryExists(claimDir)
+	s.NoError(err)
+	s.False(exists)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidURI() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+	}
+	response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+	s.Error(err)
+	s.Nil(response)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+		Query:       "some invalid query",
+	}
+	response, err := visibilityArchiver.Query(context.Background(), s.newTestURI(), request, searchattribute.TestNameTypeMap)
+	s.Error(err)
+	s.Nil(response)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_NamespaceNotExist() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+	}
+	response, err := visibilityArchiver.Query(context.Background(), s.newTestURI(), request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Empty(response.Executions)
+	s.Nil(response.NextPageToken)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_Pagination() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI := s.newTestURI()
+	closeTime := time.Date(2023, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	// Records span three partitions, with one partition compacted, one partially compacted
+	// and one only staged.
+	var runIDs []string
+	for day := 0; day < 3; day++ {
+		for i := 0; i < testBatchSize+day-1; i++ {
+			runID := fmt.Sprintf("run-%d-%d", day, i)
+			record := s.newVisibilityRecord(runID, closeTime.AddDate(0, 0, -day).Add(time.Duration(i)*time.Minute))
+			s.NoError(visibilityArchiver.Archive(context.Background(), URI, record))
+			runIDs = append(runIDs, runID)
+		}
+	}
+
+	var executions []string
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    2,
+	}
+	for {
+		response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+		s.NoError(err)
+		s.LessOrEqual(len(response.Executions), request.PageSize)
+		for _, execution := range response.Executions {
+			executions = append(executions, execution.GetExecution().GetRunId())
+		}
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+
+	s.Equal([]string{
+		"run-0-1", "run-0-0",
+		"run-1-2", "run-1-1", "run-1-0",
+		"run-2-3", "run-2-2", "run-2-1", "run-2-0",
+	}, executions)
+	s.ElementsMatch(runIDs, executions)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_Filter() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI := s.newTestURI()
+	closeTime := time.Date(2023, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	for day := 0; day < 5; day++ {
+		record := s.newVisibilityRecord(fmt.Sprintf("run-%d", day), closeTime.AddDate(0, 0, -day))
+		if day%2 == 0 {
+			record.Status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+		}
+		s.NoError(visibilityArchiver.Archive(context.Background(), URI, record))
+	}
+
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+		Query: fmt.Sprintf(
+			"CloseTime >= '%s' and CloseTime <= '%s' and ExecutionStatus = 'Completed'",
+			closeTime.AddDate(0, 0, -3).Format(time.RFC3339),
+			closeTime.AddDate(0, 0, -1).Format(time.RFC3339),
+		),
+	}
+	response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 1)
+	s.Equal("run-2", response.Executions[0].GetExecution().GetRunId())
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, response.Executions[0].GetStatus())
+	s.Equal(testWorkflowTypeName, response.Executions[0].GetType().GetName())
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_OrderByCloseTime() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI := s.newTestURI()
+	closeTime := time.Date(2023, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	for day := 0; day < 4; day++ {
+		record := s.newVisibilityRecord(fmt.Sprintf("run-%d", day), closeTime.AddDate(0, 0, -day))
+		record.SearchAttributes["CustomIntField"] = fmt.Sprintf("%d", day)
+		s.NoError(visibilityArchiver.Archive(context.Background(), URI, record))
+	}
+
+	var executions []string
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+		Query:       "(CustomIntField IN (0, 2) or RunId = 'run-3') and CustomKeywordField = '456' order by CloseTime asc",
+	}
+	for {
+		response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+		s.NoError(err)
+		for _, execution := range response.Executions {
+			executions = append(executions, execution.GetExecution().GetRunId())
+		}
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal([]string{"run-3", "run-2", "run-0"}, executions)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_DeduplicateRecords() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI := s.newTestURI()
+	closeTime := time.Date(2023, 11, 20, 12, 0, 0, 0, time.UTC)
+	record := s.newVisibilityRecord(testRunID, closeTime)
+
+	// the same record both compacted and staged, as left behind by a crashed compaction
+	partDir := partitionDir(URI.Path(), testNamespaceID, closeTime)
+	s.NoError(filestore.MkdirAll(partDir, os.FileMode(0766)))
+	s.NoError(writeParquetFile(path.Join(partDir, constructPartFilename()), []*archiverspb.VisibilityRecord{record}, os.FileMode(0666)))
+	s.NoError(visibilityArchiver.Archive(context.Background(), URI, record))
+
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+	}
+	response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Len(response.Executions, 1)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_ConcurrentCompaction() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI := s.newTestURI()
+	closeTime := time.Date(2023, 11, 20, 12, 0, 0, 0, time.UTC)
+	numRecords := 100
+
+	// records archived before a query starts must be returned, even if they are compacted while the partition is read
+	var archived atomic.Int32
+	errCh := make(chan error, 1)
+	go func() {
+		for i := 0; i < numRecords; i++ {
+			record := s.newVisibilityRecord(fmt.Sprintf("run-%d", i), closeTime.Add(time.Duration(i)*time.Second))
+			if err := visibilityArchiver.Archive(context.Background(), URI, record); err != nil {
+				errCh <- err
+				return
+			}
+			archived.Add(1)
+		}
+		errCh <- nil
+	}()
+
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    numRecords,
+	}
+	for {
+		expected := int(archived.Load())
+		response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+		s.NoError(err)
+		s.GreaterOrEqual(len(response.Executions), expected)
+		if expected == numRecords {
+			break
+		}
+	}
+	s.NoError(<-errCh)
+}
+
+func (s *visibilityArchiverSuite) assertPartition(URI archiver.URI, closeTime time.Time, expectedStaged int, expectedParquetFiles int) {
+	partDir := partitionDir(URI.Path(), testNamespaceID, closeTime)
+	staged, err := listFilesBySuffix(path.Join(partDir, stagingDirName), stagedRecordFileExt)
+	s.NoError(err)
+	s.Len(staged, expectedStaged)
+	parquetFiles, err := listFilesBySuffix(partDir, parquetFileExt)
+	s.NoError(err)
+	s.Len(parquetFiles, expectedParquetFiles)
+}
+
+func (s *visibilityArchiverSuite) newTestVisibilityArchiver() archiver.VisibilityArchiver {
+	config := &config.ParquetArchiver{
+		FileMode:  "0666",
+		DirMode:   "0766",
+		BatchSize: testBatchSize,
+	}
+	visibilityArchiver, err := NewVisibilityArchiver(s.container, config)
+	s.NoError(err)
+	return visibilityArchiver
+}
+
+func (s *visibilityArchiverSuite) newTestURI() archiver.URI {
+	dir := testutils.MkdirTemp(s.T(), "", "TestParquetVisibilityArchiver")
+	URI, err := archiver.NewURI("parquet://" + dir)
+	s.NoError(err)
+	return URI
+}
+
+func (s *visibilityArchiverSuite) newVisibilityRecord(runID string, closeTime time.Time) *archiverspb.VisibilityRecord {
+	return &archiverspb.VisibilityRecord{
+		NamespaceId:      testNamespaceID,
+		Namespace:        testNamespace,
+		WorkflowId:       testWorkflowID,
+		RunId:            runID,
+		WorkflowTypeName: testWorkflowTypeName,
+		StartTime:        timestamppb.New(closeTime.Add(-time.Hour)),
+		CloseTime:        timestamppb.New(closeTime),
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+		HistoryLength:    int64(101),
+		Memo: &commonpb.Memo{
+			Fields: map[string]*commonpb.Payload{
+				"testFields": payload.EncodeBytes([]byte{1, 2, 3}),
+			},
+		},
+		SearchAttributes: map[string]string{
+			"CustomKeywordField": "456",
+		},
+	}
+}
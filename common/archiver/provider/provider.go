@@ -33,7 +33,9 @@ import (
 	"go.temporal.io/server/common/archiver/gcloud"
 
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/encryption"
 	"go.temporal.io/server/common/archiver/filestore"
+	"go.temporal.io/server/common/archiver/parquetstore"
 	"go.temporal.io/server/common/archiver/s3store"
 	"go.temporal.io/server/common/config"
 )
@@ -138,40 +140,54 @@ func (p *archiverProvider) GetHistoryArchiver(scheme, serviceName string) (histo
 		return nil, ErrBootstrapContainerNotFound
 	}
 
+	if p.historyArchiverConfigs.Encryption != nil {
+		// all archivers created by the provider write history in the history archive format,
+		// which is encrypted with the cipher of the container
+		cipher, err := encryption.NewConfiguredHistoryArchiveCipher(p.historyArchiverConfigs.Encryption)
+		if err != nil {
+			return nil, err
+		}
+		encryptingContainer := *container
+		encryptingContainer.HistoryArchiveCipher = cipher
+		container = &encryptingContainer
+	}
+	historyArchiver, err = p.newHistoryArchiver(scheme, container)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	if existingHistoryArchiver, ok := p.historyArchivers[archiverKey]; ok {
+		return existingHistoryArchiver, nil
+	}
+	p.historyArchivers[archiverKey] = historyArchiver
+	return historyArchiver, nil
+}
+
+func (p *archiverProvider) newHistoryArchiver(scheme string, container *archiver.HistoryBootstrapContainer) (archiver.HistoryArchiver, error) {
 	switch scheme {
 	case filestore.URIScheme:
 		if p.historyArchiverConfigs.Filestore == nil {
 			return nil, ErrArchiverConfigNotFound
 		}
-		historyArchiver, err = filestore.NewHistoryArchiver(container, p.historyArchiverConfigs.Filestore)
+		return filestore.NewHistoryArchiver(container, p.historyArchiverConfigs.Filestore)
 
 	case gcloud.URIScheme:
 		if p.historyArchiverConfigs.Gstorage == nil {
 			return nil, ErrArchiverConfigNotFound
 		}
 
-		historyArchiver, err = gcloud.NewHistoryArchiver(container, p.historyArchiverConfigs.Gstorage)
+		return gcloud.NewHistoryArchiver(container, p.historyArchiverConfigs.Gstorage)
 
 	case s3store.URIScheme:
 		if p.historyArchiverConfigs.S3store == nil {
 			return nil, ErrArchiverConfigNotFound
 		}
-		historyArchiver, err = s3store.NewHistoryArchiver(container, p.historyArchiverConfigs.S3store)
+		return s3store.NewHistoryArchiver(container, p.historyArchiverConfigs.S3store)
 	default:
 		return nil, ErrUnknownScheme
 	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	p.Lock()
-	defer p.Unlock()
-	if existingHistoryArchiver, ok := p.historyArchivers[archiverKey]; ok {
-		return existingHistoryArchiver, nil
-	}
-	p.historyArchivers[archiverKey] = historyArchiver
-	return historyArchiver, nil
 }
 
 func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (archiver.VisibilityArchiver, error) {
@@ -207,6 +223,11 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 			return nil, ErrArchiverConfigNotFound
 		}
 		visibilityArchiver, err = gcloud.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Gstorage)
+	case parquetstore.URIScheme:
+		if p.visibilityArchiverConfigs.Parquet == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		visibilityArchiver, err = parquetstore.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Parquet)
 
 	default:
 		return nil, ErrUnknownScheme
@@ -24,12 +24,20 @@
 
 // S3 History Archiver will archive workflow histories to amazon s3
 
+// Each Archive() request streams the history batches into a single object in the compressed
+// history archive format of the archiver package. The object is written with a multipart upload,
+// whose progress is recorded so that a retried Archive() resumes after the last uploaded part.
+// Histories archived by older versions as one JSON encoded object per history blob remain readable.
+
 package s3store
 
 import (
+	"bufio"
+	"bytes"
 	"context"
-	"encoding/binary"
 	"errors"
+	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"time"
@@ -40,6 +48,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
@@ -60,33 +69,56 @@ const (
 	errWriteKey             = "failed to write history to s3"
 	defaultBlobstoreTimeout = time.Minute
 	targetHistoryBlobSize   = 2 * 1024 * 1024 // 2MB
+	// multipartUploadPartSize must be at least 5MB, the minimum size of all but the last part of a multipart upload
+	multipartUploadPartSize = 8 * 1024 * 1024 // 8MB
+	historyArchiveKeySuffix = "archive"
 )
 
 var (
-	errNoBucketSpecified = errors.New("no bucket specified")
-	errBucketNotExists   = errors.New("requested bucket does not exist")
-	errEmptyAwsRegion    = errors.New("empty aws region")
+	errNoBucketSpecified  = errors.New("no bucket specified")
+	errBucketNotExists    = errors.New("requested bucket does not exist")
+	errEmptyAwsRegion     = errors.New("empty aws region")
+	errInvalidCompression = errors.New("invalid compression")
 )
 
 type (
 	historyArchiver struct {
 		container *archiver.HistoryBootstrapContainer
 		s3cli     s3iface.S3API
+		// compression of newly archived history
+		compression archiver.HistoryArchiveCompression
 		// only set in test code
 		historyIterator archiver.HistoryIterator
 	}
 
 	getHistoryToken struct {
 		CloseFailoverVersion int64
-		BatchIdx             int
+		// BatchIdx is the index of the next history blob object for history archived by older versions
+		BatchIdx int
+		// NextBatchPosition is the position of the next history batch in the history archive,
+		// so that pages are read with a ranged get instead of downloading the archive from the start
+		NextBatchPosition *archiver.HistoryArchivePosition `json:",omitempty"`
 	}
 
 	uploadProgress struct {
-		BatchIdx      int
+		// UploadID is the ID of the multipart upload of the history archive,
+		// it is only set once the first part was uploaded
+		UploadID string
+		Parts    []uploadedPart
+		// KeyMetadata is the key metadata in the header of an encrypted history archive,
+		// the frames of the parts uploaded by the next attempt are encrypted with the same key
+		KeyMetadata []byte
+		// NextFrameIdx is the index of the first frame of the parts uploaded by the next attempt
+		NextFrameIdx  uint64
 		IteratorState []byte
 		uploadedSize  int64
 		historySize   int64
 	}
+
+	uploadedPart struct {
+		PartNumber int64
+		ETag       string
+	}
 )
 
 // NewHistoryArchiver creates a new archiver.HistoryArchiver based on s3
@@ -115,10 +147,15 @@ func newHistoryArchiver(
 	if err != nil {
 		return nil, err
 	}
+	compression, err := archiver.ParseHistoryArchiveCompression(config.Compression)
+	if err != nil {
+		return nil, errInvalidCompression
+	}
 
 	return &historyArchiver{
 		container:       container,
 		s3cli:           s3.New(sess),
+		compression:     compression,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -157,10 +194,48 @@ func (h *historyArchiver) Archive(
 		return err
 	}
 
+	key := constructHistoryArchiveKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
 	var progress uploadProgress
-	historyIterator := h.historyIterator
-	if historyIterator == nil { // will only be set by testing code
-		historyIterator = loadHistoryIterator(ctx, request, h.container.ExecutionManager, featureCatalog, &progress)
+	historyIterator := loadHistoryIterator(ctx, request, h.container.ExecutionManager, featureCatalog, &progress)
+	if h.historyIterator != nil { // will only be set by testing code
+		historyIterator = h.historyIterator
+	}
+	defer func() {
+		// keep the parts of an unfinished upload only if the next attempt can resume it,
+		// abandoned uploads should also be cleaned up with a bucket lifecycle rule
+		if err != nil && progress.UploadID != "" && featureCatalog.ProgressManager == nil {
+			_ = abortMultipartUpload(ctx, h.s3cli, URI, key, progress.UploadID)
+		}
+	}()
+
+	if len(progress.Parts) == 0 {
+		exists, err := KeyExists(ctx, h.s3cli, URI, key)
+		if err != nil {
+			h.logUploadError(logger, err)
+			return err
+		}
+		if exists {
+			handler.Counter(metrics.HistoryArchiverBlobExistsCount.Name()).Record(1)
+			return nil
+		}
+	}
+
+	var part bytes.Buffer
+	var historyWriter *archiver.HistoryArchiveWriter
+	newHistoryWriter := func() error {
+		var err error
+		if len(progress.Parts) == 0 {
+			historyWriter, err = archiver.NewHistoryArchiveWriter(&part, h.compression, h.container.HistoryArchiveCipher)
+			if err == nil {
+				progress.KeyMetadata = historyWriter.KeyMetadata()
+			}
+		} else {
+			historyWriter, err = archiver.ResumeHistoryArchiveWriter(&part, h.compression, h.container.HistoryArchiveCipher, progress.KeyMetadata, progress.NextFrameIdx)
+		}
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+		}
+		return err
 	}
 	for historyIterator.HasNext() {
 		historyBlob, err := historyIterator.Next(ctx)
@@ -171,6 +246,9 @@ func (h *historyArchiver) Archive(
 				// can be processed concurrently.
 				logger.Info(archiver.ArchiveSkippedInfoMsg)
 				handler.Counter(metrics.HistoryArchiverDuplicateArchivalsCount.Name()).Record(1)
+				if progress.UploadID != "" {
+					_ = abortMultipartUpload(ctx, h.s3cli, URI, key, progress.UploadID)
+				}
 				return nil
 			}
 
@@ -188,42 +266,69 @@ func (h *historyArchiver) Archive(
 			return archiver.ErrHistoryMutated
 		}
 
-		encoder := codec.NewJSONPBEncoder()
-		encodedHistoryBlob, err := encoder.Encode(historyBlob)
-		if err != nil {
+		if historyWriter == nil {
+			if err := newHistoryWriter(); err != nil {
+				return err
+			}
+		}
+		sizeBefore := historyWriter.Size()
+		for _, batch := range historyBlob.Body {
+			if err := historyWriter.Write(batch); err != nil {
+				logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+				return err
+			}
+		}
+		progress.historySize += historyWriter.Size() - sizeBefore
+
+		if part.Len() < multipartUploadPartSize {
+			continue
+		}
+		// the frame is ended with the part, so that the next attempt can resume after it
+		if err := historyWriter.Flush(); err != nil {
 			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 			return err
 		}
-		key := constructHistoryKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, progress.BatchIdx)
+		progress.NextFrameIdx = historyWriter.FrameIndex()
+		if err := h.uploadPart(ctx, URI, key, part.Bytes(), featureCatalog, &progress); err != nil {
+			h.logUploadError(logger, err)
+			return err
+		}
+		handler.Histogram(metrics.HistoryArchiverBlobSize.Name(), metrics.HistoryArchiverBlobSize.Unit()).Record(int64(part.Len()))
+		part.Reset()
+		saveHistoryIteratorState(ctx, featureCatalog, historyIterator, &progress)
+	}
 
-		exists, err := KeyExists(ctx, h.s3cli, URI, key)
-		if err != nil {
-			if isRetryableError(err) {
-				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
-			} else {
-				logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
-			}
+	if historyWriter == nil {
+		// the parts of all history were uploaded by a previous attempt, but the archive still needs to be ended
+		if err := newHistoryWriter(); err != nil {
 			return err
 		}
-		blobSize := int64(binary.Size(encodedHistoryBlob))
-		if exists {
-			handler.Counter(metrics.HistoryArchiverBlobExistsCount.Name()).Record(1)
-		} else {
-			if err := Upload(ctx, h.s3cli, URI, key, encodedHistoryBlob); err != nil {
-				if isRetryableError(err) {
-					logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
-				} else {
-					logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
-				}
+	}
+	if err := historyWriter.Close(); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+		return err
+	}
+	if progress.UploadID == "" {
+		// small histories fit in a single part and don't need a multipart upload
+		if err := Upload(ctx, h.s3cli, URI, key, part.Bytes()); err != nil {
+			h.logUploadError(logger, err)
+			return err
+		}
+		progress.uploadedSize += int64(part.Len())
+	} else {
+		if part.Len() > 0 {
+			if err := h.uploadPart(ctx, URI, key, part.Bytes(), featureCatalog, &progress); err != nil {
+				h.logUploadError(logger, err)
 				return err
 			}
-			progress.uploadedSize += blobSize
-			handler.Histogram(metrics.HistoryArchiverBlobSize.Name(), metrics.HistoryArchiverBlobSize.Unit()).Record(blobSize)
 		}
-
-		progress.historySize += blobSize
-		progress.BatchIdx = progress.BatchIdx + 1
-		saveHistoryIteratorState(ctx, featureCatalog, historyIterator, &progress)
+		if err := completeMultipartUpload(ctx, h.s3cli, URI, key, progress.UploadID, progress.Parts); err != nil {
+			h.logUploadError(logger, err)
+			return err
+		}
+	}
+	if part.Len() > 0 {
+		handler.Histogram(metrics.HistoryArchiverBlobSize.Name(), metrics.HistoryArchiverBlobSize.Unit()).Record(int64(part.Len()))
 	}
 
 	handler.Histogram(metrics.HistoryArchiverTotalUploadSize.Name(), metrics.HistoryArchiverTotalUploadSize.Unit()).Record(progress.uploadedSize)
@@ -232,20 +337,60 @@ func (h *historyArchiver) Archive(
 	return nil
 }
 
+// uploadPart uploads the next part of the multipart upload of the history archive,
+// the multipart upload is created with the first part.
+func (h *historyArchiver) uploadPart(
+	ctx context.Context,
+	URI archiver.URI,
+	key string,
+	data []byte,
+	featureCatalog *archiver.ArchiveFeatureCatalog,
+	progress *uploadProgress,
+) error {
+	if progress.UploadID == "" {
+		uploadID, err := createMultipartUpload(ctx, h.s3cli, URI, key)
+		if err != nil {
+			return err
+		}
+		progress.UploadID = uploadID
+	}
+	partNumber := int64(len(progress.Parts) + 1)
+	etag, err := uploadPart(ctx, h.s3cli, URI, key, progress.UploadID, partNumber, data)
+	if err != nil {
+		if isNoSuchUploadError(err) && featureCatalog.ProgressManager != nil {
+			// the upload was aborted, e.g. by a bucket lifecycle rule, restart from scratch on the next attempt
+			_ = featureCatalog.ProgressManager.RecordProgress(ctx, &uploadProgress{})
+		}
+		return err
+	}
+	progress.Parts = append(progress.Parts, uploadedPart{
+		PartNumber: partNumber,
+		ETag:       etag,
+	})
+	progress.uploadedSize += int64(len(data))
+	return nil
+}
+
+func (h *historyArchiver) logUploadError(logger log.Logger, err error) {
+	if isRetryableError(err) {
+		logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
+	} else {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
+	}
+}
+
 func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, executionManager persistence.ExecutionManager, featureCatalog *archiver.ArchiveFeatureCatalog, progress *uploadProgress) (historyIterator archiver.HistoryIterator) {
 	if featureCatalog.ProgressManager != nil {
 		if featureCatalog.ProgressManager.HasProgress(ctx) {
 			err := featureCatalog.ProgressManager.LoadProgress(ctx, progress)
-			if err == nil {
+			// progress recorded before the history archive format was introduced has no upload to resume
+			if err == nil && progress.UploadID != "" {
 				historyIterator, err := archiver.NewHistoryIteratorFromState(request, executionManager, targetHistoryBlobSize, progress.IteratorState)
 				if err == nil {
 					return historyIterator
 				}
 			}
-			progress.IteratorState = nil
-			progress.BatchIdx = 0
-			progress.historySize = 0
-			progress.uploadedSize = 0
+			*progress = uploadProgress{}
 		}
 	}
 	return archiver.NewHistoryIterator(request, executionManager, targetHistoryBlobSize)
@@ -302,6 +447,27 @@ func (h *historyArchiver) Get(
 			CloseFailoverVersion: *highestVersion,
 		}
 	}
+
+	key := constructHistoryArchiveKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion)
+	historyBatches, nextPageToken, err := h.readHistoryArchive(ctx, URI, key, token, request.PageSize)
+	if err == nil {
+		response := &archiver.GetHistoryResponse{
+			HistoryBatches: historyBatches,
+		}
+		if nextPageToken != nil {
+			nextToken, err := SerializeToken(nextPageToken)
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+			response.NextPageToken = nextToken
+		}
+		return response, nil
+	}
+	if _, isNotFound := err.(*serviceerror.NotFound); !isNotFound {
+		return nil, err
+	}
+
+	// the history was archived by an older version, with one object per history blob
 	encoder := codec.NewJSONPBEncoder()
 	response := &archiver.GetHistoryResponse{}
 	numOfEvents := 0
@@ -354,6 +520,88 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+// readHistoryArchive reads a page of history batches from the history archive object, starting at the batch of token.
+// It returns the token of the next page, or nil if the page is the last one. Pages after the first one only
+// download the header of the archive and the frames from the position of the token onwards.
+func (h *historyArchiver) readHistoryArchive(
+	ctx context.Context,
+	URI archiver.URI,
+	key string,
+	token *getHistoryToken,
+	pageSize int,
+) ([]*historypb.History, *getHistoryToken, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	byteRange := ""
+	if token.NextBatchPosition != nil {
+		byteRange = fmt.Sprintf("bytes=0-%d", archiver.MaxHistoryArchiveHeaderSize-1)
+	}
+	body, err := h.getHistoryArchiveObject(ctx, URI, key, byteRange)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		_ = body.Close()
+	}()
+
+	historyReader, err := archiver.NewHistoryArchiveReader(bufio.NewReader(body), h.container.HistoryArchiveCipher)
+	if err != nil {
+		return nil, nil, serviceerror.NewInternal(err.Error())
+	}
+	defer historyReader.Close()
+	if token.NextBatchPosition != nil {
+		frames, err := h.getHistoryArchiveObject(ctx, URI, key, fmt.Sprintf("bytes=%d-", token.NextBatchPosition.FrameOffset))
+		if err != nil {
+			return nil, nil, err
+		}
+		defer func() {
+			_ = frames.Close()
+		}()
+		historyReader.ResumeAt(frames, *token.NextBatchPosition)
+	}
+
+	var historyBatches []*historypb.History
+	numOfEvents := 0
+	for {
+		position := historyReader.Position()
+		batch, err := historyReader.Next()
+		if err == io.EOF {
+			return historyBatches, nil, nil
+		}
+		if err != nil {
+			if isRetryableError(err) {
+				return nil, nil, serviceerror.NewUnavailable(err.Error())
+			}
+			return nil, nil, serviceerror.NewInternal(err.Error())
+		}
+		if numOfEvents >= pageSize {
+			return historyBatches, &getHistoryToken{
+				CloseFailoverVersion: token.CloseFailoverVersion,
+				NextBatchPosition:    &position,
+			}, nil
+		}
+		historyBatches = append(historyBatches, batch)
+		numOfEvents += len(batch.Events)
+	}
+}
+
+func (h *historyArchiver) getHistoryArchiveObject(ctx context.Context, URI archiver.URI, key string, byteRange string) (io.ReadCloser, error) {
+	body, err := getObject(ctx, h.s3cli, URI, key, byteRange)
+	if err != nil {
+		if isRetryableError(err) {
+			return nil, serviceerror.NewUnavailable(err.Error())
+		}
+		switch err.(type) {
+		case *serviceerror.InvalidArgument, *serviceerror.Unavailable, *serviceerror.NotFound:
+			return nil, err
+		default:
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+	}
+	return body, nil
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	err := SoftValidateURI(URI)
 	if err != nil {
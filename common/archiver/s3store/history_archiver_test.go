@@ -27,6 +27,8 @@ package s3store
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -43,9 +45,11 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
@@ -55,6 +59,7 @@ import (
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/util"
 )
 
@@ -201,6 +206,49 @@ func setupFsEmulation(s3cli *mocks.MockS3API) {
 		}).AnyTimes()
 	s3cli.EXPECT().PutObjectWithContext(gomock.Any(), gomock.Any()).DoAndReturn(putObjectFn).AnyTimes()
 
+	uploads := make(map[string]map[int64][]byte)
+	s3cli.EXPECT().CreateMultipartUploadWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ aws.Context, input *s3.CreateMultipartUploadInput, _ ...request.Option) (*s3.CreateMultipartUploadOutput, error) {
+			uploadID := fmt.Sprintf("upload-%d", len(uploads)+1)
+			uploads[uploadID] = make(map[int64][]byte)
+			return &s3.CreateMultipartUploadOutput{UploadId: aws.String(uploadID)}, nil
+		}).AnyTimes()
+	s3cli.EXPECT().UploadPartWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ aws.Context, input *s3.UploadPartInput, _ ...request.Option) (*s3.UploadPartOutput, error) {
+			parts, ok := uploads[*input.UploadId]
+			if !ok {
+				return nil, awserr.New(s3.ErrCodeNoSuchUpload, "", nil)
+			}
+			buf := new(bytes.Buffer)
+			if _, err := buf.ReadFrom(input.Body); err != nil {
+				return nil, err
+			}
+			parts[*input.PartNumber] = buf.Bytes()
+			return &s3.UploadPartOutput{ETag: aws.String(fmt.Sprintf("etag-%d", *input.PartNumber))}, nil
+		}).AnyTimes()
+	s3cli.EXPECT().CompleteMultipartUploadWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ aws.Context, input *s3.CompleteMultipartUploadInput, _ ...request.Option) (*s3.CompleteMultipartUploadOutput, error) {
+			parts, ok := uploads[*input.UploadId]
+			if !ok {
+				return nil, awserr.New(s3.ErrCodeNoSuchUpload, "", nil)
+			}
+			buf := new(bytes.Buffer)
+			for _, part := range input.MultipartUpload.Parts {
+				if *part.ETag != fmt.Sprintf("etag-%d", *part.PartNumber) {
+					return nil, awserr.New("InvalidPart", "", nil)
+				}
+				buf.Write(parts[*part.PartNumber])
+			}
+			delete(uploads, *input.UploadId)
+			fs[*input.Bucket+*input.Key] = buf.Bytes()
+			return &s3.CompleteMultipartUploadOutput{}, nil
+		}).AnyTimes()
+	s3cli.EXPECT().AbortMultipartUploadWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ aws.Context, input *s3.AbortMultipartUploadInput, _ ...request.Option) (*s3.AbortMultipartUploadOutput, error) {
+			delete(uploads, *input.UploadId)
+			return &s3.AbortMultipartUploadOutput{}, nil
+		}).AnyTimes()
+
 	s3cli.EXPECT().HeadObjectWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(ctx aws.Context, input *s3.HeadObjectInput, options ...request.Option) (*s3.HeadObjectOutput, error) {
 			_, ok := fs[*input.Bucket+*input.Key]
@@ -213,13 +261,21 @@ func setupFsEmulation(s3cli *mocks.MockS3API) {
 
 	s3cli.EXPECT().GetObjectWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(ctx aws.Context, input *s3.GetObjectInput, options ...request.Option) (*s3.GetObjectOutput, error) {
-			_, ok := fs[*input.Bucket+*input.Key]
+			data, ok := fs[*input.Bucket+*input.Key]
 			if !ok {
 				return nil, awserr.New(s3.ErrCodeNoSuchKey, "", nil)
 			}
 
+			if input.Range != nil {
+				var start, end int
+				if n, _ := fmt.Sscanf(*input.Range, "bytes=%d-%d", &start, &end); n == 2 {
+					data = data[start:min(end+1, len(data))]
+				} else {
+					data = data[start:]
+				}
+			}
 			return &s3.GetObjectOutput{
-				Body: io.NopCloser(bytes.NewReader(fs[*input.Bucket+*input.Key])),
+				Body: io.NopCloser(bytes.NewReader(data)),
 			}, nil
 		}).AnyTimes()
 }
@@ -438,8 +494,8 @@ func (s *historyArchiverSuite) TestArchive_Skip() {
 	err = historyArchiver.Archive(context.Background(), URI, request)
 	s.NoError(err)
 
-	expectedkey := constructHistoryKey("", testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion, 0)
-	s.assertKeyExists(expectedkey)
+	expectedkey := constructHistoryArchiveKey(URI.Path(), testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)
+	s.assertKeyNotExists(expectedkey)
 }
 
 func (s *historyArchiverSuite) TestArchive_Success() {
@@ -496,10 +552,118 @@ func (s *historyArchiverSuite) TestArchive_Success() {
 	err = historyArchiver.Archive(context.Background(), URI, request)
 	s.NoError(err)
 
-	expectedkey := constructHistoryKey("", testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion, 0)
+	expectedkey := constructHistoryArchiveKey(URI.Path(), testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)
 	s.assertKeyExists(expectedkey)
 }
 
+func (s *historyArchiverSuite) TestArchive_Skip_AlreadyArchived() {
+	URI, err := archiver.NewURI(testBucketURI + "/TestArchive_Skip_AlreadyArchived")
+	s.NoError(err)
+	key := constructHistoryArchiveKey(URI.Path(), testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)
+	s.NoError(Upload(context.Background(), s.s3cli, URI, key, []byte("archived")))
+
+	// the history iterator must not be used
+	historyIterator := archiver.NewMockHistoryIterator(s.controller)
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err = historyArchiver.Archive(context.Background(), URI, s.newArchiveRequest())
+	s.NoError(err)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet_MultipartUpload() {
+	historyBlobs := s.newLargeHistoryBlobs(3)
+	historyIterator := archiver.NewMockHistoryIterator(s.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(historyBlobs[0], nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(historyBlobs[1], nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(historyBlobs[2], nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	URI, err := archiver.NewURI(testBucketURI + "/TestArchiveAndGet_MultipartUpload")
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, s.newArchiveRequest())
+	s.NoError(err)
+
+	s.assertGetHistoryBlobs(historyArchiver, URI, historyBlobs)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet_ResumeMultipartUpload() {
+	historyBlobs := s.newLargeHistoryBlobs(3)
+	progressManager := &testProgressManager{}
+	progressOption := func(catalog *archiver.ArchiveFeatureCatalog) {
+		catalog.ProgressManager = progressManager
+	}
+	URI, err := archiver.NewURI(testBucketURI + "/TestArchiveAndGet_ResumeMultipartUpload")
+	s.NoError(err)
+	// the next attempt must encrypt its parts with the data key of the header uploaded by the first attempt
+	s.container.HistoryArchiveCipher = &testHistoryArchiveCipher{}
+
+	historyIterator := archiver.NewMockHistoryIterator(s.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(historyBlobs[0], nil),
+		historyIterator.EXPECT().GetState().Return([]byte(`{"NextEventID":2}`), nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(nil, serviceerror.NewUnavailable("some transient error")),
+	)
+	err = s.newTestHistoryArchiver(historyIterator).Archive(context.Background(), URI, s.newArchiveRequest(), progressOption)
+	s.Error(err)
+
+	var progress uploadProgress
+	s.NoError(progressManager.LoadProgress(context.Background(), &progress))
+	s.NotEmpty(progress.UploadID)
+	s.Len(progress.Parts, 1)
+	s.NotEmpty(progress.KeyMetadata)
+	s.Positive(progress.NextFrameIdx)
+
+	historyIterator = archiver.NewMockHistoryIterator(s.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(historyBlobs[1], nil),
+		historyIterator.EXPECT().GetState().Return([]byte(`{"NextEventID":3}`), nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(historyBlobs[2], nil),
+		historyIterator.EXPECT().GetState().Return([]byte(`{"NextEventID":4}`), nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+	err = s.newTestHistoryArchiver(historyIterator).Archive(context.Background(), URI, s.newArchiveRequest(), progressOption)
+	s.NoError(err)
+
+	s.assertGetHistoryBlobs(s.newTestHistoryArchiver(nil), URI, historyBlobs)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_UploadAborted() {
+	historyBlobs := s.newLargeHistoryBlobs(2)
+	URI, err := archiver.NewURI(testBucketURI + "/TestArchive_Fail_UploadAborted")
+	s.NoError(err)
+	progressManager := &testProgressManager{}
+	s.NoError(progressManager.RecordProgress(context.Background(), &uploadProgress{
+		UploadID:      "aborted-upload",
+		Parts:         []uploadedPart{{PartNumber: 1, ETag: "etag-1"}},
+		IteratorState: []byte(`{"NextEventID":2}`),
+	}))
+
+	historyIterator := archiver.NewMockHistoryIterator(s.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(historyBlobs[1], nil),
+	)
+	err = s.newTestHistoryArchiver(historyIterator).Archive(context.Background(), URI, s.newArchiveRequest(), func(catalog *archiver.ArchiveFeatureCatalog) {
+		catalog.ProgressManager = progressManager
+	})
+	s.Error(err)
+
+	// the next attempt restarts from scratch
+	var progress uploadProgress
+	s.NoError(progressManager.LoadProgress(context.Background(), &progress))
+	s.Empty(progress.UploadID)
+	s.Empty(progress.Parts)
+}
+
 func (s *historyArchiverSuite) TestGet_Fail_InvalidURI() {
 	historyArchiver := s.newTestHistoryArchiver(nil)
 	request := &archiver.GetHistoryRequest{
@@ -684,7 +848,51 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.NoError(err)
 	s.NotNil(response)
 	s.Nil(response.NextPageToken)
-	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
+	protorequire.ProtoSliceEqual(s.T(), append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
+}
+
+// testHistoryArchiveCipher xors the frames of each history archive with a new key byte,
+// and appends a digest of the additional data
+type (
+	testHistoryArchiveCipher struct {
+		nextKey byte
+	}
+	testHistoryArchiveFrameCipher struct {
+		key byte
+	}
+)
+
+func (c *testHistoryArchiveCipher) NewKey() (archiver.HistoryArchiveFrameCipher, []byte, error) {
+	c.nextKey++
+	return testHistoryArchiveFrameCipher{key: c.nextKey}, []byte{c.nextKey}, nil
+}
+
+func (c *testHistoryArchiveCipher) OpenKey(keyMetadata []byte) (archiver.HistoryArchiveFrameCipher, error) {
+	return testHistoryArchiveFrameCipher{key: keyMetadata[0]}, nil
+}
+
+func (c testHistoryArchiveFrameCipher) Seal(frame []byte, additionalData []byte) ([]byte, error) {
+	sealed := make([]byte, len(frame), len(frame)+sha256.Size)
+	for i, b := range frame {
+		sealed[i] = b ^ c.key
+	}
+	digest := sha256.Sum256(additionalData)
+	return append(sealed, digest[:]...), nil
+}
+
+func (c testHistoryArchiveFrameCipher) Open(sealed []byte, additionalData []byte) ([]byte, error) {
+	if len(sealed) < sha256.Size {
+		return nil, errors.New("sealed frame is too short")
+	}
+	frame, digest := sealed[:len(sealed)-sha256.Size], sealed[len(sealed)-sha256.Size:]
+	if expected := sha256.Sum256(additionalData); !bytes.Equal(expected[:], digest) {
+		return nil, errors.New("additional data does not match")
+	}
+	opened := make([]byte, len(frame))
+	for i, b := range frame {
+		opened[i] = b ^ c.key
+	}
+	return opened, nil
 }
 
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
@@ -693,6 +901,7 @@ func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.H
 	archiver := &historyArchiver{
 		container:       s.container,
 		s3cli:           s.s3cli,
+		compression:     archiver.HistoryArchiveCompressionNone,
 		historyIterator: historyIterator,
 	}
 	return archiver
@@ -787,6 +996,111 @@ func (s *historyArchiverSuite) assertKeyExists(key string) {
 	s.NoError(err)
 }
 
+func (s *historyArchiverSuite) assertKeyNotExists(key string) {
+	_, err := s.s3cli.GetObjectWithContext(context.Background(), &s3.GetObjectInput{
+		Bucket: aws.String(testBucket),
+		Key:    aws.String(key),
+	})
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) assertGetHistoryBlobs(historyArchiver *historyArchiver, URI archiver.URI, historyBlobs []*archiverspb.HistoryBlob) {
+	var expectedHistory []*historypb.History
+	for _, historyBlob := range historyBlobs {
+		expectedHistory = append(expectedHistory, historyBlob.Body...)
+	}
+	request := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    1,
+	}
+	var combinedHistory []*historypb.History
+	for {
+		response, err := historyArchiver.Get(context.Background(), URI, request)
+		s.NoError(err)
+		s.Len(response.HistoryBatches, 1)
+		combinedHistory = append(combinedHistory, response.HistoryBatches...)
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	// histories are compared with proto.Equal, diffing megabytes of payloads is too slow
+	s.Len(combinedHistory, len(expectedHistory))
+	for i := range expectedHistory {
+		s.True(proto.Equal(expectedHistory[i], combinedHistory[i]))
+	}
+}
+
+func (s *historyArchiverSuite) newArchiveRequest() *archiver.ArchiveHistoryRequest {
+	return &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+}
+
+// newLargeHistoryBlobs returns history blobs which are each larger than a multipart upload part
+func (s *historyArchiverSuite) newLargeHistoryBlobs(count int) []*archiverspb.HistoryBlob {
+	historyBlobs := make([]*archiverspb.HistoryBlob, count)
+	for i := range historyBlobs {
+		eventID := int64(i + 1)
+		if i == count-1 {
+			eventID = testNextEventID - 1
+		}
+		historyBlobs[i] = &archiverspb.HistoryBlob{
+			Header: &archiverspb.HistoryBlobHeader{
+				IsLast: i == count-1,
+			},
+			Body: []*historypb.History{
+				{
+					Events: []*historypb.HistoryEvent{
+						{
+							EventId:   eventID,
+							EventTime: timestamppb.New(time.Date(2020, 8, 22, 1, 2, 3, 4, time.UTC)),
+							Version:   testCloseFailoverVersion,
+							Attributes: &historypb.HistoryEvent_MarkerRecordedEventAttributes{
+								MarkerRecordedEventAttributes: &historypb.MarkerRecordedEventAttributes{
+									Details: map[string]*commonpb.Payloads{
+										"data": {Payloads: []*commonpb.Payload{{Data: bytes.Repeat([]byte{byte(i)}, multipartUploadPartSize)}}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	return historyBlobs
+}
+
+type testProgressManager struct {
+	progress []byte
+}
+
+func (m *testProgressManager) RecordProgress(_ context.Context, progress interface{}) error {
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	m.progress = data
+	return nil
+}
+
+func (m *testProgressManager) LoadProgress(_ context.Context, valuePtr interface{}) error {
+	return json.Unmarshal(m.progress, valuePtr)
+}
+
+func (m *testProgressManager) HasProgress(_ context.Context) bool {
+	return len(m.progress) > 0
+}
+
 func getCanceledContext() context.Context {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
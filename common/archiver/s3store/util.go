@@ -46,6 +46,7 @@ import (
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/visibilityquery"
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/searchattribute"
 )
@@ -141,6 +142,13 @@ func constructHistoryKey(path, namespaceID, workflowID, runID string, version in
 	return fmt.Sprintf("%s%d", prefix, batchIdx)
 }
 
+// constructHistoryArchiveKey returns the key of the single object a history is archived to in the
+// history archive format, history archived by older versions is split into one object per batch.
+func constructHistoryArchiveKey(path, namespaceID, workflowID, runID string, version int64) string {
+	prefix := constructHistoryKeyPrefixWithVersion(path, namespaceID, workflowID, runID, version)
+	return prefix + historyArchiveKeySuffix
+}
+
 func constructHistoryKeyPrefixWithVersion(path, namespaceID, workflowID, runID string, version int64) string {
 	prefix := constructHistoryKeyPrefix(path, namespaceID, workflowID, runID)
 	return fmt.Sprintf("%s/%v/", prefix, version)
@@ -150,26 +158,13 @@ func constructHistoryKeyPrefix(path, namespaceID, workflowID, runID string) stri
 	return strings.TrimLeft(strings.Join([]string{path, namespaceID, "history", workflowID, runID}, "/"), "/")
 }
 
-func constructTimeBasedSearchKey(path, namespaceID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, t time.Time, precision string) string {
-	var timeFormat = ""
-	switch precision {
-	case PrecisionSecond:
-		timeFormat = ":05"
-		fallthrough
-	case PrecisionMinute:
-		timeFormat = ":04" + timeFormat
-		fallthrough
-	case PrecisionHour:
-		timeFormat = "15" + timeFormat
-		fallthrough
-	case PrecisionDay:
-		timeFormat = "2006-01-02T" + timeFormat
-	}
-
+// constructTimeRangeSearchPrefix returns the key prefix shared by all keys of the given index with a secondary
+// index value within [earliest, latest].
+func constructTimeRangeSearchPrefix(path, namespaceID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, earliest, latest time.Time) string {
 	return fmt.Sprintf(
 		"%s/%s",
 		constructIndexedVisibilitySearchPrefix(path, namespaceID, primaryIndexKey, primaryIndexValue, secondaryIndexKey),
-		t.Format(timeFormat),
+		visibilityquery.TimeRangePrefix(earliest, latest, time.RFC3339),
 	)
 }
 
@@ -228,13 +223,33 @@ func Upload(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key stri
 	return nil
 }
 
-func Download(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string) ([]byte, error) {
+func Download(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string) (_ []byte, retErr error) {
 	ctx, cancel := ensureContextTimeout(ctx)
 	defer cancel()
-	result, err := s3cli.GetObjectWithContext(ctx, &s3.GetObjectInput{
+	body, err := getObject(ctx, s3cli, URI, key, "")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if ierr := body.Close(); ierr != nil {
+			retErr = multierr.Append(retErr, ierr)
+		}
+	}()
+
+	return io.ReadAll(body)
+}
+
+// getObject returns the body of the object, it must be read before ctx is canceled.
+// If byteRange is set, only the given HTTP range of the object is returned, e.g. "bytes=1024-".
+func getObject(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string, byteRange string) (io.ReadCloser, error) {
+	input := &s3.GetObjectInput{
 		Bucket: aws.String(URI.Hostname()),
 		Key:    aws.String(key),
-	})
+	}
+	if byteRange != "" {
+		input.Range = aws.String(byteRange)
+	}
+	result, err := s3cli.GetObjectWithContext(ctx, input)
 
 	if err != nil {
 		if aerr, ok := err.(awserr.Error); ok {
@@ -248,18 +263,80 @@ func Download(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key st
 		}
 		return nil, err
 	}
+	return result.Body, nil
+}
 
-	defer func() {
-		if ierr := result.Body.Close(); ierr != nil {
-			err = multierr.Append(err, ierr)
+func createMultipartUpload(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string) (string, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	result, err := s3cli.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(URI.Hostname()),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			if aerr.Code() == s3.ErrCodeNoSuchBucket {
+				return "", serviceerror.NewInvalidArgument(errBucketNotExists.Error())
+			}
 		}
-	}()
+		return "", err
+	}
+	return aws.StringValue(result.UploadId), nil
+}
+
+func uploadPart(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string, uploadID string, partNumber int64, data []byte) (string, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
 
-	body, err := io.ReadAll(result.Body)
+	result, err := s3cli.UploadPartWithContext(ctx, &s3.UploadPartInput{
+		Bucket:     aws.String(URI.Hostname()),
+		Key:        aws.String(key),
+		UploadId:   aws.String(uploadID),
+		PartNumber: aws.Int64(partNumber),
+		Body:       bytes.NewReader(data),
+	})
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	return body, nil
+	return aws.StringValue(result.ETag), nil
+}
+
+func completeMultipartUpload(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string, uploadID string, parts []uploadedPart) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	completedParts := make([]*s3.CompletedPart, 0, len(parts))
+	for _, part := range parts {
+		completedParts = append(completedParts, &s3.CompletedPart{
+			PartNumber: aws.Int64(part.PartNumber),
+			ETag:       aws.String(part.ETag),
+		})
+	}
+	_, err := s3cli.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(URI.Hostname()),
+		Key:             aws.String(key),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completedParts},
+	})
+	return err
+}
+
+func abortMultipartUpload(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string, uploadID string) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	_, err := s3cli.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(URI.Hostname()),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	})
+	return err
+}
+
+func isNoSuchUploadError(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == s3.ErrCodeNoSuchUpload
 }
 
 func historyMutated(request *archiver.ArchiveHistoryRequest, historyBatches []*historypb.History, isLast bool) bool {
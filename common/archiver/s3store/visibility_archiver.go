@@ -40,6 +40,7 @@ import (
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/visibilityquery"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -48,16 +49,15 @@ import (
 
 type (
 	visibilityArchiver struct {
-		container   *archiver.VisibilityBootstrapContainer
-		s3cli       s3iface.S3API
-		queryParser QueryParser
+		container *archiver.VisibilityBootstrapContainer
+		s3cli     s3iface.S3API
 	}
 
 	queryVisibilityRequest struct {
 		namespaceID   string
 		pageSize      int
 		nextPageToken []byte
-		parsedQuery   *parsedQuery
+		query         *visibilityquery.Query
 	}
 
 	indexToArchive struct {
@@ -74,6 +74,8 @@ const (
 	secondaryIndexKeyCloseTimeout   = "closeTimeout"
 	primaryIndexKeyWorkflowTypeName = "workflowTypeName"
 	primaryIndexKeyWorkflowID       = "workflowID"
+	// defaultListPageSize is the number of keys listed at once when all records matching a query are read.
+	defaultListPageSize = 1000
 )
 
 // NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on s3
@@ -98,9 +100,8 @@ func newVisibilityArchiver(
 		return nil, err
 	}
 	return &visibilityArchiver{
-		container:   container,
-		s3cli:       s3.New(sess),
-		queryParser: NewQueryParser(),
+		container: container,
+		s3cli:     s3.New(sess),
 	}, nil
 }
 
@@ -183,73 +184,56 @@ func (v *visibilityArchiver) Query(
 		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
 	}
 
-	if strings.TrimSpace(request.Query) == "" {
-		return v.queryAll(ctx, URI, request, saTypeMap)
-	}
-
-	parsedQuery, err := v.queryParser.Parse(request.Query)
+	query, err := visibilityquery.Parse(request.Query, saTypeMap)
 	if err != nil {
 		return nil, serviceerror.NewInvalidArgument(err.Error())
 	}
 
-	return v.query(
-		ctx,
-		URI,
-		&queryVisibilityRequest{
-			namespaceID:   request.NamespaceID,
-			pageSize:      request.PageSize,
-			nextPageToken: request.NextPageToken,
-			parsedQuery:   parsedQuery,
-		},
-		saTypeMap,
-	)
+	if query.EmptyResult() {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	queryRequest := &queryVisibilityRequest{
+		namespaceID:   request.NamespaceID,
+		pageSize:      request.PageSize,
+		nextPageToken: request.NextPageToken,
+		query:         query,
+	}
+	if query.HasOrderBy() {
+		return v.queryOrdered(ctx, URI, queryRequest, saTypeMap)
+	}
+	return v.query(ctx, URI, queryRequest, saTypeMap)
 }
 
-// queryAll returns all workflow executions in the archive.
-func (v *visibilityArchiver) queryAll(
+// query returns the workflow executions matching the query, in the order of their S3 keys.
+func (v *visibilityArchiver) query(
 	ctx context.Context,
 	uri archiver.URI,
-	request *archiver.QueryVisibilityRequest,
+	request *queryVisibilityRequest,
 	saTypeMap searchattribute.NameTypeMap,
 ) (*archiver.QueryVisibilityResponse, error) {
+	prefix, keyFilter := searchPrefix(uri, request.namespaceID, request.query)
 	// remaining is the number of workflow executions left to return before we reach pageSize.
-	remaining := request.PageSize
-	nextPageToken := request.NextPageToken
+	remaining := request.pageSize
+	nextPageToken := request.nextPageToken
 	var executions []*workflowpb.WorkflowExecutionInfo
-	// We need to loop because the number of workflow executions returned by each call to query may be fewer than
-	// pageSize. This is because we may have to skip some workflow executions after querying S3 (client-side filtering)
-	// because there are 2 entries in S3 for each workflow execution indexed by workflowTypeName (one for closeTimeout
-	// and one for startTimeout), and we only want to return one entry per workflow execution. See
-	// createIndexesToArchive for a list of all indexes.
+	// We need to loop because the number of workflow executions returned by each call to queryPrefix may be fewer
+	// than pageSize. This is because we may have to skip some workflow executions after querying S3 (client-side
+	// filtering), either because they don't match the query, or because there are 2 entries in S3 for each workflow
+	// execution indexed by workflowTypeName (one for closeTimeout and one for startTimeout), and we only want to
+	// return one entry per workflow execution. See createIndexesToArchive for a list of all indexes.
 	for {
-		searchPrefix := constructVisibilitySearchPrefix(uri.Path(), request.NamespaceID)
-		// We suffix searchPrefix with workflowTypeName because the data in S3 is duplicated across combinations of 2
-		// different primary indices (workflowID and workflowTypeName) and 2 different secondary indices (closeTimeout
-		// and startTimeout). We only want to return one entry per workflow execution, but the full path to the S3 key
-		// is <primaryIndexKey>/<primaryIndexValue>/<secondaryIndexKey>/<secondaryIndexValue>/<runID>, and we don't have
-		// the primaryIndexValue when we make the call to query, so we can only specify the primaryIndexKey.
-		searchPrefix += "/" + primaryIndexKeyWorkflowTypeName
-		// The pageSize we supply here is actually the maximum number of keys to fetch from S3. For each execution,
-		// there should be 2 keys in S3 for this prefix, so you might think that we should multiply the pageSize by 2.
-		// However, if we do that, we may end up returning more than pageSize workflow executions to the end user of
-		// this API. This is because we aren't guaranteed that both keys for a given workflow execution will be returned
-		// in the same call. For example, if the user supplies a pageSize of 1, and we specify a maximum number of keys
-		// of 2 to S3, we may get back entries from S3 for 2 different workflow executions. You might think that we can
-		// just truncate this result to 1 workflow execution, but then the nextPageToken would be incorrect. So, we may
-		// need to make multiple calls to S3 to get the correct number of workflow executions, which will probably make
-		// this API call slower.
+		// The pageSize we supply here is actually the maximum number of keys to fetch from S3. We can't fetch more
+		// keys than the number of workflow executions left to return, because we may then end up returning more
+		// than pageSize workflow executions to the end user of this API, and we can't truncate the result without
+		// making the nextPageToken incorrect. So, we may need to make multiple calls to S3 to get the correct number
+		// of workflow executions, which will probably make this API call slower.
 		res, err := v.queryPrefix(ctx, uri, &queryVisibilityRequest{
-			namespaceID:   request.NamespaceID,
+			namespaceID:   request.namespaceID,
 			pageSize:      remaining,
 			nextPageToken: nextPageToken,
-			parsedQuery:   &parsedQuery{},
-		}, saTypeMap, searchPrefix, func(key string) bool {
-			// We only want to return entries for the closeTimeout secondary index, which will always be of the form:
-			// .../closeTimeout/<closeTimeout>/<runID>, so we split the key on "/" and check that the third-to-last
-			// element is "closeTimeout".
-			elements := strings.Split(key, "/")
-			return len(elements) >= 3 && elements[len(elements)-3] == secondaryIndexKeyCloseTimeout
-		})
+			query:         request.query,
+		}, saTypeMap, prefix, keyFilter)
 		if err != nil {
 			return nil, err
 		}
@@ -266,55 +250,103 @@ func (v *visibilityArchiver) queryAll(
 	}, nil
 }
 
-func (v *visibilityArchiver) query(
+// queryOrdered returns the workflow executions matching a query with an ORDER BY clause. S3 keys are not
+// sorted by close time, so all records matching the query are read before returning a page of them.
+func (v *visibilityArchiver) queryOrdered(
 	ctx context.Context,
-	URI archiver.URI,
+	uri archiver.URI,
 	request *queryVisibilityRequest,
 	saTypeMap searchattribute.NameTypeMap,
 ) (*archiver.QueryVisibilityResponse, error) {
-	primaryIndex := primaryIndexKeyWorkflowTypeName
-	primaryIndexValue := request.parsedQuery.workflowTypeName
-	if request.parsedQuery.workflowID != nil {
-		primaryIndex = primaryIndexKeyWorkflowID
-		primaryIndexValue = request.parsedQuery.workflowID
+	var token *visibilityquery.PageToken
+	if request.nextPageToken != nil {
+		var err error
+		token, err = visibilityquery.DeserializePageToken(request.nextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	}
+
+	prefix, keyFilter := searchPrefix(uri, request.namespaceID, request.query)
+	var records []*archiverspb.VisibilityRecord
+	var continuationToken []byte
+	for {
+		res, nextContinuationToken, err := v.listRecords(ctx, uri, prefix, keyFilter, request.query, defaultListPageSize, continuationToken)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, res...)
+		if len(nextContinuationToken) == 0 {
+			break
+		}
+		continuationToken = nextContinuationToken
+	}
+
+	records, nextToken := request.query.Page(records, token, request.pageSize)
+	response := &archiver.QueryVisibilityResponse{}
+	for _, record := range records {
+		executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.Executions = append(response.Executions, executionInfo)
+	}
+	if nextToken != nil {
+		encodedToken, err := visibilityquery.SerializePageToken(nextToken)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.NextPageToken = encodedToken
+	}
+	return response, nil
+}
+
+// searchPrefix returns the S3 key prefix under which all records matching the query are indexed. The keyFilter
+// function is an optional filter that must be applied to keys under that prefix.
+func searchPrefix(uri archiver.URI, namespaceID string, query *visibilityquery.Query) (string, func(key string) bool) {
+	primaryIndex := primaryIndexKeyWorkflowID
+	primaryIndexValues, ok := query.Values(searchattribute.WorkflowID)
+	if !ok || len(primaryIndexValues) != 1 {
+		primaryIndex = primaryIndexKeyWorkflowTypeName
+		primaryIndexValues, ok = query.Values(searchattribute.WorkflowType)
+	}
+	if !ok || len(primaryIndexValues) != 1 {
+		// We suffix the prefix with workflowTypeName because the data in S3 is duplicated across combinations of 2
+		// different primary indices (workflowID and workflowTypeName) and 2 different secondary indices (closeTimeout
+		// and startTimeout). We only want to return one entry per workflow execution, but the full path to the S3 key
+		// is <primaryIndexKey>/<primaryIndexValue>/<secondaryIndexKey>/<secondaryIndexValue>/<runID>, and we don't
+		// have the primaryIndexValue, so we can only specify the primaryIndexKey.
+		prefix := constructVisibilitySearchPrefix(uri.Path(), namespaceID) + "/" + primaryIndexKeyWorkflowTypeName
+		return prefix, func(key string) bool {
+			// We only want to return entries for the closeTimeout secondary index, which will always be of the form:
+			// .../closeTimeout/<closeTimeout>/<runID>, so we split the key on "/" and check that the third-to-last
+			// element is "closeTimeout".
+			elements := strings.Split(key, "/")
+			return len(elements) >= 3 && elements[len(elements)-3] == secondaryIndexKeyCloseTimeout
+		}
 	}
 
-	prefix := constructIndexedVisibilitySearchPrefix(
-		URI.Path(),
-		request.namespaceID,
+	secondaryIndex := secondaryIndexKeyCloseTimeout
+	earliest, latest := query.CloseTimeRange()
+	if earliest.IsZero() && latest.Equal(visibilityquery.MaxTime) {
+		if startEarliest, startLatest := query.StartTimeRange(); !startEarliest.IsZero() || !startLatest.Equal(visibilityquery.MaxTime) {
+			secondaryIndex = secondaryIndexKeyStartTimeout
+			earliest, latest = startEarliest, startLatest
+		}
+	}
+	prefix := constructTimeRangeSearchPrefix(
+		uri.Path(),
+		namespaceID,
 		primaryIndex,
-		*primaryIndexValue,
-		secondaryIndexKeyCloseTimeout,
-	) + "/"
-	if request.parsedQuery.closeTime != nil {
-		prefix = constructTimeBasedSearchKey(
-			URI.Path(),
-			request.namespaceID,
-			primaryIndex,
-			*primaryIndexValue,
-			secondaryIndexKeyCloseTimeout,
-			*request.parsedQuery.closeTime,
-			*request.parsedQuery.searchPrecision,
-		)
-	}
-	if request.parsedQuery.startTime != nil {
-		prefix = constructTimeBasedSearchKey(
-			URI.Path(),
-			request.namespaceID,
-			primaryIndex,
-			*primaryIndexValue,
-			secondaryIndexKeyStartTimeout,
-			*request.parsedQuery.startTime,
-			*request.parsedQuery.searchPrecision,
-		)
-	}
-
-	return v.queryPrefix(ctx, URI, request, saTypeMap, prefix, nil)
+		primaryIndexValues[0],
+		secondaryIndex,
+		earliest,
+		latest,
+	)
+	return prefix, nil
 }
 
-// queryPrefix returns all workflow executions in the archive that match the given prefix. The keyFilter function is an
-// optional filter that can be used to further filter the results. If keyFilter returns false for a given key, that key
-// will be skipped, and the object will not be downloaded from S3 or included in the results.
+// queryPrefix returns the workflow executions matching the query among the records under the given prefix.
 func (v *visibilityArchiver) queryPrefix(
 	ctx context.Context,
 	uri archiver.URI,
@@ -323,34 +355,65 @@ func (v *visibilityArchiver) queryPrefix(
 	prefix string,
 	keyFilter func(key string) bool,
 ) (*archiver.QueryVisibilityResponse, error) {
+	records, nextPageToken, err := v.listRecords(ctx, uri, prefix, keyFilter, request.query, request.pageSize, request.nextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	response := &archiver.QueryVisibilityResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, record := range records {
+		executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.Executions = append(response.Executions, executionInfo)
+	}
+	return response, nil
+}
+
+// listRecords lists at most maxKeys keys under the given prefix, and returns the records matching the query
+// along with the token to list the next keys. The keyFilter function is an optional filter that can be used to
+// further filter the keys. If keyFilter returns false for a given key, that key will be skipped, and the object
+// will not be downloaded from S3.
+func (v *visibilityArchiver) listRecords(
+	ctx context.Context,
+	uri archiver.URI,
+	prefix string,
+	keyFilter func(key string) bool,
+	query *visibilityquery.Query,
+	maxKeys int,
+	nextPageToken []byte,
+) ([]*archiverspb.VisibilityRecord, []byte, error) {
 	ctx, cancel := ensureContextTimeout(ctx)
 	defer cancel()
 
 	var token *string
 
-	if request.nextPageToken != nil {
-		token = deserializeQueryVisibilityToken(request.nextPageToken)
+	if nextPageToken != nil {
+		token = deserializeQueryVisibilityToken(nextPageToken)
 	}
 	results, err := v.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
 		Bucket:            aws.String(uri.Hostname()),
 		Prefix:            aws.String(prefix),
-		MaxKeys:           aws.Int64(int64(request.pageSize)),
+		MaxKeys:           aws.Int64(int64(maxKeys)),
 		ContinuationToken: token,
 	})
 	if err != nil {
 		if isRetryableError(err) {
-			return nil, serviceerror.NewUnavailable(err.Error())
+			return nil, nil, serviceerror.NewUnavailable(err.Error())
 		}
-		return nil, serviceerror.NewInvalidArgument(err.Error())
+		return nil, nil, serviceerror.NewInvalidArgument(err.Error())
 	}
 	if len(results.Contents) == 0 {
-		return &archiver.QueryVisibilityResponse{}, nil
+		return nil, nil, nil
 	}
 
-	response := &archiver.QueryVisibilityResponse{}
+	var newNextPageToken []byte
 	if *results.IsTruncated {
-		response.NextPageToken = serializeQueryVisibilityToken(*results.NextContinuationToken)
+		newNextPageToken = serializeQueryVisibilityToken(*results.NextContinuationToken)
 	}
+	var records []*archiverspb.VisibilityRecord
 	for _, item := range results.Contents {
 		if keyFilter != nil && !keyFilter(*item.Key) {
 			continue
@@ -358,20 +421,22 @@ func (v *visibilityArchiver) queryPrefix(
 
 		encodedRecord, err := Download(ctx, v.s3cli, uri, *item.Key)
 		if err != nil {
-			return nil, serviceerror.NewUnavailable(err.Error())
+			return nil, nil, serviceerror.NewUnavailable(err.Error())
 		}
 
 		record, err := decodeVisibilityRecord(encodedRecord)
 		if err != nil {
-			return nil, serviceerror.NewInternal(err.Error())
+			return nil, nil, serviceerror.NewInternal(err.Error())
 		}
-		executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+		match, err := query.Match(record)
 		if err != nil {
-			return nil, serviceerror.NewInternal(err.Error())
+			return nil, nil, serviceerror.NewInternal(err.Error())
+		}
+		if match {
+			records = append(records, record)
 		}
-		response.Executions = append(response.Executions, executionInfo)
 	}
-	return response, nil
+	return records, newNextPageToken, nil
 }
 
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
@@ -47,13 +47,13 @@ import (
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/s3store/mocks"
+	"go.temporal.io/server/common/archiver/visibilityquery"
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
-	"go.temporal.io/server/common/util"
 )
 
 type visibilityArchiverSuite struct {
@@ -118,9 +118,8 @@ func (s *visibilityArchiverSuite) TestValidateURI() {
 
 func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
 	return &visibilityArchiver{
-		container:   s.container,
-		s3cli:       s.s3cli,
-		queryParser: NewQueryParser(),
+		container: s.container,
+		s3cli:     s.s3cli,
 	}
 }
 
@@ -256,9 +255,6 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
 
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
-	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
 		NamespaceID: "some random namespaceID",
 		PageSize:    10,
@@ -270,16 +266,9 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		workflowID:      util.Ptr(testWorkflowID),
-		closeTime:       &time.Time{},
-		searchPrecision: util.Ptr(PrecisionSecond),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
-		Query:       "parsed by mockParser",
+		Query:       "WorkflowId = '" + testWorkflowID + "' and CloseTime = '0001-01-01T00:00:00Z' and SearchPrecision = 'Second'",
 		PageSize:    1,
 	}
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request, searchattribute.TestNameTypeMap)
@@ -291,17 +280,10 @@ func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       util.Ptr(time.Unix(0, int64(1*time.Hour)).UTC()),
-		searchPrecision: util.Ptr(PrecisionHour),
-		workflowID:      util.Ptr(testWorkflowID),
-	}, nil)
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
 		PageSize:    10,
-		Query:       "parsed by mockParser",
+		Query:       "WorkflowId = '" + testWorkflowID + "' and CloseTime = '1970-01-01T01:00:00Z' and SearchPrecision = 'Hour'",
 	}
 	URI, err := archiver.NewURI(testBucketURI)
 	s.NoError(err)
@@ -317,17 +299,10 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		closeTime:       util.Ptr(time.Unix(0, 0).UTC()),
-		searchPrecision: util.Ptr(PrecisionDay),
-		workflowID:      util.Ptr(testWorkflowID),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
 		PageSize:    2,
-		Query:       "parsed by mockParser",
+		Query:       "WorkflowId = '" + testWorkflowID + "' and CloseTime = '1970-01-01T00:00:00Z' and SearchPrecision = 'Day'",
 	}
 	URI, err := archiver.NewURI(testBucketURI)
 	s.NoError(err)
@@ -354,6 +329,50 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.Equal(ei, response.Executions[0])
 }
 
+func (s *visibilityArchiverSuite) TestQuery_Success_OrderByCloseTime() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI(testBucketURI)
+	s.NoError(err)
+
+	testCases := []struct {
+		query    string
+		expected []*archiverspb.VisibilityRecord
+	}{
+		{
+			query:    "WorkflowId = '" + testWorkflowID + "' and (CloseTime < '1970-01-01T01:10:00Z' or CloseTime > '1970-01-01T02:00:00Z') order by CloseTime asc",
+			expected: []*archiverspb.VisibilityRecord{s.visibilityRecords[0], s.visibilityRecords[2]},
+		},
+		{
+			query:    "ExecutionStatus = 'Failed' order by CloseTime desc",
+			expected: []*archiverspb.VisibilityRecord{s.visibilityRecords[2], s.visibilityRecords[1], s.visibilityRecords[0]},
+		},
+	}
+	for _, tc := range testCases {
+		request := &archiver.QueryVisibilityRequest{
+			NamespaceID: testNamespaceID,
+			PageSize:    1,
+			Query:       tc.query,
+		}
+		var executions []*workflowpb.WorkflowExecutionInfo
+		for {
+			response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+			s.NoError(err)
+			s.LessOrEqual(len(response.Executions), request.PageSize)
+			executions = append(executions, response.Executions...)
+			if len(response.NextPageToken) == 0 {
+				break
+			}
+			request.NextPageToken = response.NextPageToken
+		}
+		s.Len(executions, len(tc.expected), tc.query)
+		for i, record := range tc.expected {
+			ei, err := convertToExecutionInfo(record, searchattribute.TestNameTypeMap)
+			s.NoError(err)
+			s.Equal(ei, executions[i], tc.query)
+		}
+	}
+}
+
 func (s *visibilityArchiverSuite) TestQuery_EmptyQuery_InvalidNamespace() {
 	arc := archiver.VisibilityArchiver(s.newTestVisibilityArchiver())
 	uri, err := archiver.NewURI(testBucketURI)
@@ -440,70 +459,70 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 			hour:      0,
 			minute:    0,
 			second:    0,
-			precision: PrecisionDay,
+			precision: visibilityquery.PrecisionDay,
 		},
 		{
 			day:       1,
 			hour:      1,
 			minute:    0,
 			second:    0,
-			precision: PrecisionDay,
+			precision: visibilityquery.PrecisionDay,
 		},
 		{
 			day:       2,
 			hour:      1,
 			minute:    0,
 			second:    0,
-			precision: PrecisionHour,
+			precision: visibilityquery.PrecisionHour,
 		},
 		{
 			day:       2,
 			hour:      1,
 			minute:    30,
 			second:    0,
-			precision: PrecisionHour,
+			precision: visibilityquery.PrecisionHour,
 		},
 		{
 			day:       3,
 			hour:      2,
 			minute:    1,
 			second:    0,
-			precision: PrecisionMinute,
+			precision: visibilityquery.PrecisionMinute,
 		},
 		{
 			day:       3,
 			hour:      2,
 			minute:    1,
 			second:    30,
-			precision: PrecisionMinute,
+			precision: visibilityquery.PrecisionMinute,
 		},
 		{
 			day:       4,
 			hour:      3,
 			minute:    2,
 			second:    1,
-			precision: PrecisionSecond,
+			precision: visibilityquery.PrecisionSecond,
 		},
 		{
 			day:       4,
 			hour:      3,
 			minute:    2,
 			second:    1,
-			precision: PrecisionSecond,
+			precision: visibilityquery.PrecisionSecond,
 		},
 		{
 			day:       4,
 			hour:      3,
 			minute:    2,
 			second:    2,
-			precision: PrecisionSecond,
+			precision: visibilityquery.PrecisionSecond,
 		},
 		{
 			day:       4,
 			hour:      3,
 			minute:    2,
 			second:    2,
-			precision: PrecisionSecond,
+			precision: visibilityquery.PrecisionSecond,
 		},
 	}
 	visibilityArchiver := s.newTestVisibilityArchiver()
@@ -526,64 +545,24 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 		s.NoError(err, "case %d", i)
 	}
 
-	request := &archiver.QueryVisibilityRequest{
-		NamespaceID: testNamespaceID,
-		PageSize:    100,
-		Query:       "parsed by mockParser",
-	}
-
 	for i, testData := range precisionTests {
-		mockParser := NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			closeTime:       util.Ptr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
-			searchPrecision: util.Ptr(testData.precision),
-			workflowID:      util.Ptr(testWorkflowID),
-		}, nil).AnyTimes()
-		visibilityArchiver.queryParser = mockParser
-
-		response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
-		s.NoError(err)
-		s.NotNil(response)
-		s.Len(response.Executions, 2, "Iteration ", i)
-
-		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			startTime:       util.Ptr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
-			searchPrecision: util.Ptr(testData.precision),
-			workflowID:      util.Ptr(testWorkflowID),
-		}, nil).AnyTimes()
-		visibilityArchiver.queryParser = mockParser
-
-		response, err = visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
-		s.NoError(err)
-		s.NotNil(response)
-		s.Len(response.Executions, 2, "Iteration ", i)
-
-		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			closeTime:        util.Ptr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
-			searchPrecision:  util.Ptr(testData.precision),
-			workflowTypeName: util.Ptr(testWorkflowTypeName),
-		}, nil).AnyTimes()
-		visibilityArchiver.queryParser = mockParser
-
-		response, err = visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
-		s.NoError(err)
-		s.NotNil(response)
-		s.Len(response.Executions, 2, "Iteration ", i)
-
-		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-			startTime:        util.Ptr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
-			searchPrecision:  util.Ptr(testData.precision),
-			workflowTypeName: util.Ptr(testWorkflowTypeName),
-		}, nil).AnyTimes()
-		visibilityArchiver.queryParser = mockParser
-
-		response, err = visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
-		s.NoError(err)
-		s.NotNil(response)
-		s.Len(response.Executions, 2, "Iteration ", i)
+		queryTime := time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC).Format(time.RFC3339)
+		for _, query := range []string{
+			fmt.Sprintf("CloseTime = '%s' and SearchPrecision = '%s' and WorkflowId = '%s'", queryTime, testData.precision, testWorkflowID),
+			fmt.Sprintf("StartTime = '%s' and SearchPrecision = '%s' and WorkflowId = '%s'", queryTime, testData.precision, testWorkflowID),
+			fmt.Sprintf("CloseTime = '%s' and SearchPrecision = '%s' and WorkflowTypeName = '%s'", queryTime, testData.precision, testWorkflowTypeName),
+			fmt.Sprintf("StartTime = '%s' and SearchPrecision = '%s' and WorkflowTypeName = '%s'", queryTime, testData.precision, testWorkflowTypeName),
+		} {
+			request := &archiver.QueryVisibilityRequest{
+				NamespaceID: testNamespaceID,
+				PageSize:    100,
+				Query:       query,
+			}
+			response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+			s.NoError(err)
+			s.NotNil(response)
+			s.Len(response.Executions, 2, "Iteration ", i)
+		}
 	}
 }
 
@@ -596,15 +575,10 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 		s.NoError(err)
 	}
 
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		workflowID: util.Ptr(testWorkflowID),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
 		PageSize:    1,
-		Query:       "parsed by mockParser",
+		Query:       "WorkflowId = '" + testWorkflowID + "'",
 	}
 	executions := []*workflowpb.WorkflowExecutionInfo{}
 	first := true
@@ -627,15 +601,10 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.NoError(err)
 	s.Equal(ei, executions[2])
 
-	mockParser = NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		workflowTypeName: util.Ptr(testWorkflowTypeName),
-	}, nil).AnyTimes()
-	visibilityArchiver.queryParser = mockParser
 	request = &archiver.QueryVisibilityRequest{
 		NamespaceID: testNamespaceID,
 		PageSize:    1,
-		Query:       "parsed by mockParser",
+		Query:       "WorkflowTypeName = '" + testWorkflowTypeName + "'",
 	}
 	executions = []*workflowpb.WorkflowExecutionInfo{}
 	first = true
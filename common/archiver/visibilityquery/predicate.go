@@ -0,0 +1,689 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityquery
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/temporalio/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// predicate is a condition of the where clause evaluated against the values of a record.
+	// Conditions on a search attribute which isn't set on the record are false, including negated ones.
+	predicate interface {
+		eval(values *recordValues) bool
+	}
+
+	andPredicate struct {
+		left  predicate
+		right predicate
+	}
+
+	orPredicate struct {
+		left  predicate
+		right predicate
+	}
+
+	notPredicate struct {
+		predicate predicate
+	}
+
+	comparisonPredicate struct {
+		saName   string
+		saType   enumspb.IndexedValueType
+		operator string
+		values   []any
+	}
+
+	rangePredicate struct {
+		saName string
+		not    bool
+		from   any
+		to     any
+	}
+
+	isNullPredicate struct {
+		saName string
+		not    bool
+	}
+
+	converter struct {
+		saTypeMap searchattribute.NameTypeMap
+		precision string
+	}
+)
+
+var (
+	supportedComparisonOperators = []string{
+		sqlparser.EqualStr,
+		sqlparser.NotEqualStr,
+		sqlparser.LessThanStr,
+		sqlparser.GreaterThanStr,
+		sqlparser.LessEqualStr,
+		sqlparser.GreaterEqualStr,
+		sqlparser.InStr,
+		sqlparser.NotInStr,
+		sqlparser.StartsWithStr,
+		sqlparser.NotStartsWithStr,
+	}
+
+	supportedKeywordListOperators = []string{
+		sqlparser.EqualStr,
+		sqlparser.NotEqualStr,
+		sqlparser.InStr,
+		sqlparser.NotInStr,
+	}
+
+	supportedTextOperators = []string{
+		sqlparser.EqualStr,
+		sqlparser.NotEqualStr,
+	}
+
+	supportedTypesRangeCond = []enumspb.IndexedValueType{
+		enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+		enumspb.INDEXED_VALUE_TYPE_INT,
+		enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	}
+)
+
+func andAll(predicates []predicate) predicate {
+	result := predicates[0]
+	for _, p := range predicates[1:] {
+		result = &andPredicate{left: result, right: p}
+	}
+	return result
+}
+
+func (p *andPredicate) eval(values *recordValues) bool {
+	return p.left.eval(values) && p.right.eval(values)
+}
+
+func (p *orPredicate) eval(values *recordValues) bool {
+	return p.left.eval(values) || p.right.eval(values)
+}
+
+func (p *notPredicate) eval(values *recordValues) bool {
+	return !p.predicate.eval(values)
+}
+
+func (p *comparisonPredicate) eval(values *recordValues) bool {
+	recordValues := values.get(p.saName)
+	if len(recordValues) == 0 {
+		return false
+	}
+	switch p.operator {
+	case sqlparser.NotEqualStr, sqlparser.NotInStr, sqlparser.NotStartsWithStr:
+		// negative operators match if no value of the record matches
+		for _, recordValue := range recordValues {
+			if p.matchValue(recordValue) {
+				return false
+			}
+		}
+		return true
+	default:
+		for _, recordValue := range recordValues {
+			if p.matchValue(recordValue) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// matchValue returns whether a value of the record matches the comparison, ignoring negation.
+func (p *comparisonPredicate) matchValue(recordValue any) bool {
+	switch p.operator {
+	case sqlparser.StartsWithStr, sqlparser.NotStartsWithStr:
+		s, ok := recordValue.(string)
+		return ok && strings.HasPrefix(s, p.values[0].(string))
+	case sqlparser.EqualStr, sqlparser.NotEqualStr, sqlparser.InStr, sqlparser.NotInStr:
+		for _, value := range p.values {
+			if p.saType == enumspb.INDEXED_VALUE_TYPE_TEXT {
+				if matchText(recordValue, value) {
+					return true
+				}
+				continue
+			}
+			if cmp, ok := compareValues(recordValue, value); ok && cmp == 0 {
+				return true
+			}
+		}
+		return false
+	default:
+		cmp, ok := compareValues(recordValue, p.values[0])
+		if !ok {
+			return false
+		}
+		switch p.operator {
+		case sqlparser.LessThanStr:
+			return cmp < 0
+		case sqlparser.GreaterThanStr:
+			return cmp > 0
+		case sqlparser.LessEqualStr:
+			return cmp <= 0
+		case sqlparser.GreaterEqualStr:
+			return cmp >= 0
+		}
+		return false
+	}
+}
+
+func (p *rangePredicate) eval(values *recordValues) bool {
+	recordValues := values.get(p.saName)
+	if len(recordValues) == 0 {
+		return false
+	}
+	inRange := false
+	for _, recordValue := range recordValues {
+		fromCmp, fromOK := compareValues(recordValue, p.from)
+		toCmp, toOK := compareValues(recordValue, p.to)
+		if fromOK && toOK && fromCmp >= 0 && toCmp <= 0 {
+			inRange = true
+			break
+		}
+	}
+	return inRange != p.not
+}
+
+func (p *isNullPredicate) eval(values *recordValues) bool {
+	isNull := len(values.get(p.saName)) == 0
+	return isNull != p.not
+}
+
+func (c *converter) convertExpr(expr sqlparser.Expr) (predicate, error) {
+	switch e := expr.(type) {
+	case *sqlparser.ParenExpr:
+		return c.convertExpr(e.Expr)
+	case *sqlparser.NotExpr:
+		p, err := c.convertExpr(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &notPredicate{predicate: p}, nil
+	case *sqlparser.AndExpr:
+		left, err := c.convertExpr(e.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := c.convertExpr(e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &andPredicate{left: left, right: right}, nil
+	case *sqlparser.OrExpr:
+		left, err := c.convertExpr(e.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := c.convertExpr(e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &orPredicate{left: left, right: right}, nil
+	case *sqlparser.ComparisonExpr:
+		return c.convertComparisonExpr(e)
+	case *sqlparser.RangeCond:
+		return c.convertRangeCond(e)
+	case *sqlparser.IsExpr:
+		return c.convertIsExpr(e)
+	case *sqlparser.FuncExpr:
+		return nil, query.NewConverterError("%s: function expression", query.NotSupportedErrMessage)
+	case *sqlparser.ColName:
+		return nil, query.NewConverterError("%s: incomplete expression", query.InvalidExpressionErrMessage)
+	default:
+		return nil, query.NewConverterError("%s: expression of type %T", query.NotSupportedErrMessage, expr)
+	}
+}
+
+func (c *converter) convertComparisonExpr(expr *sqlparser.ComparisonExpr) (predicate, error) {
+	if !isSupportedOperator(supportedComparisonOperators, expr.Operator) {
+		return nil, query.NewConverterError(
+			"%s: invalid operator '%s' in `%s`",
+			query.InvalidExpressionErrMessage,
+			expr.Operator,
+			sqlparser.String(expr),
+		)
+	}
+	saName, saType, err := c.convertColName(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+	switch saType {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST:
+		if !isSupportedOperator(supportedKeywordListOperators, expr.Operator) {
+			return nil, query.NewConverterError(
+				"%s: operator '%s' not supported for KeywordList type search attribute in `%s`",
+				query.InvalidExpressionErrMessage,
+				expr.Operator,
+				sqlparser.String(expr),
+			)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_TEXT:
+		if !isSupportedOperator(supportedTextOperators, expr.Operator) {
+			return nil, query.NewConverterError(
+				"%s: operator '%s' not supported for Text type search attribute in `%s`",
+				query.InvalidExpressionErrMessage,
+				expr.Operator,
+				sqlparser.String(expr),
+			)
+		}
+	}
+
+	var values []any
+	switch expr.Operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, query.NewConverterError(
+				"%s: right-hand side of '%s' must be a list of values",
+				query.InvalidExpressionErrMessage,
+				expr.Operator,
+			)
+		}
+		for _, valueExpr := range tuple {
+			value, err := c.convertValueExpr(valueExpr, saName, saType)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+	default:
+		value, err := c.convertValueExpr(expr.Right, saName, saType)
+		if err != nil {
+			return nil, err
+		}
+		values = []any{value}
+	}
+
+	switch expr.Operator {
+	case sqlparser.StartsWithStr, sqlparser.NotStartsWithStr:
+		if _, ok := values[0].(string); !ok {
+			return nil, query.NewConverterError(
+				"%s: right-hand side of '%s' must be a literal string (got: %v)",
+				query.InvalidExpressionErrMessage,
+				expr.Operator,
+				sqlparser.String(expr.Right),
+			)
+		}
+	case sqlparser.EqualStr:
+		if from, to, ok := c.precisionRange(saName, values[0]); ok {
+			return &rangePredicate{saName: saName, from: from, to: to}, nil
+		}
+	}
+
+	return &comparisonPredicate{
+		saName:   saName,
+		saType:   saType,
+		operator: expr.Operator,
+		values:   values,
+	}, nil
+}
+
+func (c *converter) convertRangeCond(expr *sqlparser.RangeCond) (predicate, error) {
+	saName, saType, err := c.convertColName(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+	if !isSupportedTypeRangeCond(saType) {
+		return nil, query.NewConverterError(
+			"%s: cannot do range condition on search attribute '%s' of type %s",
+			query.InvalidExpressionErrMessage,
+			saName,
+			saType.String(),
+		)
+	}
+	from, err := c.convertValueExpr(expr.From, saName, saType)
+	if err != nil {
+		return nil, err
+	}
+	to, err := c.convertValueExpr(expr.To, saName, saType)
+	if err != nil {
+		return nil, err
+	}
+	return &rangePredicate{
+		saName: saName,
+		not:    expr.Operator == sqlparser.NotBetweenStr,
+		from:   from,
+		to:     to,
+	}, nil
+}
+
+func (c *converter) convertIsExpr(expr *sqlparser.IsExpr) (predicate, error) {
+	saName, _, err := c.convertColName(expr.Expr)
+	if err != nil {
+		return nil, err
+	}
+	switch expr.Operator {
+	case sqlparser.IsNullStr, sqlparser.IsNotNullStr:
+		return &isNullPredicate{
+			saName: saName,
+			not:    expr.Operator == sqlparser.IsNotNullStr,
+		}, nil
+	default:
+		return nil, query.NewConverterError(
+			"%s: 'IS' operator can only be used with 'NULL' or 'NOT NULL'",
+			query.InvalidExpressionErrMessage,
+		)
+	}
+}
+
+func (c *converter) convertColName(expr sqlparser.Expr) (string, enumspb.IndexedValueType, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return "", enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, query.NewConverterError(
+			"%s: must be a column name but was %T",
+			query.InvalidExpressionErrMessage,
+			expr,
+		)
+	}
+	saName := colNameString(colName)
+	switch saName {
+	case workflowTypeName:
+		saName = searchattribute.WorkflowType
+	case SearchPrecision:
+		return "", enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, query.NewConverterError(
+			"%s: %s is only supported as a top level condition",
+			query.InvalidExpressionErrMessage,
+			SearchPrecision,
+		)
+	}
+	saType, err := c.saTypeMap.GetType(saName)
+	if err != nil {
+		return "", enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, query.NewConverterError(
+			"%s: column name '%s' is not a valid search attribute",
+			query.InvalidExpressionErrMessage,
+			saName,
+		)
+	}
+	return saName, saType, nil
+}
+
+func (c *converter) convertValueExpr(expr sqlparser.Expr, saName string, saType enumspb.IndexedValueType) (any, error) {
+	switch e := expr.(type) {
+	case *sqlparser.SQLVal:
+		var sqlValue string
+		switch e.Type {
+		case sqlparser.StrVal:
+			sqlValue = fmt.Sprintf(`'%s'`, e.Val)
+		default:
+			sqlValue = string(e.Val)
+		}
+		value, err := query.ParseSqlValue(sqlValue)
+		if err != nil {
+			return nil, err
+		}
+		return normalizeQueryValue(value, saName, saType)
+	case sqlparser.BoolVal:
+		return normalizeQueryValue(bool(e), saName, saType)
+	case sqlparser.ValTuple:
+		return nil, query.NewConverterError(
+			"%s: list of values is only supported with 'IN' and 'NOT IN' operators",
+			query.InvalidExpressionErrMessage,
+		)
+	case *sqlparser.FuncExpr:
+		return nil, query.NewConverterError("%s: nested func", query.NotSupportedErrMessage)
+	case *sqlparser.ColName:
+		return nil, query.NewConverterError(
+			"%s: column name on the right side of comparison expression (did you forget to quote '%s'?)",
+			query.NotSupportedErrMessage,
+			sqlparser.String(expr),
+		)
+	default:
+		return nil, query.NewConverterError(
+			"%s: unexpected value type %T",
+			query.InvalidExpressionErrMessage,
+			expr,
+		)
+	}
+}
+
+// precisionRange returns the time range of a StartTime or CloseTime equality condition when the query
+// has a SearchPrecision condition.
+func (c *converter) precisionRange(saName string, value any) (time.Time, time.Time, bool) {
+	if c.precision == "" || saName != searchattribute.StartTime && saName != searchattribute.CloseTime {
+		return time.Time{}, time.Time{}, false
+	}
+	t := value.(time.Time)
+	var from time.Time
+	var unit time.Duration
+	switch c.precision {
+	case PrecisionDay:
+		from = truncateToDate(t)
+		unit = 24 * time.Hour
+	case PrecisionHour:
+		from = t.Truncate(time.Hour)
+		unit = time.Hour
+	case PrecisionMinute:
+		from = t.Truncate(time.Minute)
+		unit = time.Minute
+	case PrecisionSecond:
+		from = t.Truncate(time.Second)
+		unit = time.Second
+	}
+	return from, from.Add(unit - time.Nanosecond), true
+}
+
+// normalizeQueryValue converts a value of the query to the type of values of the search attribute.
+func normalizeQueryValue(value any, saName string, saType enumspb.IndexedValueType) (any, error) {
+	switch saName {
+	case searchattribute.ExecutionStatus:
+		return statusName(value)
+	case searchattribute.ExecutionDuration:
+		if durationStr, ok := value.(string); ok {
+			return parseDuration(durationStr)
+		}
+	}
+
+	switch saType {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		enumspb.INDEXED_VALUE_TYPE_TEXT:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		if v, ok := value.(int64); ok {
+			return v, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case int64:
+			return float64(v), nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			if b, err := strconv.ParseBool(v); err == nil {
+				return b, nil
+			}
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		switch v := value.(type) {
+		case int64:
+			return time.Unix(0, v).UTC(), nil
+		case string:
+			t, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				return nil, query.NewConverterError(
+					"%s: unable to parse datetime '%s'",
+					query.InvalidExpressionErrMessage,
+					v,
+				)
+			}
+			return t.UTC(), nil
+		}
+	}
+	return nil, invalidValueError(saName, value)
+}
+
+// parseDuration parses golang durations such as "300ms", "-1.5h" or "2h45m" and "hh:mm:ss" durations.
+func parseDuration(durationStr string) (int64, error) {
+	if duration, err := timestamp.ParseDuration(durationStr); err == nil {
+		return duration.Nanoseconds(), nil
+	}
+	durationNanos, err := timestamp.ParseHHMMSSDuration(durationStr)
+	if err != nil {
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return 0, converterErr
+		}
+		return 0, invalidValueError(searchattribute.ExecutionDuration, durationStr)
+	}
+	return durationNanos.Nanoseconds(), nil
+}
+
+// compareValues compares two values of the same search attribute type, and returns false if they
+// can't be compared.
+func compareValues(a any, b any) (int, bool) {
+	switch a := a.(type) {
+	case string:
+		if b, ok := b.(string); ok {
+			return strings.Compare(a, b), true
+		}
+	case int64:
+		switch b := b.(type) {
+		case int64:
+			return compareOrdered(a, b), true
+		case float64:
+			return compareOrdered(float64(a), b), true
+		}
+	case float64:
+		switch b := b.(type) {
+		case float64:
+			return compareOrdered(a, b), true
+		case int64:
+			return compareOrdered(a, float64(b)), true
+		}
+	case bool:
+		if b, ok := b.(bool); ok {
+			switch {
+			case a == b:
+				return 0, true
+			case b:
+				return -1, true
+			default:
+				return 1, true
+			}
+		}
+	case time.Time:
+		if b, ok := b.(time.Time); ok {
+			return a.Compare(b), true
+		}
+	}
+	return 0, false
+}
+
+func compareOrdered[T int64 | float64](a T, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// matchText returns whether the text of the record contains all words of the query value, ignoring case.
+func matchText(recordValue any, value any) bool {
+	text, ok := recordValue.(string)
+	if !ok {
+		return false
+	}
+	words := make(map[string]struct{})
+	for _, word := range splitWords(text) {
+		words[word] = struct{}{}
+	}
+	queryWords := splitWords(value.(string))
+	if len(queryWords) == 0 {
+		return false
+	}
+	for _, word := range queryWords {
+		if _, ok := words[word]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func splitWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// toValueList converts a decoded search attribute value, which may be a list, to a list of values.
+func toValueList(value any) []any {
+	if value == nil {
+		return nil
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice {
+		return []any{value}
+	}
+	values := make([]any, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		values = append(values, v.Index(i).Interface())
+	}
+	return values
+}
+
+func truncateToDate(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func isSupportedOperator(supportedOperators []string, operator string) bool {
+	for _, op := range supportedOperators {
+		if operator == op {
+			return true
+		}
+	}
+	return false
+}
+
+func isSupportedTypeRangeCond(saType enumspb.IndexedValueType) bool {
+	for _, tp := range supportedTypesRangeCond {
+		if saType == tp {
+			return true
+		}
+	}
+	return false
+}
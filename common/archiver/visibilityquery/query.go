@@ -0,0 +1,507 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package visibilityquery evaluates visibility queries against archived visibility records. It accepts the
+// same List Filter grammar as the live SQL visibility store, so all visibility archivers share one query
+// language: comparisons, IN, BETWEEN, STARTS_WITH, IS NULL, AND/OR/NOT on system and custom search
+// attributes, and ORDER BY CloseTime.
+package visibilityquery
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/temporalio/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// Query is a parsed visibility query which can be evaluated against archived visibility records.
+	Query struct {
+		saTypeMap searchattribute.NameTypeMap
+		// filter is nil if the query has no where clause
+		filter    predicate
+		orderBy   bool
+		ascending bool
+
+		// Hints derived from the top level AND conditions of the where clause, which archivers use to
+		// narrow down the records they read. Records must still be checked with Match.
+		closeTime   timeRange
+		startTime   timeRange
+		values      map[string][]string
+		emptyResult bool
+	}
+
+	// PageToken is the position of the last record of a page, in the order of a query.
+	PageToken struct {
+		LastCloseTime time.Time
+		LastRunID     string
+	}
+
+	timeRange struct {
+		earliest time.Time
+		latest   time.Time
+	}
+)
+
+// SearchPrecision is a pseudo search attribute supported for compatibility with the former s3store and
+// gcloud query syntax. It widens StartTime and CloseTime equality conditions to the given precision.
+const SearchPrecision = "SearchPrecision"
+
+// Precision specific values
+const (
+	PrecisionDay    = "Day"
+	PrecisionHour   = "Hour"
+	PrecisionMinute = "Minute"
+	PrecisionSecond = "Second"
+)
+
+// workflowTypeName is the former s3store name of the WorkflowType search attribute.
+const workflowTypeName = "WorkflowTypeName"
+
+var (
+	// MaxTime is the latest close time of an unbounded time range.
+	MaxTime = time.Unix(0, math.MaxInt64).UTC()
+
+	// hintFields are the search attributes for which Values returns the values allowed by a query.
+	hintFields = []string{
+		searchattribute.WorkflowID,
+		searchattribute.RunID,
+		searchattribute.WorkflowType,
+	}
+)
+
+// Parse parses a visibility query. Custom search attributes are resolved with saTypeMap.
+func Parse(queryString string, saTypeMap searchattribute.NameTypeMap) (*Query, error) {
+	q := &Query{
+		saTypeMap: saTypeMap,
+		closeTime: timeRange{latest: MaxTime},
+		startTime: timeRange{latest: MaxTime},
+		values:    make(map[string][]string),
+	}
+
+	where := strings.TrimSpace(queryString)
+	if where != "" && !strings.HasPrefix(strings.ToLower(where), "order by") {
+		where = "where " + where
+	}
+	// sqlparser can't parse just WHERE clause but instead accepts only valid SQL statement.
+	stmt, err := sqlparser.Parse("select * from archived_visibility " + where)
+	if err != nil {
+		return nil, query.NewConverterError("%s: %v", query.MalformedSqlQueryErrMessage, err)
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, query.NewConverterError("%s: statement must be 'select' not %T", query.NotSupportedErrMessage, stmt)
+	}
+	if len(sel.GroupBy) != 0 {
+		return nil, query.NewConverterError("%s: 'group by' clause", query.NotSupportedErrMessage)
+	}
+	if sel.Having != nil {
+		return nil, query.NewConverterError("%s: 'having' clause", query.NotSupportedErrMessage)
+	}
+	if sel.Limit != nil {
+		return nil, query.NewConverterError("%s: 'limit' clause", query.NotSupportedErrMessage)
+	}
+	if err := q.convertOrderBy(sel.OrderBy); err != nil {
+		return nil, err
+	}
+	if sel.Where == nil {
+		return q, nil
+	}
+
+	conditions, precision, err := splitConditions(sel.Where.Expr)
+	if err != nil {
+		return nil, err
+	}
+	c := &converter{
+		saTypeMap: saTypeMap,
+		precision: precision,
+	}
+	var predicates []predicate
+	for _, condition := range conditions {
+		p, err := c.convertExpr(condition)
+		if err != nil {
+			return nil, err
+		}
+		q.addHint(p)
+		predicates = append(predicates, p)
+	}
+	if len(predicates) != 0 {
+		q.filter = andAll(predicates)
+	}
+	return q, nil
+}
+
+// Match returns whether the record matches the where clause of the query.
+func (q *Query) Match(record *archiverspb.VisibilityRecord) (bool, error) {
+	if q.emptyResult {
+		return false, nil
+	}
+	if q.filter == nil {
+		return true, nil
+	}
+	values := newRecordValues(record, q.saTypeMap)
+	match := q.filter.eval(values)
+	if values.err != nil {
+		return false, values.err
+	}
+	return match, nil
+}
+
+// EmptyResult returns whether the query can't match any record.
+func (q *Query) EmptyResult() bool {
+	return q.emptyResult
+}
+
+// CloseTimeRange returns the range of close times of the records which may match the query.
+func (q *Query) CloseTimeRange() (time.Time, time.Time) {
+	return q.closeTime.earliest, q.closeTime.latest
+}
+
+// StartTimeRange returns the range of start times of the records which may match the query.
+func (q *Query) StartTimeRange() (time.Time, time.Time) {
+	return q.startTime.earliest, q.startTime.latest
+}
+
+// Values returns the values of WorkflowId, RunId or WorkflowType allowed by the query, and false if
+// the query doesn't restrict them.
+func (q *Query) Values(saName string) ([]string, bool) {
+	values, ok := q.values[saName]
+	return values, ok
+}
+
+// HasOrderBy returns whether the query has an ORDER BY clause.
+func (q *Query) HasOrderBy() bool {
+	return q.orderBy
+}
+
+// Ascending returns whether records are returned by ascending close time. Queries without an ORDER BY
+// clause return records by descending close time, while ORDER BY defaults to ascending as in SQL.
+func (q *Query) Ascending() bool {
+	return q.ascending
+}
+
+// Less returns whether record a comes before record b in the order of the query. Records with the
+// same close time are ordered by run ID.
+func (q *Query) Less(a *archiverspb.VisibilityRecord, b *archiverspb.VisibilityRecord) bool {
+	return q.before(compareRecordKeys(a.CloseTime.AsTime(), a.GetRunId(), b.CloseTime.AsTime(), b.GetRunId()))
+}
+
+// IsAfter returns whether the record comes after the position of the page token in the order of the query.
+func (q *Query) IsAfter(record *archiverspb.VisibilityRecord, token *PageToken) bool {
+	return q.before(compareRecordKeys(token.LastCloseTime, token.LastRunID, record.CloseTime.AsTime(), record.GetRunId()))
+}
+
+// NewPageToken returns the page token of a page ending with the given record.
+func (q *Query) NewPageToken(record *archiverspb.VisibilityRecord) *PageToken {
+	return &PageToken{
+		LastCloseTime: record.CloseTime.AsTime(),
+		LastRunID:     record.GetRunId(),
+	}
+}
+
+// Page sorts records in the order of the query, and returns the page of at most pageSize records coming
+// after the page token, along with the token of the next page which is nil if there are no more records.
+// Records are expected to match the query.
+func (q *Query) Page(
+	records []*archiverspb.VisibilityRecord,
+	token *PageToken,
+	pageSize int,
+) ([]*archiverspb.VisibilityRecord, *PageToken) {
+	var page []*archiverspb.VisibilityRecord
+	for _, record := range records {
+		if token == nil || q.IsAfter(record, token) {
+			page = append(page, record)
+		}
+	}
+	sort.Slice(page, func(i, j int) bool {
+		return q.Less(page[i], page[j])
+	})
+	if len(page) <= pageSize {
+		return page, nil
+	}
+	page = page[:pageSize]
+	return page, q.NewPageToken(page[pageSize-1])
+}
+
+func (q *Query) before(cmp int) bool {
+	if q.ascending {
+		return cmp < 0
+	}
+	return cmp > 0
+}
+
+func (q *Query) convertOrderBy(orderBy sqlparser.OrderBy) error {
+	if len(orderBy) == 0 {
+		return nil
+	}
+	if len(orderBy) > 1 {
+		return query.NewConverterError("%s: 'order by' clause supports only a single field", query.NotSupportedErrMessage)
+	}
+	colName, ok := orderBy[0].Expr.(*sqlparser.ColName)
+	if !ok || colNameString(colName) != searchattribute.CloseTime {
+		return query.NewConverterError(
+			"%s: 'order by' clause is only supported for %s search attribute",
+			query.NotSupportedErrMessage,
+			searchattribute.CloseTime,
+		)
+	}
+	q.orderBy = true
+	q.ascending = orderBy[0].Direction == sqlparser.AscScr
+	return nil
+}
+
+// addHint narrows down the hints of the query with a top level AND condition.
+func (q *Query) addHint(p predicate) {
+	switch p := p.(type) {
+	case *comparisonPredicate:
+		switch p.saName {
+		case searchattribute.CloseTime:
+			q.closeTime.narrow(p)
+		case searchattribute.StartTime:
+			q.startTime.narrow(p)
+		default:
+			if p.operator != sqlparser.EqualStr && p.operator != sqlparser.InStr || !isHintField(p.saName) {
+				return
+			}
+			values := make([]string, 0, len(p.values))
+			for _, value := range p.values {
+				values = append(values, value.(string))
+			}
+			if current, ok := q.values[p.saName]; ok {
+				values = intersect(current, values)
+			}
+			q.values[p.saName] = values
+			if len(values) == 0 {
+				q.emptyResult = true
+			}
+		}
+	case *rangePredicate:
+		if p.not {
+			return
+		}
+		switch p.saName {
+		case searchattribute.CloseTime:
+			q.closeTime.narrowBetween(p.from, p.to)
+		case searchattribute.StartTime:
+			q.startTime.narrowBetween(p.from, p.to)
+		}
+	}
+	if q.closeTime.earliest.After(q.closeTime.latest) || q.startTime.earliest.After(q.startTime.latest) {
+		q.emptyResult = true
+	}
+}
+
+func (r *timeRange) narrow(p *comparisonPredicate) {
+	if len(p.values) != 1 {
+		return
+	}
+	t, ok := p.values[0].(time.Time)
+	if !ok {
+		return
+	}
+	switch p.operator {
+	case sqlparser.EqualStr:
+		r.narrowBetween(t, t)
+	case sqlparser.LessThanStr:
+		r.narrowBetween(time.Time{}, t.Add(-time.Nanosecond))
+	case sqlparser.LessEqualStr:
+		r.narrowBetween(time.Time{}, t)
+	case sqlparser.GreaterThanStr:
+		r.narrowBetween(t.Add(time.Nanosecond), MaxTime)
+	case sqlparser.GreaterEqualStr:
+		r.narrowBetween(t, MaxTime)
+	}
+}
+
+func (r *timeRange) narrowBetween(from any, to any) {
+	fromTime, fromOK := from.(time.Time)
+	toTime, toOK := to.(time.Time)
+	if !fromOK || !toOK {
+		return
+	}
+	if fromTime.After(r.earliest) {
+		r.earliest = fromTime
+	}
+	if toTime.Before(r.latest) {
+		r.latest = toTime
+	}
+}
+
+// SerializePageToken serializes a page token into a next page token.
+func SerializePageToken(token *PageToken) ([]byte, error) {
+	return json.Marshal(token)
+}
+
+// DeserializePageToken deserializes a next page token.
+func DeserializePageToken(data []byte) (*PageToken, error) {
+	token := &PageToken{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// splitConditions splits the top level AND conditions of a where clause, and removes the SearchPrecision
+// conditions from them.
+func splitConditions(expr sqlparser.Expr) ([]sqlparser.Expr, string, error) {
+	var conditions []sqlparser.Expr
+	var split func(expr sqlparser.Expr)
+	split = func(expr sqlparser.Expr) {
+		switch e := expr.(type) {
+		case *sqlparser.AndExpr:
+			split(e.Left)
+			split(e.Right)
+		case *sqlparser.ParenExpr:
+			if _, ok := e.Expr.(*sqlparser.AndExpr); ok {
+				split(e.Expr)
+				return
+			}
+			conditions = append(conditions, expr)
+		default:
+			conditions = append(conditions, expr)
+		}
+	}
+	split(expr)
+
+	precision := ""
+	filtered := conditions[:0]
+	for _, condition := range conditions {
+		value, ok, err := searchPrecisionValue(condition)
+		if err != nil {
+			return nil, "", err
+		}
+		if !ok {
+			filtered = append(filtered, condition)
+			continue
+		}
+		if precision != "" && precision != value {
+			return nil, "", query.NewConverterError("%s: only one expression is allowed for %s", query.InvalidExpressionErrMessage, SearchPrecision)
+		}
+		precision = value
+	}
+	return filtered, precision, nil
+}
+
+func searchPrecisionValue(expr sqlparser.Expr) (string, bool, error) {
+	comparison, ok := expr.(*sqlparser.ComparisonExpr)
+	if !ok {
+		return "", false, nil
+	}
+	colName, ok := comparison.Left.(*sqlparser.ColName)
+	if !ok || colNameString(colName) != SearchPrecision {
+		return "", false, nil
+	}
+	value, ok := comparison.Right.(*sqlparser.SQLVal)
+	if !ok || value.Type != sqlparser.StrVal || comparison.Operator != sqlparser.EqualStr {
+		return "", false, query.NewConverterError("%s: only operation = is supported for %s", query.InvalidExpressionErrMessage, SearchPrecision)
+	}
+	precision := string(value.Val)
+	switch precision {
+	case PrecisionDay, PrecisionHour, PrecisionMinute, PrecisionSecond:
+		return precision, true, nil
+	default:
+		return "", false, query.NewConverterError("%s: invalid value for %s: %s", query.InvalidExpressionErrMessage, SearchPrecision, precision)
+	}
+}
+
+// compareRecordKeys compares the (close time, run ID) keys of two records.
+func compareRecordKeys(aCloseTime time.Time, aRunID string, bCloseTime time.Time, bRunID string) int {
+	if cmp := aCloseTime.Compare(bCloseTime); cmp != 0 {
+		return cmp
+	}
+	return strings.Compare(aRunID, bRunID)
+}
+
+func colNameString(colName *sqlparser.ColName) string {
+	return strings.ReplaceAll(sqlparser.String(colName), "`", "")
+}
+
+func isHintField(saName string) bool {
+	for _, field := range hintFields {
+		if field == saName {
+			return true
+		}
+	}
+	return false
+}
+
+func intersect(a []string, b []string) []string {
+	var result []string
+	for _, value := range a {
+		for _, other := range b {
+			if value == other {
+				result = append(result, value)
+				break
+			}
+		}
+	}
+	return result
+}
+
+func invalidValueError(saName string, value any) error {
+	return query.NewConverterError("%s: invalid value %v for search attribute %s", query.InvalidExpressionErrMessage, value, saName)
+}
+
+func statusName(value any) (string, error) {
+	switch v := value.(type) {
+	case int64:
+		status := enumspb.WorkflowExecutionStatus(v)
+		if _, ok := enumspb.WorkflowExecutionStatus_name[int32(status)]; !ok {
+			return "", invalidValueError(searchattribute.ExecutionStatus, v)
+		}
+		return status.String(), nil
+	case string:
+		if status, err := enumspb.WorkflowExecutionStatusFromString(v); err == nil {
+			return status.String(), nil
+		}
+		// former filestore query syntax, e.g. 'completed' or 'continued_as_new'
+		normalized := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(v)), "_", "")
+		for name := range enumspb.WorkflowExecutionStatus_shorthandValue {
+			if strings.ToLower(name) == normalized {
+				return name, nil
+			}
+		}
+		return "", invalidValueError(searchattribute.ExecutionStatus, v)
+	default:
+		return "", invalidValueError(searchattribute.ExecutionStatus, v)
+	}
+}
+
+// TimeRangePrefix returns the longest common prefix of the bounds of a time range formatted in UTC with the
+// given layout. For fixed width layouts such as time.RFC3339, all times within the range share this prefix.
+func TimeRangePrefix(earliest time.Time, latest time.Time, layout string) string {
+	a := earliest.UTC().Format(layout)
+	b := latest.UTC().Format(layout)
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return a[:i]
+}
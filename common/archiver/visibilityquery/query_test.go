@@ -0,0 +1,252 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type queryTestSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	record *archiverspb.VisibilityRecord
+}
+
+func TestQuerySuite(t *testing.T) {
+	suite.Run(t, new(queryTestSuite))
+}
+
+func (s *queryTestSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.record = &archiverspb.VisibilityRecord{
+		NamespaceId:      "test-namespace-id",
+		WorkflowId:       "test-workflow-id",
+		RunId:            "test-run-id",
+		WorkflowTypeName: "test-workflow-type",
+		StartTime:        timestamppb.New(time.Date(2020, 2, 5, 9, 0, 0, 0, time.UTC)),
+		CloseTime:        timestamppb.New(time.Date(2020, 2, 5, 10, 30, 0, 0, time.UTC)),
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW,
+		HistoryLength:    36,
+		SearchAttributes: map[string]string{
+			"CustomKeywordField":  "keyword value",
+			"CustomTextField":     "Some Random Text",
+			"CustomIntField":      "42",
+			"CustomDoubleField":   "1.5",
+			"CustomBoolField":     "true",
+			"CustomDatetimeField": "2020-02-01T00:00:00Z",
+			"KeywordList01":       `["a","b"]`,
+		},
+	}
+}
+
+func (s *queryTestSuite) TestMatch() {
+	testCases := []struct {
+		query string
+		match bool
+	}{
+		{"", true},
+		{"WorkflowId = 'test-workflow-id'", true},
+		{"WorkflowId = 'other-workflow-id'", false},
+		{"WorkflowId != 'other-workflow-id' and RunId = 'test-run-id'", true},
+		{"WorkflowId = 'other-workflow-id' or RunId = 'test-run-id'", true},
+		{"WorkflowId in ('a', 'test-workflow-id')", true},
+		{"WorkflowId not in ('a', 'test-workflow-id')", false},
+		{"WorkflowId starts_with 'test-'", true},
+		{"WorkflowId not starts_with 'test-'", false},
+		{"WorkflowType = 'test-workflow-type'", true},
+		{"WorkflowTypeName = 'test-workflow-type'", true},
+		{"ExecutionStatus = 'ContinuedAsNew'", true},
+		{"ExecutionStatus = 'WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW'", true},
+		{"ExecutionStatus = 'continued_as_new'", true},
+		{"ExecutionStatus = 6", true},
+		{"ExecutionStatus in ('Completed', 'Failed')", false},
+		{"HistoryLength between 30 and 40", true},
+		{"HistoryLength not between 30 and 40", false},
+		{"not (HistoryLength > 40 or HistoryLength < 30)", true},
+		{"CloseTime > '2020-02-05T10:00:00Z'", true},
+		{"CloseTime <= '2020-02-05T10:00:00Z'", false},
+		{"CloseTime = '2020-02-05T00:00:00Z' and SearchPrecision = 'Day'", true},
+		{"CloseTime = '2020-02-05T10:00:00Z' and SearchPrecision = 'Hour'", true},
+		{"CloseTime = '2020-02-05T10:00:00Z' and SearchPrecision = 'Minute'", false},
+		{"ExecutionDuration = '1h30m'", true},
+		{"ExecutionDuration > '01:00:00'", true},
+		{"CustomKeywordField = 'keyword value'", true},
+		{"CustomKeywordField in ('a', 'b')", false},
+		{"CustomTextField = 'random some'", true},
+		{"CustomTextField = 'random other'", false},
+		{"CustomTextField != 'other'", true},
+		{"CustomIntField >= 42 and CustomDoubleField < 2", true},
+		{"CustomIntField between 43 and 50", false},
+		{"CustomBoolField = true", true},
+		{"CustomDatetimeField < '2020-02-02T00:00:00Z'", true},
+		{"KeywordList01 = 'b'", true},
+		{"KeywordList01 != 'b'", false},
+		{"KeywordList01 in ('c', 'd')", false},
+		{"CustomKeywordField is not null", true},
+		{"Keyword01 is null", true},
+		{"Keyword01 = 'x'", false},
+		{"Keyword01 != 'x'", false},
+		{"(ExecutionStatus = 'Failed' or HistoryLength > 30) and WorkflowId = 'test-workflow-id' order by CloseTime", true},
+	}
+
+	for _, tc := range testCases {
+		q, err := Parse(tc.query, searchattribute.TestNameTypeMap)
+		s.NoError(err, tc.query)
+		match, err := q.Match(s.record)
+		s.NoError(err, tc.query)
+		s.Equal(tc.match, match, tc.query)
+	}
+}
+
+func (s *queryTestSuite) TestParse_Error() {
+	testCases := []string{
+		"some invalid query",
+		"WorkflowId = 'a' group by ExecutionStatus",
+		"WorkflowId = 'a' limit 10",
+		"UnknownField = 'a'",
+		"WorkflowId = 'a' order by StartTime",
+		"WorkflowId = 'a' order by CloseTime, RunId",
+		"WorkflowId > 'a' and WorkflowId < 1",
+		"CustomTextField in ('a', 'b')",
+		"CustomBoolField between 1 and 2",
+		"ExecutionStatus = 'unknown'",
+		"CloseTime = 'not a time'",
+		"SearchPrecision = 'Week'",
+		"SearchPrecision = 'Day' and SearchPrecision = 'Hour'",
+		"WorkflowId = 'a' or SearchPrecision = 'Day'",
+	}
+
+	for _, query := range testCases {
+		_, err := Parse(query, searchattribute.TestNameTypeMap)
+		s.Error(err, query)
+	}
+}
+
+func (s *queryTestSuite) TestHints() {
+	q, err := Parse(
+		"WorkflowId in ('a', 'b') and WorkflowId = 'b' and CloseTime > '2020-02-05T00:00:00Z' and CloseTime between '2020-01-01T00:00:00Z' and '2020-02-06T00:00:00Z' and (RunId = 'c' or RunId = 'd')",
+		searchattribute.TestNameTypeMap,
+	)
+	s.NoError(err)
+	s.False(q.EmptyResult())
+	values, ok := q.Values(searchattribute.WorkflowID)
+	s.True(ok)
+	s.Equal([]string{"b"}, values)
+	_, ok = q.Values(searchattribute.RunID)
+	s.False(ok)
+	earliest, latest := q.CloseTimeRange()
+	s.Equal(time.Date(2020, 2, 5, 0, 0, 0, 1, time.UTC), earliest)
+	s.Equal(time.Date(2020, 2, 6, 0, 0, 0, 0, time.UTC), latest)
+	earliest, latest = q.StartTimeRange()
+	s.True(earliest.IsZero())
+	s.Equal(MaxTime, latest)
+
+	q, err = Parse("StartTime = '2020-02-05T10:00:00Z' and SearchPrecision = 'Hour'", searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	earliest, latest = q.StartTimeRange()
+	s.Equal("2020-02-05T10:", TimeRangePrefix(earliest, latest, time.RFC3339))
+
+	for _, query := range []string{
+		"WorkflowId = 'a' and WorkflowId = 'b'",
+		"CloseTime > '2020-02-05T00:00:00Z' and CloseTime < '2020-02-04T00:00:00Z'",
+	} {
+		q, err = Parse(query, searchattribute.TestNameTypeMap)
+		s.NoError(err, query)
+		s.True(q.EmptyResult(), query)
+		match, err := q.Match(s.record)
+		s.NoError(err)
+		s.False(match)
+	}
+}
+
+func (s *queryTestSuite) TestPage() {
+	var records []*archiverspb.VisibilityRecord
+	for _, r := range []struct {
+		runID     string
+		closeTime int64
+	}{{"run-1", 3}, {"run-2", 1}, {"run-3", 2}, {"run-0", 2}} {
+		records = append(records, &archiverspb.VisibilityRecord{
+			RunId:     r.runID,
+			CloseTime: timestamppb.New(time.Unix(r.closeTime, 0)),
+		})
+	}
+
+	testCases := []struct {
+		query    string
+		expected []string
+	}{
+		{"order by CloseTime", []string{"run-2", "run-0", "run-3", "run-1"}},
+		{"order by CloseTime desc", []string{"run-1", "run-3", "run-0", "run-2"}},
+		{"order by CloseTime asc", []string{"run-2", "run-0", "run-3", "run-1"}},
+	}
+	for _, tc := range testCases {
+		q, err := Parse(tc.query, searchattribute.TestNameTypeMap)
+		s.NoError(err)
+		s.True(q.HasOrderBy())
+
+		var runIDs []string
+		var token *PageToken
+		for {
+			var page []*archiverspb.VisibilityRecord
+			page, token = q.Page(records, token, 3)
+			for _, record := range page {
+				runIDs = append(runIDs, record.GetRunId())
+			}
+			if token == nil {
+				break
+			}
+			data, err := SerializePageToken(token)
+			s.NoError(err)
+			token, err = DeserializePageToken(data)
+			s.NoError(err)
+		}
+		s.Equal(tc.expected, runIDs, tc.query)
+	}
+
+	_, err := DeserializePageToken([]byte{1, 2, 3})
+	s.Error(err)
+}
+
+func (s *queryTestSuite) TestTimeRangePrefix() {
+	s.Equal("", TimeRangePrefix(time.Time{}, MaxTime, time.RFC3339))
+	s.Equal("2020-02-0", TimeRangePrefix(
+		time.Date(2020, 2, 5, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 2, 6, 0, 0, 0, 0, time.UTC),
+		time.RFC3339,
+	))
+	s.Equal("2020-02-05T10:30:00Z", TimeRangePrefix(
+		time.Date(2020, 2, 5, 10, 30, 0, 0, time.UTC),
+		time.Date(2020, 2, 5, 10, 30, 0, 0, time.UTC),
+		time.RFC3339,
+	))
+}
@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityquery
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// recordValues lazily resolves the values of search attributes of an archived visibility record.
+	// A search attribute which isn't set on the record has no values.
+	recordValues struct {
+		record    *archiverspb.VisibilityRecord
+		saTypeMap searchattribute.NameTypeMap
+		decoded   map[string][]any
+		// err is the first error encountered while decoding custom search attributes of the record
+		err error
+	}
+)
+
+func newRecordValues(record *archiverspb.VisibilityRecord, saTypeMap searchattribute.NameTypeMap) *recordValues {
+	return &recordValues{
+		record:    record,
+		saTypeMap: saTypeMap,
+		decoded:   make(map[string][]any),
+	}
+}
+
+func (v *recordValues) get(saName string) []any {
+	record := v.record
+	switch saName {
+	case searchattribute.NamespaceID:
+		return []any{record.GetNamespaceId()}
+	case searchattribute.WorkflowID:
+		return []any{record.GetWorkflowId()}
+	case searchattribute.RunID:
+		return []any{record.GetRunId()}
+	case searchattribute.WorkflowType:
+		return []any{record.GetWorkflowTypeName()}
+	case searchattribute.StartTime:
+		return timeValues(record.GetStartTime())
+	case searchattribute.ExecutionTime:
+		return timeValues(record.GetExecutionTime())
+	case searchattribute.CloseTime:
+		return timeValues(record.GetCloseTime())
+	case searchattribute.ExecutionStatus:
+		return []any{record.GetStatus().String()}
+	case searchattribute.HistoryLength:
+		return []any{record.GetHistoryLength()}
+	case searchattribute.ExecutionDuration:
+		if record.CloseTime == nil {
+			return nil
+		}
+		executionTime := record.GetExecutionTime()
+		if executionTime == nil {
+			executionTime = record.GetStartTime()
+		}
+		if executionTime == nil {
+			return nil
+		}
+		return []any{record.CloseTime.AsTime().Sub(executionTime.AsTime()).Nanoseconds()}
+	}
+
+	if values, ok := v.decoded[saName]; ok {
+		return values
+	}
+	values := v.decode(saName)
+	v.decoded[saName] = values
+	return values
+}
+
+func (v *recordValues) decode(saName string) []any {
+	valueStr, ok := v.record.GetSearchAttributes()[saName]
+	if !ok {
+		return nil
+	}
+	saType, err := v.saTypeMap.GetType(saName)
+	if err != nil {
+		return nil
+	}
+	searchAttributes, err := searchattribute.Parse(map[string]string{saName: valueStr}, &v.saTypeMap)
+	if err != nil {
+		v.setErr(err)
+		return nil
+	}
+	value, err := searchattribute.DecodeValue(searchAttributes.GetIndexedFields()[saName], saType, true)
+	if err != nil {
+		v.setErr(err)
+		return nil
+	}
+	values := toValueList(value)
+	for i, value := range values {
+		if t, ok := value.(time.Time); ok {
+			values[i] = t.UTC()
+		}
+	}
+	return values
+}
+
+func (v *recordValues) setErr(err error) {
+	if v.err == nil {
+		v.err = err
+	}
+}
+
+func timeValues(ts *timestamppb.Timestamp) []any {
+	if ts == nil {
+		return nil
+	}
+	return []any{ts.AsTime()}
+}
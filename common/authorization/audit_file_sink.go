@@ -0,0 +1,146 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	defaultAuditMaxFileSizeMB = 100
+	defaultAuditMaxBackups    = 5
+)
+
+type (
+	// fileAuditSink writes audit records as JSON lines and rotates the file by size.
+	// Rotated files are named <file>.1 (newest) to <file>.<maxBackups> (oldest).
+	// If rotation fails, records keep going to the current file and rotation is retried on the next write.
+	fileAuditSink struct {
+		path        string
+		maxFileSize int64
+		maxBackups  int
+
+		file *os.File
+		size int64
+	}
+)
+
+var _ AuditSink = (*fileAuditSink)(nil)
+
+// NewFileAuditSink creates an audit sink writing to the file in cfg.
+func NewFileAuditSink(cfg *config.AuthorizationAudit) (*fileAuditSink, error) {
+	maxFileSizeMB := cfg.MaxFileSizeMB
+	if maxFileSizeMB <= 0 {
+		maxFileSizeMB = defaultAuditMaxFileSizeMB
+	}
+	maxBackups := cfg.MaxBackups
+	if maxBackups <= 0 {
+		maxBackups = defaultAuditMaxBackups
+	}
+	s := &fileAuditSink{
+		path:        cfg.File,
+		maxFileSize: int64(maxFileSizeMB) * 1024 * 1024,
+		maxBackups:  maxBackups,
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *fileAuditSink) Write(record *AuditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+	var rotateErr error
+	if s.size > 0 && s.size+int64(len(line)) > s.maxFileSize {
+		rotateErr = s.rotate()
+	}
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+	if err != nil {
+		return err
+	}
+	return rotateErr
+}
+
+func (s *fileAuditSink) Close() error {
+	return s.file.Close()
+}
+
+func (s *fileAuditSink) open() error {
+	file, size, err := openAuditFile(s.path)
+	if err != nil {
+		return err
+	}
+	s.file = file
+	s.size = size
+	return nil
+}
+
+// rotate renames the current file before opening a new one, and keeps the
+// current file handle until the new file is open.
+func (s *fileAuditSink) rotate() error {
+	for i := s.maxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(s.backupPath(i), s.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("unable to rotate audit log file: %w", err)
+		}
+	}
+	if err := os.Rename(s.path, s.backupPath(1)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("unable to rotate audit log file: %w", err)
+	}
+	file, size, err := openAuditFile(s.path)
+	if err != nil {
+		return err
+	}
+	previous := s.file
+	s.file = file
+	s.size = size
+	if err := previous.Close(); err != nil {
+		return fmt.Errorf("unable to close rotated audit log file: %w", err)
+	}
+	return nil
+}
+
+func (s *fileAuditSink) backupPath(i int) string {
+	return fmt.Sprintf("%s.%d", s.path, i)
+}
+
+func openAuditFile(path string) (*os.File, int64, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to open audit log file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, 0, fmt.Errorf("unable to stat audit log file: %w", err)
+	}
+	return file, info.Size(), nil
+}
@@ -0,0 +1,208 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"sync"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	AuditDecisionAllow = "allow"
+	AuditDecisionDeny  = "deny"
+	// AuditDecisionError is recorded when the authorizer failed to make a decision.
+	AuditDecisionError = "error"
+
+	defaultAuditBufferSize = 10000
+)
+
+type (
+	// AuditRecord describes a single authorization decision.
+	AuditRecord struct {
+		Time       time.Time `json:"time"`
+		Subject    string    `json:"subject,omitempty"`
+		APIName    string    `json:"apiName"`
+		Namespace  string    `json:"namespace,omitempty"`
+		WorkflowID string    `json:"workflowId,omitempty"`
+		Decision   string    `json:"decision"`
+		Reason     string    `json:"reason,omitempty"`
+		// Latency of the authorization of the call.
+		Latency time.Duration `json:"latencyNanos"`
+	}
+
+	// AuditSink receives audit records. Records are written from a single goroutine.
+	AuditSink interface {
+		Write(record *AuditRecord) error
+		Close() error
+	}
+
+	// AuditLogger accepts audit records without blocking the caller.
+	AuditLogger interface {
+		Log(record *AuditRecord)
+	}
+
+	// AsyncAuditLogger buffers audit records and writes them to the sinks in the background.
+	// Records are dropped when the buffer is full, so a slow sink never blocks requests.
+	AsyncAuditLogger struct {
+		sinks          []AuditSink
+		records        chan *AuditRecord
+		metricsHandler metrics.Handler
+		logger         log.Logger
+
+		stopOnce sync.Once
+		stopCh   chan struct{}
+		doneCh   chan struct{}
+	}
+
+	hasWorkflowID interface {
+		GetWorkflowId() string
+	}
+
+	hasWorkflowExecution interface {
+		GetWorkflowExecution() *commonpb.WorkflowExecution
+	}
+
+	hasExecution interface {
+		GetExecution() *commonpb.WorkflowExecution
+	}
+)
+
+var _ AuditLogger = (*AsyncAuditLogger)(nil)
+
+// NewAuditLoggerFromConfig creates an audit logger writing to the file sink in cfg, if configured,
+// and to the additional sinks. It returns nil when there is no sink.
+func NewAuditLoggerFromConfig(
+	cfg *config.AuthorizationAudit,
+	sinks []AuditSink,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) (*AsyncAuditLogger, error) {
+	if cfg.File != "" {
+		fileSink, err := NewFileAuditSink(cfg)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append([]AuditSink{fileSink}, sinks...)
+	}
+	if len(sinks) == 0 {
+		return nil, nil
+	}
+	return NewAsyncAuditLogger(sinks, cfg.BufferSize, metricsHandler, logger), nil
+}
+
+// NewAsyncAuditLogger creates an audit logger buffering up to bufferSize records.
+func NewAsyncAuditLogger(
+	sinks []AuditSink,
+	bufferSize int,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *AsyncAuditLogger {
+	if bufferSize <= 0 {
+		bufferSize = defaultAuditBufferSize
+	}
+	return &AsyncAuditLogger{
+		sinks:          sinks,
+		records:        make(chan *AuditRecord, bufferSize),
+		metricsHandler: metricsHandler.WithTags(metrics.OperationTag(metrics.AuthorizationScope)),
+		logger:         logger,
+		stopCh:         make(chan struct{}),
+		doneCh:         make(chan struct{}),
+	}
+}
+
+func (l *AsyncAuditLogger) Start() {
+	go l.writeLoop()
+}
+
+// Stop writes the buffered records and closes the sinks.
+func (l *AsyncAuditLogger) Stop() {
+	l.stopOnce.Do(func() {
+		close(l.stopCh)
+		<-l.doneCh
+	})
+}
+
+// Log enqueues the record, or drops it if the buffer is full.
+func (l *AsyncAuditLogger) Log(record *AuditRecord) {
+	select {
+	case l.records <- record:
+	default:
+		l.metricsHandler.Counter(metrics.AuthorizationAuditRecordsDropped.Name()).Record(1)
+	}
+}
+
+func (l *AsyncAuditLogger) writeLoop() {
+	defer close(l.doneCh)
+	for {
+		select {
+		case record := <-l.records:
+			l.write(record)
+		case <-l.stopCh:
+			for {
+				select {
+				case record := <-l.records:
+					l.write(record)
+				default:
+					l.closeSinks()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (l *AsyncAuditLogger) write(record *AuditRecord) {
+	for _, sink := range l.sinks {
+		if err := sink.Write(record); err != nil {
+			l.metricsHandler.Counter(metrics.AuthorizationAuditSinkErrors.Name()).Record(1)
+			l.logger.Error("Unable to write authorization audit record", tag.Error(err))
+		}
+	}
+}
+
+func (l *AsyncAuditLogger) closeSinks() {
+	for _, sink := range l.sinks {
+		if err := sink.Close(); err != nil {
+			l.logger.Error("Unable to close authorization audit sink", tag.Error(err))
+		}
+	}
+}
+
+func auditWorkflowID(req interface{}) string {
+	switch r := req.(type) {
+	case hasWorkflowID:
+		return r.GetWorkflowId()
+	case hasWorkflowExecution:
+		return r.GetWorkflowExecution().GetWorkflowId()
+	case hasExecution:
+		return r.GetExecution().GetWorkflowId()
+	}
+	return ""
+}
@@ -0,0 +1,189 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+type testAuditSink struct {
+	sync.Mutex
+	records []*AuditRecord
+	block   chan struct{}
+	closed  bool
+}
+
+func (s *testAuditSink) Write(record *AuditRecord) error {
+	if s.block != nil {
+		<-s.block
+	}
+	s.Lock()
+	defer s.Unlock()
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *testAuditSink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	s.closed = true
+	return nil
+}
+
+func TestAsyncAuditLogger_WritesAndFlushesOnStop(t *testing.T) {
+	sink := &testAuditSink{}
+	auditLogger := NewAsyncAuditLogger([]AuditSink{sink}, 10, metrics.NoopMetricsHandler, log.NewNoopLogger())
+	auditLogger.Start()
+	for i := 0; i < 5; i++ {
+		auditLogger.Log(&AuditRecord{APIName: "api"})
+	}
+	auditLogger.Stop()
+
+	require.Len(t, sink.records, 5)
+	require.True(t, sink.closed)
+}
+
+func TestAsyncAuditLogger_DropsWhenFull(t *testing.T) {
+	sink := &testAuditSink{block: make(chan struct{})}
+	auditLogger := NewAsyncAuditLogger([]AuditSink{sink}, 2, metrics.NoopMetricsHandler, log.NewNoopLogger())
+	auditLogger.Start()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10; i++ {
+			auditLogger.Log(&AuditRecord{APIName: "api"})
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "Log blocked on a slow sink")
+	}
+
+	close(sink.block)
+	auditLogger.Stop()
+	// one record may be held by the blocked sink in addition to the buffered ones
+	require.LessOrEqual(t, len(sink.records), 3)
+	require.NotEmpty(t, sink.records)
+}
+
+func TestNewAuditLoggerFromConfig_NoSinks(t *testing.T) {
+	auditLogger, err := NewAuditLoggerFromConfig(&config.AuthorizationAudit{}, nil, metrics.NoopMetricsHandler, log.NewNoopLogger())
+	require.NoError(t, err)
+	require.Nil(t, auditLogger)
+}
+
+func TestFileAuditSink_Rotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileAuditSink(&config.AuthorizationAudit{File: path, MaxBackups: 2})
+	require.NoError(t, err)
+	// rotate after every record
+	sink.maxFileSize = 1
+
+	for _, subject := range []string{"a", "b", "c", "d"} {
+		require.NoError(t, sink.Write(&AuditRecord{Subject: subject, Decision: AuditDecisionAllow}))
+	}
+	require.NoError(t, sink.Close())
+
+	for file, subject := range map[string]string{path: "d", path + ".1": "c", path + ".2": "b"} {
+		records := readAuditRecords(t, file)
+		require.Len(t, records, 1)
+		require.Equal(t, subject, records[0].Subject)
+	}
+	_, err = os.Stat(path + ".3")
+	require.True(t, errors.Is(err, os.ErrNotExist))
+}
+
+func TestFileAuditSink_RotateFailure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileAuditSink(&config.AuthorizationAudit{File: path, MaxBackups: 1})
+	require.NoError(t, err)
+	sink.maxFileSize = 1
+	require.NoError(t, sink.Write(&AuditRecord{Subject: "a"}))
+
+	// a non-empty directory in place of the backup fails the rename
+	require.NoError(t, os.MkdirAll(filepath.Join(path+".1", "dir"), 0700))
+	require.Error(t, sink.Write(&AuditRecord{Subject: "b"}))
+	require.Len(t, readAuditRecords(t, path), 2)
+
+	require.NoError(t, os.RemoveAll(path+".1"))
+	require.NoError(t, sink.Write(&AuditRecord{Subject: "c"}))
+	require.NoError(t, sink.Close())
+	require.Len(t, readAuditRecords(t, path+".1"), 2)
+	records := readAuditRecords(t, path)
+	require.Len(t, records, 1)
+	require.Equal(t, "c", records[0].Subject)
+}
+
+func TestFileAuditSink_Append(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	for i := 0; i < 2; i++ {
+		sink, err := NewFileAuditSink(&config.AuthorizationAudit{File: path})
+		require.NoError(t, err)
+		require.NoError(t, sink.Write(&AuditRecord{APIName: "api", Latency: time.Millisecond}))
+		require.NoError(t, sink.Close())
+	}
+	records := readAuditRecords(t, path)
+	require.Len(t, records, 2)
+	require.Equal(t, time.Millisecond, records[1].Latency)
+}
+
+func TestAuditWorkflowID(t *testing.T) {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "wf-id"}
+	require.Equal(t, "wf-id", auditWorkflowID(&workflowservice.StartWorkflowExecutionRequest{WorkflowId: "wf-id"}))
+	require.Equal(t, "wf-id", auditWorkflowID(&workflowservice.SignalWorkflowExecutionRequest{WorkflowExecution: execution}))
+	require.Equal(t, "wf-id", auditWorkflowID(&workflowservice.DescribeWorkflowExecutionRequest{Execution: execution}))
+	require.Equal(t, "", auditWorkflowID(&workflowservice.DescribeNamespaceRequest{}))
+}
+
+func readAuditRecords(t *testing.T, path string) []*AuditRecord {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+
+	var records []*AuditRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record AuditRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, &record)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
@@ -32,6 +32,7 @@ import (
 	"strings"
 
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 )
 
 const (
@@ -52,6 +53,9 @@ type CallTarget struct {
 	Namespace string
 	// Request contains a deserialized copy of the API request object
 	Request interface{}
+	// WorkflowTypeResolver resolves the workflow type of an existing execution, for requests which
+	// target an execution without carrying its type, e.g. SignalWorkflowExecution. It may be nil.
+	WorkflowTypeResolver WorkflowTypeResolver
 }
 
 // @@@SNIPEND
@@ -80,13 +84,19 @@ type hasNamespace interface {
 	GetNamespace() string
 }
 
-func GetAuthorizerFromConfig(config *config.Authorization) (Authorizer, error) {
+func GetAuthorizerFromConfig(config *config.Authorization, logger log.Logger) (Authorizer, error) {
 
 	switch strings.ToLower(config.Authorizer) {
 	case "":
 		return NewNoopAuthorizer(), nil
 	case "default":
 		return NewDefaultAuthorizer(), nil
+	case "policy":
+		authorizer, err := NewPolicyAuthorizer(&config.Policy, logger)
+		if err != nil {
+			return nil, err
+		}
+		return authorizer, nil
 	}
 	return nil, fmt.Errorf("unknown authorizer: %s", config.Authorizer)
 }
@@ -85,11 +85,30 @@ func (*noopClaimMapper) AuthInfoRequired() bool {
 
 func GetClaimMapperFromConfig(config *config.Authorization, logger log.Logger) (ClaimMapper, error) {
 
-	switch strings.ToLower(config.ClaimMapper) {
+	names := strings.Split(config.ClaimMapper, ",")
+	if len(names) == 1 {
+		return getClaimMapper(names[0], config, logger)
+	}
+	claimMappers := make([]ClaimMapper, len(names))
+	for i, name := range names {
+		claimMapper, err := getClaimMapper(name, config, logger)
+		if err != nil {
+			return nil, err
+		}
+		claimMappers[i] = claimMapper
+	}
+	return NewCompositeClaimMapper(claimMappers...), nil
+}
+
+func getClaimMapper(name string, config *config.Authorization, logger log.Logger) (ClaimMapper, error) {
+
+	switch strings.ToLower(strings.TrimSpace(name)) {
 	case "":
 		return NewNoopClaimMapper(), nil
 	case "default":
 		return NewDefaultJWTClaimMapper(NewDefaultTokenKeyProvider(config, logger), config, logger), nil
+	case "tls":
+		return NewTLSClaimMapper(&config.TLSClaimMapper)
 	}
-	return nil, fmt.Errorf("unknown claim mapper: %s", config.ClaimMapper)
+	return nil, fmt.Errorf("unknown claim mapper: %s", name)
 }
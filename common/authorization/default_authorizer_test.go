@@ -33,6 +33,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 )
 
 var (
@@ -184,6 +185,9 @@ func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigNoop() {
 func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigDefault() {
 	s.testGetAuthorizerFromConfig("default", true, reflect.TypeOf(&defaultAuthorizer{}))
 }
+func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigPolicyWithoutFile() {
+	s.testGetAuthorizerFromConfig("policy", false, nil)
+}
 func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigUnknown() {
 	s.testGetAuthorizerFromConfig("foo", false, nil)
 }
@@ -191,7 +195,7 @@ func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigUnknown() {
 func (s *defaultAuthorizerSuite) testGetAuthorizerFromConfig(name string, valid bool, authorizerType reflect.Type) {
 
 	cfg := config.Authorization{Authorizer: name}
-	auth, err := GetAuthorizerFromConfig(&cfg)
+	auth, err := GetAuthorizerFromConfig(&cfg, log.NewNoopLogger())
 	if valid {
 		s.NoError(err)
 		s.NotNil(auth)
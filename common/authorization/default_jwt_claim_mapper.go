@@ -27,6 +27,7 @@ package authorization
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -41,6 +42,7 @@ const (
 	defaultPermissionsClaimName = "permissions"
 	authorizationBearer         = "bearer"
 	headerSubject               = "sub"
+	headerIssuer                = "iss"
 	permissionScopeSystem       = primitives.SystemLocalNamespace
 	permissionRead              = "read"
 	permissionWrite             = "write"
@@ -53,6 +55,16 @@ type defaultJWTClaimMapper struct {
 	keyProvider          TokenKeyProvider
 	logger               log.Logger
 	permissionsClaimName string
+	subjectClaimName     string
+	groupsClaimName      string
+	groupPermissions     map[string][]string
+	issuers              map[string]*jwtIssuer
+}
+
+// jwtIssuer restricts the tokens of a trusted issuer
+type jwtIssuer struct {
+	audiences  []string
+	namespaces []*regexp.Regexp
 }
 
 func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorization, logger log.Logger) ClaimMapper {
@@ -60,7 +72,29 @@ func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorizati
 	if claimName == "" {
 		claimName = defaultPermissionsClaimName
 	}
-	return &defaultJWTClaimMapper{keyProvider: provider, logger: logger, permissionsClaimName: claimName}
+	subjectClaimName := cfg.OIDC.SubjectClaim
+	if subjectClaimName == "" {
+		subjectClaimName = headerSubject
+	}
+	var issuers map[string]*jwtIssuer
+	if len(cfg.OIDC.Issuers) > 0 {
+		issuers = make(map[string]*jwtIssuer, len(cfg.OIDC.Issuers))
+		for _, issuer := range cfg.OIDC.Issuers {
+			issuers[issuer.Issuer] = &jwtIssuer{
+				audiences:  issuer.Audiences,
+				namespaces: compilePatterns(issuer.Namespaces),
+			}
+		}
+	}
+	return &defaultJWTClaimMapper{
+		keyProvider:          provider,
+		logger:               logger,
+		permissionsClaimName: claimName,
+		subjectClaimName:     subjectClaimName,
+		groupsClaimName:      cfg.OIDC.GroupsClaim,
+		groupPermissions:     cfg.OIDC.GroupPermissions,
+		issuers:              issuers,
+	}
 }
 
 var _ ClaimMapper = (*defaultJWTClaimMapper)(nil)
@@ -84,21 +118,114 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 	if err != nil {
 		return nil, err
 	}
-	subject, ok := jwtClaims[headerSubject].(string)
+	issuer, err := a.validateIssuer(jwtClaims)
+	if err != nil {
+		return nil, err
+	}
+	subjectClaim, _ := lookupClaim(jwtClaims, a.subjectClaimName)
+	subject, ok := subjectClaim.(string)
 	if !ok {
-		return nil, serviceerror.NewPermissionDenied("unexpected value type of \"sub\" claim", "")
+		return nil, serviceerror.NewPermissionDenied(fmt.Sprintf("unexpected value type of %q claim", a.subjectClaimName), "")
 	}
 	claims.Subject = subject
-	permissions, ok := jwtClaims[a.permissionsClaimName].([]interface{})
+	permissionsClaim, _ := lookupClaim(jwtClaims, a.permissionsClaimName)
+	permissions, ok := permissionsClaim.([]interface{})
 	if ok {
 		err := a.extractPermissions(permissions, &claims)
 		if err != nil {
 			return nil, err
 		}
 	}
+	if a.groupsClaimName != "" {
+		a.extractGroupPermissions(jwtClaims, &claims)
+	}
+	if issuer != nil {
+		issuer.restrictNamespaces(&claims)
+	}
 	return &claims, nil
 }
 
+// validateIssuer checks the token issuer and audience when trusted issuers are configured.
+func (a *defaultJWTClaimMapper) validateIssuer(jwtClaims jwt.MapClaims) (*jwtIssuer, error) {
+	if a.issuers == nil {
+		return nil, nil
+	}
+	iss, _ := jwtClaims[headerIssuer].(string)
+	issuer, ok := a.issuers[iss]
+	if !ok {
+		return nil, serviceerror.NewPermissionDenied("unexpected token issuer", "")
+	}
+	if len(issuer.audiences) == 0 {
+		return issuer, nil
+	}
+	for _, audience := range issuer.audiences {
+		if jwtClaims.VerifyAudience(audience, true) {
+			return issuer, nil
+		}
+	}
+	return nil, serviceerror.NewPermissionDenied("audience mismatch", "")
+}
+
+func (a *defaultJWTClaimMapper) extractGroupPermissions(jwtClaims jwt.MapClaims, claims *Claims) {
+	groupsClaim, ok := lookupClaim(jwtClaims, a.groupsClaimName)
+	if !ok {
+		return
+	}
+	var groups []interface{}
+	switch g := groupsClaim.(type) {
+	case []interface{}:
+		groups = g
+	case string:
+		groups = []interface{}{g}
+	default:
+		a.logger.Warn(fmt.Sprintf("ignoring groups claim of unexpected type: %T", groupsClaim))
+		return
+	}
+	for _, group := range groups {
+		name, ok := group.(string)
+		if !ok {
+			continue
+		}
+		for _, permission := range a.groupPermissions[name] {
+			a.addPermission(permission, claims)
+		}
+	}
+}
+
+// restrictNamespaces drops roles in namespaces the issuer may not grant roles in.
+func (i *jwtIssuer) restrictNamespaces(claims *Claims) {
+	if len(i.namespaces) == 0 {
+		return
+	}
+	if !matchAny(i.namespaces, permissionScopeSystem) {
+		claims.System = RoleUndefined
+	}
+	for namespace := range claims.Namespaces {
+		if !matchAny(i.namespaces, namespace) {
+			delete(claims.Namespaces, namespace)
+		}
+	}
+}
+
+// lookupClaim returns the claim with the given name. If there is no such claim,
+// the name is treated as a dot separated path of nested claims.
+func lookupClaim(jwtClaims jwt.MapClaims, name string) (interface{}, bool) {
+	if value, ok := jwtClaims[name]; ok {
+		return value, true
+	}
+	var value interface{} = map[string]interface{}(jwtClaims)
+	for _, part := range strings.Split(name, ".") {
+		nested, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = nested[part]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
 func (a *defaultJWTClaimMapper) extractPermissions(permissions []interface{}, claims *Claims) error {
 	for _, permission := range permissions {
 		p, ok := permission.(string)
@@ -106,26 +233,38 @@ func (a *defaultJWTClaimMapper) extractPermissions(permissions []interface{}, cl
 			a.logger.Warn(fmt.Sprintf("ignoring permission that is not a string: %v", permission))
 			continue
 		}
-		parts := strings.Split(p, ":")
-		if len(parts) != 2 {
-			a.logger.Warn(fmt.Sprintf("ignoring permission in unexpected format: %v", permission))
-			continue
-		}
-		namespace := parts[0]
-		if namespace == permissionScopeSystem {
-			claims.System |= permissionToRole(parts[1])
-		} else {
-			if claims.Namespaces == nil {
-				claims.Namespaces = make(map[string]Role)
-			}
-			role := claims.Namespaces[namespace]
-			role |= permissionToRole(parts[1])
-			claims.Namespaces[namespace] = role
-		}
+		a.addPermission(p, claims)
 	}
 	return nil
 }
 
+func (a *defaultJWTClaimMapper) addPermission(permission string, claims *Claims) {
+	if !addPermission(permission, claims) {
+		a.logger.Warn(fmt.Sprintf("ignoring permission in unexpected format: %v", permission))
+	}
+}
+
+// addPermission adds a permission in the `namespace:role` format to the claims.
+// Returns false if the permission has an unexpected format.
+func addPermission(permission string, claims *Claims) bool {
+	parts := strings.Split(permission, ":")
+	if len(parts) != 2 {
+		return false
+	}
+	namespace := parts[0]
+	if namespace == permissionScopeSystem {
+		claims.System |= permissionToRole(parts[1])
+	} else {
+		if claims.Namespaces == nil {
+			claims.Namespaces = make(map[string]Role)
+		}
+		role := claims.Namespaces[namespace]
+		role |= permissionToRole(parts[1])
+		claims.Namespaces[namespace] = role
+	}
+	return true
+}
+
 func parseJWT(tokenString string, keyProvider TokenKeyProvider) (jwt.MapClaims, error) {
 	return parseJWTWithAudience(tokenString, keyProvider, "")
 }
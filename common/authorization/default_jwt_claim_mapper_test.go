@@ -217,6 +217,15 @@ func (s *defaultClaimMapperSuite) TestGetClaimMapperFromConfigDefault() {
 	s.testGetClaimMapperFromConfig("default", true, reflect.TypeOf(&defaultJWTClaimMapper{}))
 }
 
+func (s *defaultClaimMapperSuite) TestGetClaimMapperFromConfigTLS() {
+	s.testGetClaimMapperFromConfig("tls", true, reflect.TypeOf(&tlsClaimMapper{}))
+}
+
+func (s *defaultClaimMapperSuite) TestGetClaimMapperFromConfigComposite() {
+	s.testGetClaimMapperFromConfig("default, tls", true, reflect.TypeOf(&compositeClaimMapper{}))
+	s.testGetClaimMapperFromConfig("default,foo", false, nil)
+}
+
 func (s *defaultClaimMapperSuite) TestGetClaimMapperFromConfigUnknown() {
 	s.testGetClaimMapperFromConfig("foo", false, nil)
 }
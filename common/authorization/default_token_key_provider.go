@@ -25,11 +25,13 @@
 package authorization
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/rsa"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -43,30 +45,63 @@ import (
 	"go.temporal.io/server/common/log/tag"
 )
 
-// Default token key provider
+const (
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+	// minimal interval between key refreshes triggered by tokens signed with an unknown key
+	minKeyRefreshInterval = 30 * time.Second
+	keyRequestTimeout     = 10 * time.Second
+)
+
+// Default token key provider.
+// Keys of the key source URIs verify tokens of any issuer other than the OIDC issuers,
+// while the keys of an OIDC issuer only verify tokens of that issuer.
 type defaultTokenKeyProvider struct {
-	config   config.JWTKeyProvider
-	rsaKeys  map[string]*rsa.PublicKey
-	ecKeys   map[string]*ecdsa.PublicKey
-	keysLock sync.RWMutex
-	ticker   *time.Ticker
-	logger   log.Logger
-	stop     chan bool
+	config  config.JWTKeyProvider
+	issuers []string
+	// uriKeys are the keys of each key source URI and issuerKeys the keys of each OIDC issuer
+	uriKeys    map[string]*tokenKeySet
+	issuerKeys map[string]*tokenKeySet
+	keysLock   sync.RWMutex
+	ticker     *time.Ticker
+	logger     log.Logger
+	stop       chan bool
+
+	httpClient  *http.Client
+	refreshLock sync.Mutex
+	lastRefresh time.Time
+}
+
+type tokenKeySet struct {
+	rsaKeys map[string]*rsa.PublicKey
+	ecKeys  map[string]*ecdsa.PublicKey
+}
+
+type oidcDiscoveryDocument struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
 }
 
 var _ TokenKeyProvider = (*defaultTokenKeyProvider)(nil)
+var _ RawTokenKeyProvider = (*defaultTokenKeyProvider)(nil)
 
 func NewDefaultTokenKeyProvider(cfg *config.Authorization, logger log.Logger) *defaultTokenKeyProvider {
-	provider := defaultTokenKeyProvider{config: cfg.JWTKeyProvider, logger: logger}
+	provider := defaultTokenKeyProvider{
+		config:     cfg.JWTKeyProvider,
+		logger:     logger,
+		httpClient: &http.Client{Timeout: keyRequestTimeout},
+	}
+	for _, issuer := range cfg.OIDC.Issuers {
+		provider.issuers = append(provider.issuers, issuer.Issuer)
+	}
 	provider.initialize()
 	return &provider
 }
 
 func (a *defaultTokenKeyProvider) initialize() {
-	a.rsaKeys = make(map[string]*rsa.PublicKey)
-	a.ecKeys = make(map[string]*ecdsa.PublicKey)
-	if a.config.HasSourceURIsConfigured() {
-		err := a.updateKeys()
+	a.uriKeys = make(map[string]*tokenKeySet)
+	a.issuerKeys = make(map[string]*tokenKeySet)
+	if a.hasKeySources() {
+		err := a.refreshKeys()
 		if err != nil {
 			a.logger.Error("error during initial retrieval of token keys: ", tag.Error(err))
 		}
@@ -84,32 +119,88 @@ func (a *defaultTokenKeyProvider) Close() {
 	close(a.stop)
 }
 
+// GetKey returns the key for the token, from the keys of the token issuer if it is an OIDC issuer.
+func (a *defaultTokenKeyProvider) GetKey(_ context.Context, token *jwt.Token) (interface{}, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok {
+		return nil, fmt.Errorf("malformed token - no \"kid\" header")
+	}
+	alg, _ := token.Header["alg"].(string)
+	var issuer string
+	if claims, ok := token.Claims.(jwt.MapClaims); ok {
+		issuer, _ = claims[headerIssuer].(string)
+	}
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		return a.HmacKey(alg, kid)
+	case *jwt.SigningMethodRSA:
+		return a.rsaKey(issuer, alg, kid)
+	case *jwt.SigningMethodECDSA:
+		return a.ecdsaKey(issuer, alg, kid)
+	default:
+		return nil, fmt.Errorf("unexpected signing method: %v for algorithm: %v", token.Method, alg)
+	}
+}
+
+// RsaKey returns an RSA key of the key source URIs.
 func (a *defaultTokenKeyProvider) RsaKey(alg string, kid string) (*rsa.PublicKey, error) {
+	return a.rsaKey("", alg, kid)
+}
+
+// EcdsaKey returns an ECDSA key of the key source URIs.
+func (a *defaultTokenKeyProvider) EcdsaKey(alg string, kid string) (*ecdsa.PublicKey, error) {
+	return a.ecdsaKey("", alg, kid)
+}
+
+func (a *defaultTokenKeyProvider) rsaKey(issuer string, alg string, kid string) (*rsa.PublicKey, error) {
 	if !strings.EqualFold(alg, jwt.SigningMethodRS256.Name) {
 		return nil, fmt.Errorf("unexpected signing algorithm: %s", alg)
 	}
 
-	a.keysLock.RLock()
-	key, found := a.rsaKeys[kid]
-	a.keysLock.RUnlock()
-	if !found {
+	find := func(keys *tokenKeySet) bool { _, ok := keys.rsaKeys[kid]; return ok }
+	keys := a.findKeySet(issuer, find)
+	if keys == nil && a.refreshKeysForUnknownKID() {
+		keys = a.findKeySet(issuer, find)
+	}
+	if keys == nil {
 		return nil, fmt.Errorf("RSA key not found for key ID: %s", kid)
 	}
-	return key, nil
+	return keys.rsaKeys[kid], nil
 }
 
-func (a *defaultTokenKeyProvider) EcdsaKey(alg string, kid string) (*ecdsa.PublicKey, error) {
+func (a *defaultTokenKeyProvider) ecdsaKey(issuer string, alg string, kid string) (*ecdsa.PublicKey, error) {
 	if !strings.EqualFold(alg, jwt.SigningMethodES256.Name) {
 		return nil, fmt.Errorf("unexpected signing algorithm: %s", alg)
 	}
 
-	a.keysLock.RLock()
-	key, found := a.ecKeys[kid]
-	a.keysLock.RUnlock()
-	if !found {
+	find := func(keys *tokenKeySet) bool { _, ok := keys.ecKeys[kid]; return ok }
+	keys := a.findKeySet(issuer, find)
+	if keys == nil && a.refreshKeysForUnknownKID() {
+		keys = a.findKeySet(issuer, find)
+	}
+	if keys == nil {
 		return nil, fmt.Errorf("ECDSA key not found for key ID: %s", kid)
 	}
-	return key, nil
+	return keys.ecKeys[kid], nil
+}
+
+// findKeySet returns the first key set of the issuer for which find returns true.
+// Only the keys of the issuer are used for OIDC issuers, and the keys of the key source URIs otherwise.
+func (a *defaultTokenKeyProvider) findKeySet(issuer string, find func(*tokenKeySet) bool) *tokenKeySet {
+	a.keysLock.RLock()
+	defer a.keysLock.RUnlock()
+	if slices.Contains(a.issuers, issuer) {
+		if keys := a.issuerKeys[issuer]; keys != nil && find(keys) {
+			return keys
+		}
+		return nil
+	}
+	for _, uri := range a.config.KeySourceURIs {
+		if keys := a.uriKeys[uri]; keys != nil && find(keys) {
+			return keys
+		}
+	}
+	return nil
 }
 
 func (a *defaultTokenKeyProvider) SupportedMethods() []string {
@@ -123,8 +214,8 @@ func (a *defaultTokenKeyProvider) timerCallback() {
 			return
 		case <-a.ticker.C:
 		}
-		if a.config.HasSourceURIsConfigured() {
-			err := a.updateKeys()
+		if a.hasKeySources() {
+			err := a.refreshKeys()
 			if err != nil {
 				a.logger.Error("error while refreshing token keys: ", tag.Error(err))
 			}
@@ -132,29 +223,97 @@ func (a *defaultTokenKeyProvider) timerCallback() {
 	}
 }
 
+func (a *defaultTokenKeyProvider) hasKeySources() bool {
+	return a.config.HasSourceURIsConfigured() || len(a.issuers) > 0
+}
+
+// refreshKeysForUnknownKID refreshes keys when a token is signed with an unknown key, which happens
+// right after an issuer rotates its keys. Refreshes are rate limited so that tokens with bogus key IDs
+// can't flood the issuers. Returns false if the refresh was skipped.
+func (a *defaultTokenKeyProvider) refreshKeysForUnknownKID() bool {
+	if !a.hasKeySources() {
+		return false
+	}
+	a.refreshLock.Lock()
+	defer a.refreshLock.Unlock()
+	if time.Since(a.lastRefresh) < minKeyRefreshInterval {
+		return false
+	}
+	if err := a.updateKeys(); err != nil {
+		a.logger.Error("error while refreshing token keys for unknown key ID: ", tag.Error(err))
+	}
+	return true
+}
+
+func (a *defaultTokenKeyProvider) refreshKeys() error {
+	a.refreshLock.Lock()
+	defer a.refreshLock.Unlock()
+	return a.updateKeys()
+}
+
+// updateKeys fetches the keys of every key source. A source which fails keeps its previous keys
+// and doesn't prevent updating the others. Refreshes are only rate limited after a fetch
+// of all sources succeeded.
+// updateKeys must be called with refreshLock held.
 func (a *defaultTokenKeyProvider) updateKeys() error {
-	if !a.config.HasSourceURIsConfigured() {
+	if !a.hasKeySources() {
 		return fmt.Errorf("no URIs configured for retrieving token keys")
 	}
 
-	rsaKeys := make(map[string]*rsa.PublicKey)
-	ecKeys := make(map[string]*ecdsa.PublicKey)
-
+	var errs error
+	uriKeys := make(map[string]*tokenKeySet)
 	for _, uri := range a.config.KeySourceURIs {
 		if strings.TrimSpace(uri) == "" {
 			continue
 		}
-		err := a.updateKeysFromURI(uri, rsaKeys, ecKeys)
+		keys, err := a.fetchKeys(uri)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("unable to retrieve token keys from %s: %w", uri, err))
+			keys = a.uriKeys[uri]
+		}
+		if keys != nil {
+			uriKeys[uri] = keys
+		}
+	}
+	issuerKeys := make(map[string]*tokenKeySet)
+	for _, issuer := range a.issuers {
+		keys, err := a.fetchIssuerKeys(issuer)
 		if err != nil {
-			return err
+			errs = multierr.Append(errs, fmt.Errorf("unable to retrieve token keys of issuer %s: %w", issuer, err))
+			keys = a.issuerKeys[issuer]
+		}
+		if keys != nil {
+			issuerKeys[issuer] = keys
 		}
 	}
 	// swap old keys with the new ones
 	a.keysLock.Lock()
-	a.rsaKeys = rsaKeys
-	a.ecKeys = ecKeys
+	a.uriKeys = uriKeys
+	a.issuerKeys = issuerKeys
 	a.keysLock.Unlock()
-	return nil
+	if errs == nil {
+		a.lastRefresh = time.Now()
+	}
+	return errs
+}
+
+func (a *defaultTokenKeyProvider) fetchIssuerKeys(issuer string) (*tokenKeySet, error) {
+	uri, err := a.discoverKeysURI(issuer)
+	if err != nil {
+		return nil, err
+	}
+	return a.fetchKeys(uri)
+}
+
+func (a *defaultTokenKeyProvider) fetchKeys(uri string) (*tokenKeySet, error) {
+	keys := &tokenKeySet{
+		rsaKeys: make(map[string]*rsa.PublicKey),
+		ecKeys:  make(map[string]*ecdsa.PublicKey),
+	}
+	if err := a.updateKeysFromURI(uri, keys.rsaKeys, keys.ecKeys); err != nil {
+		return nil, err
+	}
+	return keys, nil
 }
 
 func (a *defaultTokenKeyProvider) updateKeysFromURI(
@@ -163,13 +322,16 @@ func (a *defaultTokenKeyProvider) updateKeysFromURI(
 	ecKeys map[string]*ecdsa.PublicKey,
 ) (err error) {
 
-	resp, err := http.Get(uri)
+	resp, err := a.httpClient.Get(uri)
 	if err != nil {
 		return err
 	}
 	defer func() {
 		err = multierr.Combine(err, resp.Body.Close())
 	}()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d retrieving token keys from %s", resp.StatusCode, uri)
+	}
 
 	jwks := jose.JSONWebKeySet{}
 	err = json.NewDecoder(resp.Body).Decode(&jwks)
@@ -190,6 +352,33 @@ func (a *defaultTokenKeyProvider) updateKeysFromURI(
 	return nil
 }
 
+// discoverKeysURI returns the JWKS URI of the issuer from its OpenID Connect discovery document.
+func (a *defaultTokenKeyProvider) discoverKeysURI(issuer string) (uri string, err error) {
+	discoveryURI := strings.TrimSuffix(issuer, "/") + oidcDiscoveryPath
+	resp, err := a.httpClient.Get(discoveryURI)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		err = multierr.Combine(err, resp.Body.Close())
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %d retrieving OIDC discovery document from %s", resp.StatusCode, discoveryURI)
+	}
+
+	var document oidcDiscoveryDocument
+	if err := json.NewDecoder(resp.Body).Decode(&document); err != nil {
+		return "", err
+	}
+	if document.Issuer != issuer {
+		return "", fmt.Errorf("OIDC discovery document issuer %q does not match %q", document.Issuer, issuer)
+	}
+	if document.JWKSURI == "" {
+		return "", fmt.Errorf("OIDC discovery document of %s has no jwks_uri", issuer)
+	}
+	return document.JWKSURI, nil
+}
+
 func (a *defaultTokenKeyProvider) HmacKey(alg string, kid string) ([]byte, error) {
 	return nil, fmt.Errorf("unsupported key type HMAC for: %s", alg)
 }
@@ -0,0 +1,294 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/square/go-jose.v2"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	// testIssuer is a local OIDC issuer serving a discovery document and its signing keys
+	testIssuer struct {
+		server       *httptest.Server
+		keysRequests atomic.Int32
+
+		sync.Mutex
+		kid        string
+		privateKey *rsa.PrivateKey
+	}
+
+	oidcSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		issuer *testIssuer
+		config *config.Authorization
+	}
+)
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	issuer := &testIssuer{}
+	issuer.rotateKey(t, "key-1")
+	mux := http.NewServeMux()
+	mux.HandleFunc(oidcDiscoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(oidcDiscoveryDocument{
+			Issuer:  issuer.server.URL,
+			JWKSURI: issuer.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		issuer.keysRequests.Add(1)
+		issuer.Lock()
+		defer issuer.Unlock()
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+			Key:       &issuer.privateKey.PublicKey,
+			KeyID:     issuer.kid,
+			Algorithm: jwt.SigningMethodRS256.Name,
+			Use:       "sig",
+		}}})
+	})
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+func (i *testIssuer) rotateKey(t *testing.T, kid string) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	i.Lock()
+	defer i.Unlock()
+	i.kid = kid
+	i.privateKey = privateKey
+}
+
+func (i *testIssuer) token(t *testing.T, claims jwt.MapClaims) string {
+	i.Lock()
+	defer i.Unlock()
+	if _, ok := claims["iss"]; !ok {
+		claims["iss"] = i.server.URL
+	}
+	claims["exp"] = time.Now().Add(time.Hour).Unix()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = i.kid
+	signed, err := token.SignedString(i.privateKey)
+	require.NoError(t, err)
+	return AddBearer(signed)
+}
+
+// parsedToken returns an RS256 token as passed to the key provider.
+func parsedToken(kid string, issuer string) *jwt.Token {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"iss": issuer})
+	token.Header["kid"] = kid
+	return token
+}
+
+func TestOIDCSuite(t *testing.T) {
+	s := new(oidcSuite)
+	suite.Run(t, s)
+}
+
+func (s *oidcSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.issuer = newTestIssuer(s.T())
+	s.config = &config.Authorization{
+		JWTKeyProvider: config.JWTKeyProvider{RefreshInterval: time.Hour},
+		OIDC: config.OIDC{
+			Issuers: []config.OIDCIssuer{{
+				Issuer:     s.issuer.server.URL,
+				Audiences:  []string{"temporal"},
+				Namespaces: []string{"team-*"},
+			}},
+			SubjectClaim: "email",
+			GroupsClaim:  "realm_access.groups",
+			GroupPermissions: map[string][]string{
+				"deployers": {"team-a:write", "payments:admin"},
+				"operators": {primitives.SystemLocalNamespace + ":admin"},
+			},
+		},
+	}
+}
+
+func (s *oidcSuite) newClaimMapper() (ClaimMapper, *defaultTokenKeyProvider) {
+	keyProvider := NewDefaultTokenKeyProvider(s.config, log.NewNoopLogger())
+	s.T().Cleanup(keyProvider.Close)
+	return NewDefaultJWTClaimMapper(keyProvider, s.config, log.NewNoopLogger()), keyProvider
+}
+
+func (s *oidcSuite) TestDiscovery() {
+	keyProvider := NewDefaultTokenKeyProvider(s.config, log.NewNoopLogger())
+	defer keyProvider.Close()
+	key, err := keyProvider.GetKey(context.Background(), parsedToken("key-1", s.issuer.server.URL))
+	s.NoError(err)
+	s.Equal(&s.issuer.privateKey.PublicKey, key)
+
+	// issuer keys are not used for tokens of other issuers
+	_, err = keyProvider.GetKey(context.Background(), parsedToken("key-1", "https://other.example.com"))
+	s.Error(err)
+	_, err = keyProvider.RsaKey(jwt.SigningMethodRS256.Name, "key-1")
+	s.Error(err)
+}
+
+func (s *oidcSuite) TestKeysOfOtherIssuer() {
+	other := newTestIssuer(s.T())
+	other.rotateKey(s.T(), "other-key")
+	s.config.OIDC.Issuers = append(s.config.OIDC.Issuers, config.OIDCIssuer{Issuer: other.server.URL})
+	claimMapper, _ := s.newClaimMapper()
+
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: other.token(s.T(), jwt.MapClaims{"email": "bob@example.com"})})
+	s.NoError(err)
+	s.Equal("bob@example.com", claims.Subject)
+
+	// a token signed by the other issuer claiming to be from the first issuer
+	token := other.token(s.T(), jwt.MapClaims{"email": "bob@example.com", "aud": "temporal", "iss": s.issuer.server.URL})
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: token})
+	s.ErrorContains(err, "RSA key not found")
+}
+
+func (s *oidcSuite) TestUnavailableIssuer() {
+	unavailable := newTestIssuer(s.T())
+	unavailable.server.Close()
+	s.config.OIDC.Issuers = append(s.config.OIDC.Issuers, config.OIDCIssuer{Issuer: unavailable.server.URL})
+	claimMapper, keyProvider := s.newClaimMapper()
+
+	// the keys of the other issuers are still used
+	token := s.issuer.token(s.T(), jwt.MapClaims{"email": "alice@example.com", "aud": "temporal"})
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: token})
+	s.NoError(err)
+	s.Equal("alice@example.com", claims.Subject)
+
+	// a failed refresh is not rate limited and keeps the keys of the issuers which failed
+	s.True(keyProvider.lastRefresh.IsZero())
+	s.issuer.server.Close()
+	s.Error(keyProvider.refreshKeys())
+	claims, err = claimMapper.GetClaims(&AuthInfo{AuthToken: token})
+	s.NoError(err)
+	s.Equal("alice@example.com", claims.Subject)
+}
+
+func (s *oidcSuite) TestDiscoveryIssuerMismatch() {
+	s.config.OIDC.Issuers[0].Issuer = s.issuer.server.URL + "/"
+	keyProvider := NewDefaultTokenKeyProvider(s.config, log.NewNoopLogger())
+	defer keyProvider.Close()
+	_, err := keyProvider.discoverKeysURI(s.config.OIDC.Issuers[0].Issuer)
+	s.ErrorContains(err, "does not match")
+}
+
+func (s *oidcSuite) TestKeyRotation() {
+	claimMapper, keyProvider := s.newClaimMapper()
+	s.Equal(int32(1), s.issuer.keysRequests.Load())
+
+	s.issuer.rotateKey(s.T(), "key-2")
+	token := s.issuer.token(s.T(), jwt.MapClaims{"email": "alice@example.com", "aud": "temporal"})
+
+	// refreshes for unknown keys are rate limited
+	_, err := claimMapper.GetClaims(&AuthInfo{AuthToken: token})
+	s.Error(err)
+	s.Equal(int32(1), s.issuer.keysRequests.Load())
+
+	keyProvider.lastRefresh = time.Now().Add(-minKeyRefreshInterval)
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: token})
+	s.NoError(err)
+	s.Equal("alice@example.com", claims.Subject)
+	s.Equal(int32(2), s.issuer.keysRequests.Load())
+}
+
+func (s *oidcSuite) TestGroupPermissions() {
+	claimMapper, _ := s.newClaimMapper()
+	token := s.issuer.token(s.T(), jwt.MapClaims{
+		"email":        "alice@example.com",
+		"aud":          []string{"other", "temporal"},
+		"realm_access": map[string]interface{}{"groups": []string{"deployers", "operators", "unknown"}},
+		"permissions":  []string{"team-b:read"},
+	})
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: token})
+	s.NoError(err)
+	s.Equal("alice@example.com", claims.Subject)
+	// the issuer may only grant roles in team-* namespaces
+	s.Equal(RoleUndefined, claims.System)
+	s.Equal(map[string]Role{"team-a": RoleWriter, "team-b": RoleReader}, claims.Namespaces)
+}
+
+func (s *oidcSuite) TestSingleGroup() {
+	s.config.OIDC.Issuers[0].Namespaces = nil
+	claimMapper, _ := s.newClaimMapper()
+	token := s.issuer.token(s.T(), jwt.MapClaims{
+		"email":        "alice@example.com",
+		"aud":          "temporal",
+		"realm_access": map[string]interface{}{"groups": "operators"},
+	})
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: token})
+	s.NoError(err)
+	s.Equal(RoleAdmin, claims.System)
+}
+
+func (s *oidcSuite) TestRejectedTokens() {
+	claimMapper, _ := s.newClaimMapper()
+	testCases := map[string]jwt.MapClaims{
+		"unknown issuer": {"email": "alice@example.com", "aud": "temporal", "iss": "https://other.example.com"},
+		"wrong audience": {"email": "alice@example.com", "aud": "other"},
+		"no subject":     {"sub": "alice", "aud": "temporal"},
+	}
+	for name, tokenClaims := range testCases {
+		s.Run(name, func() {
+			_, err := claimMapper.GetClaims(&AuthInfo{AuthToken: s.issuer.token(s.T(), tokenClaims)})
+			s.Error(err)
+		})
+	}
+}
+
+func TestLookupClaim(t *testing.T) {
+	claims := jwt.MapClaims{
+		"https://temporal.io/permissions": []interface{}{"default:read"},
+		"realm_access":                    map[string]interface{}{"roles": []interface{}{"admin"}},
+	}
+	value, ok := lookupClaim(claims, "https://temporal.io/permissions")
+	require.True(t, ok)
+	require.Equal(t, []interface{}{"default:read"}, value)
+
+	value, ok = lookupClaim(claims, "realm_access.roles")
+	require.True(t, ok)
+	require.Equal(t, []interface{}{"admin"}, value)
+
+	_, ok = lookupClaim(claims, "realm_access.groups")
+	require.False(t, ok)
+	_, ok = lookupClaim(claims, "realm_access.roles.admin")
+	require.False(t, ok)
+}
@@ -139,29 +139,62 @@ func (a *interceptor) Interceptor(
 			namespace = requestWithNamespace.GetNamespace()
 		}
 
+		callTarget := &CallTarget{
+			Namespace:            namespace,
+			APIName:              info.FullMethod,
+			Request:              req,
+			WorkflowTypeResolver: a.workflowTypeResolver,
+		}
+		startTime := time.Now().UTC()
 		handler := a.getMetricsHandler(metrics.AuthorizationScope, namespace)
-		result, err := a.authorize(ctx, claims, &CallTarget{
-			Namespace: namespace,
-			APIName:   info.FullMethod,
-			Request:   req,
-		}, handler)
+		result, err := a.authorize(ctx, claims, callTarget, handler)
+		latency := time.Since(startTime)
 		if err != nil {
 			handler.Counter(metrics.ServiceErrAuthorizeFailedCounter.Name()).Record(1)
 			a.logAuthError(err)
+			a.audit(claims, callTarget, AuditDecisionError, err.Error(), startTime, latency)
 			return nil, errUnauthorized // return a generic error to the caller without disclosing details
 		}
 		if result.Decision != DecisionAllow {
 			handler.Counter(metrics.ServiceErrUnauthorizedCounter.Name()).Record(1)
+			a.audit(claims, callTarget, AuditDecisionDeny, result.Reason, startTime, latency)
 			// if a reason is included in the result, include it in the error message
 			if result.Reason != "" {
 				return nil, serviceerror.NewPermissionDenied(RequestUnauthorized, result.Reason)
 			}
 			return nil, errUnauthorized // return a generic error to the caller without disclosing details
 		}
+		a.audit(claims, callTarget, AuditDecisionAllow, result.Reason, startTime, latency)
 	}
 	return handler(ctx, req)
 }
 
+func (a *interceptor) audit(
+	claims *Claims,
+	callTarget *CallTarget,
+	decision string,
+	reason string,
+	startTime time.Time,
+	latency time.Duration,
+) {
+	if a.auditLogger == nil {
+		return
+	}
+	record := &AuditRecord{
+		Time:       startTime,
+		APIName:    callTarget.APIName,
+		Namespace:  callTarget.Namespace,
+		WorkflowID: auditWorkflowID(callTarget.Request),
+		Decision:   decision,
+		Reason:     reason,
+		Latency:    latency,
+	}
+	if claims != nil {
+		record.Subject = claims.Subject
+	}
+	a.auditLogger.Log(record)
+}
+
 func (a *interceptor) authorize(
 	ctx context.Context,
 	claims *Claims,
@@ -186,6 +219,9 @@ type interceptor struct {
 	audienceGetter      JWTAudienceMapper
 	authHeaderName      string
 	authExtraHeaderName string
+	auditLogger         AuditLogger
+	// workflowTypeResolver is optional
+	workflowTypeResolver WorkflowTypeResolver
 }
 
 // NewAuthorizationInterceptor creates an authorization interceptor and return a func that points to its Interceptor method
@@ -197,15 +233,19 @@ func NewAuthorizationInterceptor(
 	audienceGetter JWTAudienceMapper,
 	authHeaderName string,
 	authExtraHeaderName string,
+	auditLogger AuditLogger,
+	workflowTypeResolver WorkflowTypeResolver,
 ) grpc.UnaryServerInterceptor {
 	return (&interceptor{
-		claimMapper:         claimMapper,
-		authorizer:          authorizer,
-		metricsHandler:      metricsHandler,
-		logger:              logger,
-		audienceGetter:      audienceGetter,
-		authHeaderName:      util.Coalesce(authHeaderName, defaultAuthHeaderName),
-		authExtraHeaderName: util.Coalesce(authExtraHeaderName, defaultAuthExtraHeaderName),
+		claimMapper:          claimMapper,
+		authorizer:           authorizer,
+		metricsHandler:       metricsHandler,
+		logger:               logger,
+		audienceGetter:       audienceGetter,
+		authHeaderName:       util.Coalesce(authHeaderName, defaultAuthHeaderName),
+		authExtraHeaderName:  util.Coalesce(authExtraHeaderName, defaultAuthExtraHeaderName),
+		auditLogger:          auditLogger,
+		workflowTypeResolver: workflowTypeResolver,
 	}).Interceptor
 }
 
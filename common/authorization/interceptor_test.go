@@ -31,6 +31,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/api/workflowservicemock/v1"
 	"google.golang.org/grpc"
@@ -92,6 +93,8 @@ func (s *authorizerInterceptorSuite) SetupTest() {
 		nil,
 		"",
 		"",
+		nil,
+		nil,
 	)
 	s.handler = func(ctx context.Context, req interface{}) (interface{}, error) { return true, nil }
 }
@@ -138,6 +141,49 @@ func (s *authorizerInterceptorSuite) TestAuthorizationFailed() {
 	s.Error(err)
 }
 
+func (s *authorizerInterceptorSuite) TestAudit() {
+	auditLogger := &recordingAuditLogger{}
+	interceptor := NewAuthorizationInterceptor(
+		s.mockClaimMapper,
+		s.mockAuthorizer,
+		s.mockMetricsHandler,
+		log.NewNoopLogger(),
+		nil,
+		"",
+		"",
+		auditLogger,
+		nil,
+	)
+	admin := &Claims{Subject: "admin", System: RoleAdmin}
+	ctx := metadata.NewIncomingContext(ctx, metadata.Pairs(defaultAuthHeaderName, "token"))
+	s.mockClaimMapper.EXPECT().GetClaims(gomock.Any()).Return(admin, nil).Times(2)
+	signalRequest := &workflowservice.SignalWorkflowExecutionRequest{
+		Namespace:         testNamespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "wf-id"},
+	}
+	signalInfo := &grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution"}
+
+	s.mockAuthorizer.EXPECT().Authorize(gomock.Any(), admin, gomock.Any()).Return(Result{Decision: DecisionAllow}, nil)
+	_, err := interceptor(ctx, signalRequest, signalInfo, s.handler)
+	s.NoError(err)
+
+	s.mockAuthorizer.EXPECT().Authorize(gomock.Any(), admin, gomock.Any()).Return(Result{Decision: DecisionDeny, Reason: "no"}, nil)
+	s.mockMetricsHandler.EXPECT().Counter(metrics.ServiceErrUnauthorizedCounter.Name()).Return(metrics.NoopCounterMetricFunc)
+	_, err = interceptor(ctx, signalRequest, signalInfo, s.handler)
+	s.Error(err)
+
+	s.Len(auditLogger.records, 2)
+	for i, decision := range []string{AuditDecisionAllow, AuditDecisionDeny} {
+		record := auditLogger.records[i]
+		s.Equal(decision, record.Decision)
+		s.Equal("admin", record.Subject)
+		s.Equal(signalInfo.FullMethod, record.APIName)
+		s.Equal(testNamespace, record.Namespace)
+		s.Equal("wf-id", record.WorkflowID)
+	}
+	s.Equal("no", auditLogger.records[1].Reason)
+}
+
 func (s *authorizerInterceptorSuite) TestNoopClaimMapperWithoutTLS() {
 	admin := &Claims{System: RoleAdmin}
 	s.mockAuthorizer.EXPECT().Authorize(gomock.Any(), admin, describeNamespaceTarget).
@@ -156,6 +202,8 @@ func (s *authorizerInterceptorSuite) TestNoopClaimMapperWithoutTLS() {
 		nil,
 		"",
 		"",
+		nil,
+		nil,
 	)
 	_, err := interceptor(ctx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
 	s.NoError(err)
@@ -170,6 +218,8 @@ func (s *authorizerInterceptorSuite) TestAlternateHeaders() {
 		nil,
 		"custom-header",
 		"custom-extra-header",
+		nil,
+		nil,
 	)
 
 	cases := []struct {
@@ -211,3 +261,11 @@ func (s *authorizerInterceptorSuite) TestAlternateHeaders() {
 		s.NoError(err)
 	}
 }
+
+type recordingAuditLogger struct {
+	records []*AuditRecord
+}
+
+func (l *recordingAuditLogger) Log(record *AuditRecord) {
+	l.records = append(l.records, record)
+}
@@ -0,0 +1,473 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"gopkg.in/yaml.v3"
+
+	"go.temporal.io/server/common/api"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	// EffectAllow allows calls matched by a policy rule
+	EffectAllow = "allow"
+	// EffectDeny denies calls matched by a policy rule
+	EffectDeny = "deny"
+
+	defaultPolicyRefreshInterval = 10 * time.Second
+)
+
+type (
+	// Policy is the declarative policy evaluated by the policy authorizer.
+	//
+	// Rules are evaluated against every call. A matching deny rule always wins over a matching
+	// allow rule. When no rule matches, DefaultEffect applies (deny if not set).
+	// All conditions within a rule must match; an empty condition matches anything.
+	// Patterns support '*' (any sequence of characters) and '?' (any single character).
+	Policy struct {
+		DefaultEffect string       `yaml:"defaultEffect"`
+		Rules         []PolicyRule `yaml:"rules"`
+	}
+
+	// PolicyRule is a single allow or deny rule of a Policy.
+	PolicyRule struct {
+		// Name identifies the rule in logs and deny reasons.
+		Name   string `yaml:"name"`
+		Effect string `yaml:"effect"`
+		// APIs match either the full API name or just the method name, e.g. "Signal*".
+		APIs       []string `yaml:"apis"`
+		Namespaces []string `yaml:"namespaces"`
+		// TaskQueues only match requests which carry a task queue.
+		TaskQueues []string `yaml:"taskQueues"`
+		// WorkflowTypes match requests which carry a workflow type, such as StartWorkflowExecution,
+		// and requests which target an existing execution, such as SignalWorkflowExecution.
+		// For the latter the type is looked up from the execution, only when all other conditions
+		// of the rule match.
+		WorkflowTypes []string `yaml:"workflowTypes"`
+		Subjects      []string `yaml:"subjects"`
+		// Roles match the caller's role for the target namespace: worker, reader, writer or admin.
+		Roles []string `yaml:"roles"`
+		// Claims match string values of the claims extensions, when they are a map.
+		Claims map[string]string `yaml:"claims"`
+	}
+
+	policyAuthorizer struct {
+		file            string
+		refreshInterval time.Duration
+		logger          log.Logger
+
+		policy  atomic.Pointer[compiledPolicy]
+		modTime time.Time
+
+		stopOnce sync.Once
+		stopCh   chan struct{}
+	}
+
+	compiledPolicy struct {
+		defaultEffect string
+		rules         []compiledRule
+	}
+
+	compiledRule struct {
+		name          string
+		effect        string
+		apis          []*regexp.Regexp
+		namespaces    []*regexp.Regexp
+		taskQueues    []*regexp.Regexp
+		workflowTypes []*regexp.Regexp
+		subjects      []*regexp.Regexp
+		roles         Role
+		claims        map[string]*regexp.Regexp
+	}
+
+	hasTaskQueue interface {
+		GetTaskQueue() *taskqueuepb.TaskQueue
+	}
+
+	hasTaskQueueName interface {
+		GetTaskQueue() string
+	}
+
+	hasWorkflowType interface {
+		GetWorkflowType() *commonpb.WorkflowType
+	}
+
+	hasWorkflowTypeName interface {
+		GetWorkflowType() string
+	}
+)
+
+var (
+	errPolicyFileNotSet = errors.New("policy file is not set for policy authorizer")
+
+	policyRoles = map[string]Role{
+		"worker": RoleWorker,
+		"reader": RoleReader,
+		"writer": RoleWriter,
+		"admin":  RoleAdmin,
+	}
+)
+
+var _ Authorizer = (*policyAuthorizer)(nil)
+
+// NewPolicyAuthorizer creates an authorizer driven by the policy file in cfg.
+// The policy file is reloaded when it changes. An invalid policy fails creation,
+// while an invalid update is logged and the previous policy is kept.
+func NewPolicyAuthorizer(cfg *config.AuthorizationPolicy, logger log.Logger) (*policyAuthorizer, error) {
+	if cfg.File == "" {
+		return nil, errPolicyFileNotSet
+	}
+	refreshInterval := cfg.RefreshInterval
+	if refreshInterval <= 0 {
+		refreshInterval = defaultPolicyRefreshInterval
+	}
+	a := &policyAuthorizer{
+		file:            cfg.File,
+		refreshInterval: refreshInterval,
+		logger:          logger,
+		stopCh:          make(chan struct{}),
+	}
+	if _, err := a.reload(); err != nil {
+		return nil, err
+	}
+	go a.refreshLoop()
+	return a, nil
+}
+
+// Stop stops reloading the policy file.
+func (a *policyAuthorizer) Stop() {
+	a.stopOnce.Do(func() { close(a.stopCh) })
+}
+
+// Authorize evaluates the current policy for the call.
+// Health check APIs are allowed to everyone.
+// Looking up the workflow type of the target execution fails the call, unless the execution does not exist.
+func (a *policyAuthorizer) Authorize(ctx context.Context, claims *Claims, target *CallTarget) (Result, error) {
+	if IsHealthCheckAPI(target.APIName) {
+		return resultAllow, nil
+	}
+
+	attrs := newPolicyAttributes(ctx, claims, target)
+	policy := a.policy.Load()
+
+	var allowed bool
+	for i := range policy.rules {
+		rule := &policy.rules[i]
+		matches, err := rule.matches(attrs)
+		if err != nil {
+			return Result{}, err
+		}
+		if !matches {
+			continue
+		}
+		if rule.effect == EffectDeny {
+			return a.deny(attrs, rule.name), nil
+		}
+		allowed = true
+	}
+	if allowed || policy.defaultEffect == EffectAllow {
+		return resultAllow, nil
+	}
+	return a.deny(attrs, ""), nil
+}
+
+func (a *policyAuthorizer) deny(attrs *policyAttributes, ruleName string) Result {
+	reason := "no policy rule allows the call"
+	if ruleName != "" {
+		reason = fmt.Sprintf("denied by policy rule %q", ruleName)
+	}
+	a.logger.Info("Authorization denied by policy",
+		tag.NewStringTag("policy-rule", ruleName),
+		tag.NewStringTag("subject", attrs.subject),
+		tag.NewStringTag("api-name", attrs.apiName),
+		tag.WorkflowNamespace(attrs.namespace),
+		tag.WorkflowTaskQueueName(attrs.taskQueue),
+		tag.WorkflowType(attrs.workflowType),
+	)
+	return Result{Decision: DecisionDeny, Reason: reason}
+}
+
+func (a *policyAuthorizer) refreshLoop() {
+	ticker := time.NewTicker(a.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.stopCh:
+			return
+		case <-ticker.C:
+		}
+		reloaded, err := a.reload()
+		if err != nil {
+			a.logger.Error("Unable to reload authorization policy, keeping previous policy",
+				tag.NewStringTag("policy-file", a.file), tag.Error(err))
+			continue
+		}
+		if reloaded {
+			a.logger.Info("Reloaded authorization policy", tag.NewStringTag("policy-file", a.file))
+		}
+	}
+}
+
+// reload loads the policy file if it has changed since the last successful load.
+func (a *policyAuthorizer) reload() (bool, error) {
+	info, err := os.Stat(a.file)
+	if err != nil {
+		return false, fmt.Errorf("unable to stat policy file: %w", err)
+	}
+	if a.policy.Load() != nil && info.ModTime().Equal(a.modTime) {
+		return false, nil
+	}
+	content, err := os.ReadFile(a.file)
+	if err != nil {
+		return false, fmt.Errorf("unable to read policy file: %w", err)
+	}
+	policy, err := ParsePolicy(content)
+	if err != nil {
+		return false, err
+	}
+	compiled, err := compilePolicy(policy)
+	if err != nil {
+		return false, err
+	}
+	a.policy.Store(compiled)
+	a.modTime = info.ModTime()
+	return true, nil
+}
+
+// ParsePolicy parses a YAML policy.
+func ParsePolicy(content []byte) (*Policy, error) {
+	var policy Policy
+	if err := yaml.Unmarshal(content, &policy); err != nil {
+		return nil, fmt.Errorf("unable to decode policy: %w", err)
+	}
+	return &policy, nil
+}
+
+func compilePolicy(policy *Policy) (*compiledPolicy, error) {
+	defaultEffect := strings.ToLower(policy.DefaultEffect)
+	switch defaultEffect {
+	case "":
+		defaultEffect = EffectDeny
+	case EffectAllow, EffectDeny:
+	default:
+		return nil, fmt.Errorf("invalid policy default effect: %q", policy.DefaultEffect)
+	}
+
+	compiled := &compiledPolicy{
+		defaultEffect: defaultEffect,
+		rules:         make([]compiledRule, 0, len(policy.Rules)),
+	}
+	for i, rule := range policy.Rules {
+		name := rule.Name
+		if name == "" {
+			name = fmt.Sprintf("rules[%d]", i)
+		}
+		c, err := compileRule(name, rule)
+		if err != nil {
+			return nil, fmt.Errorf("invalid policy rule %q: %w", name, err)
+		}
+		compiled.rules = append(compiled.rules, c)
+	}
+	return compiled, nil
+}
+
+func compileRule(name string, rule PolicyRule) (compiledRule, error) {
+	c := compiledRule{
+		name:          name,
+		effect:        strings.ToLower(rule.Effect),
+		apis:          compilePatterns(rule.APIs),
+		namespaces:    compilePatterns(rule.Namespaces),
+		taskQueues:    compilePatterns(rule.TaskQueues),
+		workflowTypes: compilePatterns(rule.WorkflowTypes),
+		subjects:      compilePatterns(rule.Subjects),
+	}
+	if c.effect != EffectAllow && c.effect != EffectDeny {
+		return c, fmt.Errorf("invalid effect: %q", rule.Effect)
+	}
+	for _, r := range rule.Roles {
+		role, ok := policyRoles[strings.ToLower(r)]
+		if !ok {
+			return c, fmt.Errorf("invalid role: %q", r)
+		}
+		c.roles |= role
+	}
+	if len(rule.Claims) > 0 {
+		c.claims = make(map[string]*regexp.Regexp, len(rule.Claims))
+		for k, v := range rule.Claims {
+			c.claims[k] = compilePattern(v)
+		}
+	}
+	return c, nil
+}
+
+func compilePatterns(patterns []string) []*regexp.Regexp {
+	if len(patterns) == 0 {
+		return nil
+	}
+	result := make([]*regexp.Regexp, len(patterns))
+	for i, p := range patterns {
+		result[i] = compilePattern(p)
+	}
+	return result
+}
+
+func compilePattern(pattern string) *regexp.Regexp {
+	expr := regexp.QuoteMeta(pattern)
+	expr = strings.ReplaceAll(expr, `\*`, ".*")
+	expr = strings.ReplaceAll(expr, `\?`, ".")
+	return regexp.MustCompile("^" + expr + "$")
+}
+
+func (r *compiledRule) matches(attrs *policyAttributes) (bool, error) {
+	if len(r.apis) > 0 && !matchAny(r.apis, attrs.apiName) && !matchAny(r.apis, attrs.methodName) {
+		return false, nil
+	}
+	if len(r.namespaces) > 0 && !matchAny(r.namespaces, attrs.namespace) {
+		return false, nil
+	}
+	if len(r.taskQueues) > 0 && (attrs.taskQueue == "" || !matchAny(r.taskQueues, attrs.taskQueue)) {
+		return false, nil
+	}
+	if len(r.subjects) > 0 && (attrs.claims == nil || !matchAny(r.subjects, attrs.subject)) {
+		return false, nil
+	}
+	if r.roles != RoleUndefined && r.roles&attrs.role == 0 {
+		return false, nil
+	}
+	for k, pattern := range r.claims {
+		value, ok := attrs.extensions[k]
+		if !ok || !pattern.MatchString(value) {
+			return false, nil
+		}
+	}
+	// checked last as it may need to look up the workflow type of the target execution
+	if len(r.workflowTypes) > 0 {
+		workflowType, err := attrs.getWorkflowType()
+		if err != nil {
+			return false, err
+		}
+		if workflowType == "" || !matchAny(r.workflowTypes, workflowType) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func matchAny(patterns []*regexp.Regexp, value string) bool {
+	for _, p := range patterns {
+		if p.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+type policyAttributes struct {
+	ctx          context.Context
+	claims       *Claims
+	subject      string
+	role         Role
+	extensions   map[string]string
+	apiName      string
+	methodName   string
+	namespace    string
+	taskQueue    string
+	workflowType string
+
+	// set when the workflow type has to be looked up from the target execution
+	workflowTypeResolver WorkflowTypeResolver
+	execution            *commonpb.WorkflowExecution
+}
+
+func newPolicyAttributes(ctx context.Context, claims *Claims, target *CallTarget) *policyAttributes {
+	attrs := &policyAttributes{
+		ctx:        ctx,
+		claims:     claims,
+		apiName:    target.APIName,
+		methodName: api.MethodName(target.APIName),
+		namespace:  target.Namespace,
+	}
+	if claims != nil {
+		attrs.subject = claims.Subject
+		// system-level roles apply across all namespaces
+		attrs.role = claims.System | claims.Namespaces[target.Namespace]
+		if ext, ok := claims.Extensions.(map[string]interface{}); ok {
+			attrs.extensions = make(map[string]string, len(ext))
+			for k, v := range ext {
+				if s, ok := v.(string); ok {
+					attrs.extensions[k] = s
+				}
+			}
+		}
+	}
+
+	switch r := target.Request.(type) {
+	case hasTaskQueue:
+		attrs.taskQueue = r.GetTaskQueue().GetName()
+	case hasTaskQueueName:
+		attrs.taskQueue = r.GetTaskQueue()
+	}
+	switch r := target.Request.(type) {
+	case hasWorkflowType:
+		attrs.workflowType = r.GetWorkflowType().GetName()
+	case hasWorkflowTypeName:
+		attrs.workflowType = r.GetWorkflowType()
+	}
+	if attrs.workflowType == "" && target.WorkflowTypeResolver != nil {
+		if execution := targetExecution(target.Request); execution.GetWorkflowId() != "" {
+			attrs.workflowTypeResolver = target.WorkflowTypeResolver
+			attrs.execution = execution
+		}
+	}
+	return attrs
+}
+
+// getWorkflowType returns the workflow type of the request, looking it up from the target execution
+// on first use if needed.
+func (attrs *policyAttributes) getWorkflowType() (string, error) {
+	if attrs.workflowTypeResolver == nil {
+		return attrs.workflowType, nil
+	}
+	workflowType, err := attrs.workflowTypeResolver.ResolveWorkflowType(attrs.ctx, attrs.namespace, attrs.execution)
+	if err != nil {
+		return "", err
+	}
+	attrs.workflowType = workflowType
+	attrs.workflowTypeResolver = nil
+	return workflowType, nil
+}
@@ -0,0 +1,295 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	testPolicy = `
+rules:
+  - name: ci-deploy
+    effect: allow
+    subjects: ["ci-*"]
+    apis: ["SignalWithStartWorkflowExecution", "StartWorkflowExecution"]
+    namespaces: ["prod-*"]
+    workflowTypes: ["Deploy*"]
+  - name: ci-signal
+    effect: allow
+    subjects: ["ci-*"]
+    apis: ["SignalWorkflowExecution"]
+    workflowTypes: ["Deploy*"]
+  - name: workers
+    effect: allow
+    roles: [worker]
+    apis: ["Poll*TaskQueue"]
+    taskQueues: ["deploy-tq"]
+  - name: no-deletes
+    effect: deny
+    apis: ["/temporal.api.workflowservice.v1.WorkflowService/DeleteWorkflowExecution"]
+  - name: ops
+    effect: allow
+    claims:
+      team: ops
+`
+	signalWithStartAPI = "/temporal.api.workflowservice.v1.WorkflowService/SignalWithStartWorkflowExecution"
+	pollWorkflowAPI    = "/temporal.api.workflowservice.v1.WorkflowService/PollWorkflowTaskQueue"
+	deleteWorkflowAPI  = "/temporal.api.workflowservice.v1.WorkflowService/DeleteWorkflowExecution"
+	signalWorkflowAPI  = "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution"
+)
+
+type (
+	policyAuthorizerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		policyFile string
+		authorizer *policyAuthorizer
+	}
+
+	testWorkflowTypeResolver struct {
+		workflowTypes map[string]string
+		err           error
+		calls         int
+	}
+)
+
+func (r *testWorkflowTypeResolver) ResolveWorkflowType(
+	_ context.Context,
+	_ string,
+	execution *commonpb.WorkflowExecution,
+) (string, error) {
+	r.calls++
+	return r.workflowTypes[execution.GetWorkflowId()], r.err
+}
+
+func TestPolicyAuthorizerSuite(t *testing.T) {
+	s := new(policyAuthorizerSuite)
+	suite.Run(t, s)
+}
+
+func (s *policyAuthorizerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.policyFile = filepath.Join(s.T().TempDir(), "policy.yaml")
+	s.writePolicy(testPolicy, time.Now())
+
+	var err error
+	s.authorizer, err = NewPolicyAuthorizer(&config.AuthorizationPolicy{
+		File:            s.policyFile,
+		RefreshInterval: time.Hour,
+	}, log.NewNoopLogger())
+	s.NoError(err)
+}
+
+func (s *policyAuthorizerSuite) TearDownTest() {
+	s.authorizer.Stop()
+}
+
+func (s *policyAuthorizerSuite) writePolicy(policy string, modTime time.Time) {
+	s.NoError(os.WriteFile(s.policyFile, []byte(policy), 0644))
+	s.NoError(os.Chtimes(s.policyFile, modTime, modTime))
+}
+
+func (s *policyAuthorizerSuite) signalWithStart(namespace string, workflowType string) *CallTarget {
+	return &CallTarget{
+		APIName:   signalWithStartAPI,
+		Namespace: namespace,
+		Request: &workflowservice.SignalWithStartWorkflowExecutionRequest{
+			Namespace:    namespace,
+			WorkflowType: &commonpb.WorkflowType{Name: workflowType},
+		},
+	}
+}
+
+func (s *policyAuthorizerSuite) TestAllowMatchingRule() {
+	result, err := s.authorizer.Authorize(context.Background(), &Claims{Subject: "ci-runner"}, s.signalWithStart("prod-east", "DeployService"))
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *policyAuthorizerSuite) TestDenyWhenNoRuleMatches() {
+	testCases := []struct {
+		name   string
+		claims *Claims
+		target *CallTarget
+	}{
+		{"workflow type", &Claims{Subject: "ci-runner"}, s.signalWithStart("prod-east", "Cleanup")},
+		{"namespace", &Claims{Subject: "ci-runner"}, s.signalWithStart("staging", "DeployService")},
+		{"subject", &Claims{Subject: "alice"}, s.signalWithStart("prod-east", "DeployService")},
+		{"no claims", nil, s.signalWithStart("prod-east", "DeployService")},
+		{"missing workflow type", &Claims{Subject: "ci-runner"}, &CallTarget{
+			APIName:   "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution",
+			Namespace: "prod-east",
+			Request:   &workflowservice.SignalWorkflowExecutionRequest{Namespace: "prod-east"},
+		}},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			result, err := s.authorizer.Authorize(context.Background(), tc.claims, tc.target)
+			s.NoError(err)
+			s.Equal(DecisionDeny, result.Decision)
+			s.Equal("no policy rule allows the call", result.Reason)
+		})
+	}
+}
+
+func (s *policyAuthorizerSuite) TestWorkflowTypeOfTargetExecution() {
+	resolver := &testWorkflowTypeResolver{workflowTypes: map[string]string{
+		"deploy-1":  "DeployService",
+		"cleanup-1": "Cleanup",
+	}}
+	signal := func(workflowID string) *CallTarget {
+		return &CallTarget{
+			APIName:   signalWorkflowAPI,
+			Namespace: "prod-east",
+			Request: &workflowservice.SignalWorkflowExecutionRequest{
+				Namespace:         "prod-east",
+				WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: workflowID},
+			},
+			WorkflowTypeResolver: resolver,
+		}
+	}
+	ci := &Claims{Subject: "ci-runner"}
+
+	result, err := s.authorizer.Authorize(context.Background(), ci, signal("deploy-1"))
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+	s.Equal(1, resolver.calls)
+
+	result, err = s.authorizer.Authorize(context.Background(), ci, signal("cleanup-1"))
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	// missing execution has no workflow type
+	result, err = s.authorizer.Authorize(context.Background(), ci, signal("missing"))
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	// workflow type is not looked up unless all other conditions of a rule match
+	resolver.calls = 0
+	result, err = s.authorizer.Authorize(context.Background(), &Claims{Subject: "alice"}, signal("deploy-1"))
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+	s.Zero(resolver.calls)
+
+	resolver.err = errors.New("unavailable")
+	_, err = s.authorizer.Authorize(context.Background(), ci, signal("deploy-1"))
+	s.ErrorIs(err, resolver.err)
+}
+
+func (s *policyAuthorizerSuite) TestTaskQueueAndRole() {
+	target := &CallTarget{
+		APIName:   pollWorkflowAPI,
+		Namespace: "prod-east",
+		Request: &workflowservice.PollWorkflowTaskQueueRequest{
+			Namespace: "prod-east",
+			TaskQueue: &taskqueuepb.TaskQueue{Name: "deploy-tq"},
+		},
+	}
+	worker := &Claims{Namespaces: map[string]Role{"prod-east": RoleWorker}}
+	result, err := s.authorizer.Authorize(context.Background(), worker, target)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	reader := &Claims{Namespaces: map[string]Role{"prod-east": RoleReader}}
+	result, err = s.authorizer.Authorize(context.Background(), reader, target)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *policyAuthorizerSuite) TestDenyOverridesAllow() {
+	claims := &Claims{Extensions: map[string]interface{}{"team": "ops"}}
+	result, err := s.authorizer.Authorize(context.Background(), claims, s.signalWithStart("prod-east", "Cleanup"))
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	result, err = s.authorizer.Authorize(context.Background(), claims, &CallTarget{APIName: deleteWorkflowAPI, Namespace: "prod-east"})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+	s.Equal(`denied by policy rule "no-deletes"`, result.Reason)
+}
+
+func (s *policyAuthorizerSuite) TestHealthCheckAllowed() {
+	result, err := s.authorizer.Authorize(context.Background(), nil, &CallTarget{APIName: "/grpc.health.v1.Health/Check"})
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *policyAuthorizerSuite) TestReload() {
+	claims := &Claims{Subject: "alice"}
+	target := s.signalWithStart("staging", "Cleanup")
+
+	s.writePolicy("defaultEffect: allow\n", time.Now().Add(time.Minute))
+	reloaded, err := s.authorizer.reload()
+	s.NoError(err)
+	s.True(reloaded)
+	result, err := s.authorizer.Authorize(context.Background(), claims, target)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	// unchanged file is not reloaded
+	reloaded, err = s.authorizer.reload()
+	s.NoError(err)
+	s.False(reloaded)
+
+	// invalid policy keeps the previous one
+	s.writePolicy("rules:\n  - effect: maybe\n", time.Now().Add(2*time.Minute))
+	_, err = s.authorizer.reload()
+	s.ErrorContains(err, `invalid policy rule "rules[0]"`)
+	result, err = s.authorizer.Authorize(context.Background(), claims, target)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *policyAuthorizerSuite) TestInvalidPolicy() {
+	testCases := map[string]string{
+		"default effect": "defaultEffect: maybe\n",
+		"role":           "rules:\n  - effect: allow\n    roles: [owner]\n",
+		"yaml":           "rules: {",
+	}
+	for name, policy := range testCases {
+		s.Run(name, func() {
+			s.writePolicy(policy, time.Now())
+			_, err := NewPolicyAuthorizer(&config.AuthorizationPolicy{File: s.policyFile}, log.NewNoopLogger())
+			s.Error(err)
+		})
+	}
+
+	_, err := NewPolicyAuthorizer(&config.AuthorizationPolicy{}, log.NewNoopLogger())
+	s.ErrorIs(err, errPolicyFileNotSet)
+}
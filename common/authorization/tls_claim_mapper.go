@@ -0,0 +1,203 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"regexp"
+
+	"go.temporal.io/server/common/config"
+)
+
+const spiffeScheme = "spiffe"
+
+type (
+	// tlsClaimMapper derives claims from the verified client certificate
+	tlsClaimMapper struct {
+		rules []tlsClaimMappingRule
+	}
+
+	tlsClaimMappingRule struct {
+		commonName         *regexp.Regexp
+		organization       *regexp.Regexp
+		organizationalUnit *regexp.Regexp
+		dnsName            *regexp.Regexp
+		uri                *regexp.Regexp
+		permissions        []string
+	}
+
+	// compositeClaimMapper merges the claims of several claim mappers, so that a caller
+	// may authenticate with any of their credentials
+	compositeClaimMapper struct {
+		claimMappers []ClaimMapper
+	}
+)
+
+var _ ClaimMapper = (*tlsClaimMapper)(nil)
+var _ ClaimMapper = (*compositeClaimMapper)(nil)
+var _ ClaimMapperWithAuthInfoRequired = (*compositeClaimMapper)(nil)
+
+// NewTLSClaimMapper creates a claim mapper granting the permissions of every rule matching the client certificate.
+// The subject is the SPIFFE ID of the certificate if it has one, otherwise its common name.
+func NewTLSClaimMapper(cfg *config.TLSClaimMapper) (ClaimMapper, error) {
+	rules := make([]tlsClaimMappingRule, len(cfg.Rules))
+	for i, rule := range cfg.Rules {
+		for _, permission := range rule.Permissions {
+			if !addPermission(permission, &Claims{}) {
+				return nil, fmt.Errorf("invalid permission %q in TLS claim mapping rule %d", permission, i)
+			}
+		}
+		rules[i] = tlsClaimMappingRule{
+			commonName:         compileOptionalPattern(rule.CommonName),
+			organization:       compileOptionalPattern(rule.Organization),
+			organizationalUnit: compileOptionalPattern(rule.OrganizationalUnit),
+			dnsName:            compileOptionalPattern(rule.DNSName),
+			uri:                compileOptionalPattern(rule.URI),
+			permissions:        rule.Permissions,
+		}
+	}
+	return &tlsClaimMapper{rules: rules}, nil
+}
+
+func (m *tlsClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	claims := Claims{}
+
+	var cert *x509.Certificate
+	subject := authInfo.TLSSubject
+	if cert = PeerCert(authInfo.TLSConnection); cert != nil {
+		subject = &cert.Subject
+	}
+	if subject == nil {
+		return &claims, nil
+	}
+
+	claims.Subject = subject.CommonName
+	if cert != nil {
+		for _, uri := range cert.URIs {
+			if uri.Scheme == spiffeScheme {
+				claims.Subject = uri.String()
+				break
+			}
+		}
+	}
+	for i := range m.rules {
+		rule := &m.rules[i]
+		if !rule.matches(subject, cert) {
+			continue
+		}
+		for _, permission := range rule.permissions {
+			addPermission(permission, &claims)
+		}
+	}
+	return &claims, nil
+}
+
+func (r *tlsClaimMappingRule) matches(subject *pkix.Name, cert *x509.Certificate) bool {
+	if r.commonName != nil && !r.commonName.MatchString(subject.CommonName) {
+		return false
+	}
+	if r.organization != nil && !matchAnyValue(r.organization, subject.Organization) {
+		return false
+	}
+	if r.organizationalUnit != nil && !matchAnyValue(r.organizationalUnit, subject.OrganizationalUnit) {
+		return false
+	}
+	if r.dnsName != nil && (cert == nil || !matchAnyValue(r.dnsName, cert.DNSNames)) {
+		return false
+	}
+	if r.uri != nil {
+		if cert == nil {
+			return false
+		}
+		uris := make([]string, len(cert.URIs))
+		for i, uri := range cert.URIs {
+			uris[i] = uri.String()
+		}
+		if !matchAnyValue(r.uri, uris) {
+			return false
+		}
+	}
+	return true
+}
+
+func compileOptionalPattern(pattern string) *regexp.Regexp {
+	if pattern == "" {
+		return nil
+	}
+	return compilePattern(pattern)
+}
+
+func matchAnyValue(pattern *regexp.Regexp, values []string) bool {
+	for _, v := range values {
+		if pattern.MatchString(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewCompositeClaimMapper creates a claim mapper combining the roles granted by all claim mappers.
+// The subject is taken from the first claim mapper returning one. An error of any claim mapper,
+// e.g. for an invalid token, fails the call.
+func NewCompositeClaimMapper(claimMappers ...ClaimMapper) ClaimMapper {
+	return &compositeClaimMapper{claimMappers: claimMappers}
+}
+
+func (m *compositeClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	claims := Claims{}
+	for _, claimMapper := range m.claimMappers {
+		mapped, err := claimMapper.GetClaims(authInfo)
+		if err != nil {
+			return nil, err
+		}
+		if mapped == nil {
+			continue
+		}
+		if claims.Subject == "" {
+			claims.Subject = mapped.Subject
+		}
+		if claims.Extensions == nil {
+			claims.Extensions = mapped.Extensions
+		}
+		claims.System |= mapped.System
+		for namespace, role := range mapped.Namespaces {
+			if claims.Namespaces == nil {
+				claims.Namespaces = make(map[string]Role)
+			}
+			claims.Namespaces[namespace] |= role
+		}
+	}
+	return &claims, nil
+}
+
+// AuthInfoRequired returns false if any of the claim mappers runs without auth info.
+func (m *compositeClaimMapper) AuthInfoRequired() bool {
+	for _, claimMapper := range m.claimMappers {
+		if cm, ok := claimMapper.(ClaimMapperWithAuthInfoRequired); ok && !cm.AuthInfoRequired() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,186 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/primitives"
+)
+
+var testTLSClaimMapperConfig = &config.TLSClaimMapper{
+	Rules: []config.TLSClaimMappingRule{
+		{
+			OrganizationalUnit: "payments",
+			Permissions:        []string{"payments:worker"},
+		},
+		{
+			URI:         "spiffe://example.org/ns/*/sa/deployer",
+			Permissions: []string{"payments:write", "billing:write"},
+		},
+		{
+			CommonName:  "admin.example.org",
+			DNSName:     "*.ops.example.org",
+			Permissions: []string{primitives.SystemLocalNamespace + ":admin"},
+		},
+	},
+}
+
+func tlsAuthInfo(cert *x509.Certificate) *AuthInfo {
+	return &AuthInfo{
+		TLSSubject: &cert.Subject,
+		TLSConnection: &credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		},
+	}
+}
+
+func mustParseURL(t *testing.T, rawURL string) *url.URL {
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	return u
+}
+
+func TestTLSClaimMapper(t *testing.T) {
+	claimMapper, err := NewTLSClaimMapper(testTLSClaimMapperConfig)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name            string
+		cert            *x509.Certificate
+		expectedSubject string
+		expectedSystem  Role
+		expectedRoles   map[string]Role
+	}{
+		{
+			name: "organizational unit",
+			cert: &x509.Certificate{
+				Subject: pkix.Name{CommonName: "worker-1", OrganizationalUnit: []string{"infra", "payments"}},
+			},
+			expectedSubject: "worker-1",
+			expectedRoles:   map[string]Role{"payments": RoleWorker},
+		},
+		{
+			name: "SPIFFE ID",
+			cert: &x509.Certificate{
+				Subject: pkix.Name{CommonName: "deployer", OrganizationalUnit: []string{"payments"}},
+				URIs:    []*url.URL{mustParseURL(t, "spiffe://example.org/ns/ci/sa/deployer")},
+			},
+			expectedSubject: "spiffe://example.org/ns/ci/sa/deployer",
+			expectedRoles:   map[string]Role{"payments": RoleWorker | RoleWriter, "billing": RoleWriter},
+		},
+		{
+			name: "all conditions must match",
+			cert: &x509.Certificate{
+				Subject:  pkix.Name{CommonName: "admin.example.org"},
+				DNSNames: []string{"admin.example.org"},
+			},
+			expectedSubject: "admin.example.org",
+		},
+		{
+			name: "DNS SAN",
+			cert: &x509.Certificate{
+				Subject:  pkix.Name{CommonName: "admin.example.org"},
+				DNSNames: []string{"admin.example.org", "host1.ops.example.org"},
+			},
+			expectedSubject: "admin.example.org",
+			expectedSystem:  RoleAdmin,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			claims, err := claimMapper.GetClaims(tlsAuthInfo(tc.cert))
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSubject, claims.Subject)
+			require.Equal(t, tc.expectedSystem, claims.System)
+			require.Equal(t, tc.expectedRoles, claims.Namespaces)
+		})
+	}
+}
+
+func TestTLSClaimMapper_SubjectOnly(t *testing.T) {
+	claimMapper, err := NewTLSClaimMapper(testTLSClaimMapperConfig)
+	require.NoError(t, err)
+
+	claims, err := claimMapper.GetClaims(&AuthInfo{TLSSubject: &pkix.Name{CommonName: "w", OrganizationalUnit: []string{"payments"}}})
+	require.NoError(t, err)
+	require.Equal(t, map[string]Role{"payments": RoleWorker}, claims.Namespaces)
+
+	claims, err = claimMapper.GetClaims(&AuthInfo{})
+	require.NoError(t, err)
+	require.Equal(t, &Claims{}, claims)
+}
+
+func TestTLSClaimMapper_InvalidPermission(t *testing.T) {
+	_, err := NewTLSClaimMapper(&config.TLSClaimMapper{
+		Rules: []config.TLSClaimMappingRule{{CommonName: "*", Permissions: []string{"admin"}}},
+	})
+	require.Error(t, err)
+}
+
+func TestCompositeClaimMapper(t *testing.T) {
+	controller := gomock.NewController(t)
+	jwtClaimMapper := NewMockClaimMapper(controller)
+	tlsClaimMapper, err := NewTLSClaimMapper(testTLSClaimMapperConfig)
+	require.NoError(t, err)
+	claimMapper := NewCompositeClaimMapper(jwtClaimMapper, tlsClaimMapper)
+
+	authInfo := tlsAuthInfo(&x509.Certificate{
+		Subject: pkix.Name{CommonName: "worker-1", OrganizationalUnit: []string{"payments"}},
+	})
+
+	// certificate only
+	jwtClaimMapper.EXPECT().GetClaims(authInfo).Return(&Claims{}, nil)
+	claims, err := claimMapper.GetClaims(authInfo)
+	require.NoError(t, err)
+	require.Equal(t, "worker-1", claims.Subject)
+	require.Equal(t, map[string]Role{"payments": RoleWorker}, claims.Namespaces)
+
+	// both credentials
+	jwtClaimMapper.EXPECT().GetClaims(authInfo).Return(&Claims{
+		Subject:    "alice",
+		Namespaces: map[string]Role{"payments": RoleReader},
+	}, nil)
+	claims, err = claimMapper.GetClaims(authInfo)
+	require.NoError(t, err)
+	require.Equal(t, "alice", claims.Subject)
+	require.Equal(t, map[string]Role{"payments": RoleReader | RoleWorker}, claims.Namespaces)
+
+	// invalid token
+	jwtClaimMapper.EXPECT().GetClaims(authInfo).Return(nil, errors.New("invalid token"))
+	_, err = claimMapper.GetClaims(authInfo)
+	require.Error(t, err)
+
+	require.True(t, claimMapper.(ClaimMapperWithAuthInfoRequired).AuthInfoRequired())
+	require.False(t, NewCompositeClaimMapper(claimMapper, NewNoopClaimMapper()).(ClaimMapperWithAuthInfoRequired).AuthInfoRequired())
+}
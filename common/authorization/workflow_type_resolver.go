@@ -0,0 +1,47 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+// WorkflowTypeResolver looks up the workflow type of existing executions.
+type WorkflowTypeResolver interface {
+	// ResolveWorkflowType returns the workflow type of the execution, or an empty string if the
+	// execution does not exist. When the run ID is empty, the current run is used.
+	ResolveWorkflowType(ctx context.Context, namespace string, execution *commonpb.WorkflowExecution) (string, error)
+}
+
+// targetExecution returns the execution targeted by the request, if any.
+func targetExecution(request interface{}) *commonpb.WorkflowExecution {
+	switch r := request.(type) {
+	case hasWorkflowExecution:
+		return r.GetWorkflowExecution()
+	case hasExecution:
+		return r.GetExecution()
+	}
+	return nil
+}
@@ -462,6 +462,8 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
 		S3store   *S3Archiver        `yaml:"s3store"`
+		// Encryption is the config for encrypting archived history at rest, it applies to the history archivers of the server
+		Encryption *ArchivalEncryption `yaml:"encryption"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -479,17 +481,34 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		S3store   *S3Archiver        `yaml:"s3store"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		Parquet   *ParquetArchiver   `yaml:"parquet"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
 	FilestoreArchiver struct {
 		FileMode string `yaml:"fileMode"`
 		DirMode  string `yaml:"dirMode"`
+		// Compression of archived history, one of "zstd", "gzip" or "none". Defaults to "zstd".
+		Compression string `yaml:"compression"`
+	}
+
+	// ParquetArchiver contains the config for the parquet visibility archiver
+	ParquetArchiver struct {
+		FileMode string `yaml:"fileMode"`
+		DirMode  string `yaml:"dirMode"`
+		// BatchSize is the number of staged records compacted into a single parquet file.
+		// Defaults to 1000.
+		BatchSize int `yaml:"batchSize"`
+		// MaxStagingAge is how long records may stay staged before they are compacted, even if
+		// they don't fill a batch. Defaults to 1h.
+		MaxStagingAge time.Duration `yaml:"maxStagingAge"`
 	}
 
 	// GstorageArchiver contain the config for google storage archiver
 	GstorageArchiver struct {
 		CredentialsPath string `yaml:"credentialsPath"`
+		// Compression of archived history, one of "zstd", "gzip" or "none". Defaults to "zstd".
+		Compression string `yaml:"compression"`
 	}
 
 	// S3Archiver contains the config for S3 archiver
@@ -498,6 +517,21 @@ type (
 		Endpoint         *string `yaml:"endpoint"`
 		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
 		LogLevel         uint    `yaml:"logLevel"`
+		// Compression of archived history, one of "zstd", "gzip" or "none". Defaults to "zstd".
+		Compression string `yaml:"compression"`
+	}
+
+	// ArchivalEncryption contains the config for envelope encryption of archived history
+	ArchivalEncryption struct {
+		// LocalKeyFile reads the key encryption keys from a file on the local filesystem
+		LocalKeyFile *LocalKeyFileConfig `yaml:"localKeyFile"`
+	}
+
+	// LocalKeyFileConfig contains the config for the local key file key provider
+	LocalKeyFileConfig struct {
+		// Path is the path of a yaml file listing the base64 encoded 256-bit keys by key ID,
+		// and the ID of the key used to encrypt newly archived history
+		Path string `yaml:"path"`
 	}
 
 	// PublicClient is the config for internal nodes (history/matching/worker) connecting to
@@ -558,12 +592,22 @@ type (
 
 	Authorization struct {
 		// Signing key provider for validating JWT tokens
-		JWTKeyProvider       JWTKeyProvider `yaml:"jwtKeyProvider"`
-		PermissionsClaimName string         `yaml:"permissionsClaimName"`
-		// Empty string for noopAuthorizer or "default" for defaultAuthorizer
+		JWTKeyProvider JWTKeyProvider `yaml:"jwtKeyProvider"`
+		// Name of the permissions claim, nested claims may be separated by dots. Defaults to `permissions`.
+		PermissionsClaimName string `yaml:"permissionsClaimName"`
+		// OpenID Connect issuers and claim mapping used by the default JWT claim mapper
+		OIDC OIDC `yaml:"oidc"`
+		// Empty string for noopAuthorizer, "default" for defaultAuthorizer or "policy" for policyAuthorizer
 		Authorizer string `yaml:"authorizer"`
-		// Empty string for noopClaimMapper or "default" for defaultJWTClaimMapper
+		// Policy file used by the "policy" authorizer
+		Policy AuthorizationPolicy `yaml:"policy"`
+		// Audit log of authorization decisions
+		Audit AuthorizationAudit `yaml:"audit"`
+		// Empty string for noopClaimMapper, "default" for defaultJWTClaimMapper or "tls" for tlsClaimMapper.
+		// A comma separated list, e.g. "default,tls", combines the claims of several claim mappers.
 		ClaimMapper string `yaml:"claimMapper"`
+		// Rules of the "tls" claim mapper
+		TLSClaimMapper TLSClaimMapper `yaml:"tlsClaimMapper"`
 		// Name of main auth header to pass to ClaimMapper (as `AuthToken`). Defaults to `authorization`.
 		AuthHeaderName string `yaml:"authHeaderName"`
 		// Name of extra auth header to pass to ClaimMapper (as `ExtraData`). Defaults to `authorization-extras`.
@@ -577,6 +621,69 @@ type (
 		RefreshInterval time.Duration `yaml:"refreshInterval"`
 	}
 	// @@@SNIPEND
+
+	// OIDC contains the OpenID Connect config for the default JWT claim mapper
+	OIDC struct {
+		// Trusted token issuers. Their signing keys are discovered from /.well-known/openid-configuration.
+		// When set, tokens from any other issuer are rejected.
+		Issuers []OIDCIssuer `yaml:"issuers"`
+		// Name of the subject claim, nested claims may be separated by dots. Defaults to `sub`.
+		SubjectClaim string `yaml:"subjectClaim"`
+		// Name of the claim holding the subject's groups as an array or a single string.
+		// Nested claims may be separated by dots.
+		GroupsClaim string `yaml:"groupsClaim"`
+		// Permissions granted to members of each group, in the `namespace:role` format of the permissions claim.
+		GroupPermissions map[string][]string `yaml:"groupPermissions"`
+	}
+
+	// OIDCIssuer contains the config of a trusted token issuer
+	OIDCIssuer struct {
+		// Issuer URL, must match the `iss` claim of tokens
+		Issuer string `yaml:"issuer"`
+		// Accepted token audiences. Any audience is accepted when empty.
+		Audiences []string `yaml:"audiences"`
+		// Glob patterns of namespaces in which tokens of this issuer may grant roles,
+		// `temporal-system` matches system roles. All namespaces are allowed when empty.
+		Namespaces []string `yaml:"namespaces"`
+	}
+
+	// TLSClaimMapper contains the rules mapping client certificates to roles
+	TLSClaimMapper struct {
+		Rules []TLSClaimMappingRule `yaml:"rules"`
+	}
+
+	// TLSClaimMappingRule grants permissions to client certificates matching all of its non-empty
+	// conditions. Conditions are glob patterns, SAN conditions match if any SAN of the type matches.
+	TLSClaimMappingRule struct {
+		CommonName         string `yaml:"commonName"`
+		Organization       string `yaml:"organization"`
+		OrganizationalUnit string `yaml:"organizationalUnit"`
+		DNSName            string `yaml:"dnsName"`
+		// URI SAN, e.g. a SPIFFE ID such as `spiffe://example.org/ns/prod/sa/worker`
+		URI string `yaml:"uri"`
+		// Permissions in the `namespace:role` format of the JWT permissions claim
+		Permissions []string `yaml:"permissions"`
+	}
+
+	// AuthorizationPolicy contains the config for the policy based authorizer
+	AuthorizationPolicy struct {
+		// Path to the YAML policy file
+		File string `yaml:"file"`
+		// How often the policy file is checked for changes. Defaults to 10s.
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+	}
+
+	// AuthorizationAudit contains the config for the authorization audit log
+	AuthorizationAudit struct {
+		// Path of the JSON lines audit log file. The file sink is disabled when empty.
+		File string `yaml:"file"`
+		// Size in megabytes after which the audit log file is rotated. Defaults to 100.
+		MaxFileSizeMB int `yaml:"maxFileSizeMB"`
+		// Number of rotated audit log files to keep. Defaults to 5.
+		MaxBackups int `yaml:"maxBackups"`
+		// Number of records buffered for the sinks, new records are dropped when full. Defaults to 10000.
+		BufferSize int `yaml:"bufferSize"`
+	}
 )
 
 const (
@@ -51,6 +51,13 @@ type (
 		GetValue(key Key) []ConstrainedValue
 	}
 
+	// valueRejecter is implemented by clients which can go back to an earlier version of a value that can't
+	// be converted to the type its key is read as. Collection calls rejectValue with the key, the constraints
+	// of such a value and the values GetValue returned for the key, and reads the key again if it returns true.
+	valueRejecter interface {
+		rejectValue(key Key, constraints Constraints, values []ConstrainedValue, err error) bool
+	}
+
 	// Key is a key/property stored in dynamic config. For convenience, it is recommended that
 	// you treat keys as case-insensitive.
 	Key string
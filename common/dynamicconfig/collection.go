@@ -445,6 +445,23 @@ func findMatch(cvs, defaultCVs []ConstrainedValue, precedence []Constraints) (an
 	return nil, errNoMatchingConstraint
 }
 
+// findClientConstraints returns the constraints of the value findMatch returns, if the value is from cvs.
+func findClientConstraints(cvs, defaultCVs []ConstrainedValue, precedence []Constraints) (Constraints, bool) {
+	for _, m := range precedence {
+		for _, cv := range cvs {
+			if m == cv.Constraints {
+				return m, true
+			}
+		}
+		for _, cv := range defaultCVs {
+			if m == cv.Constraints {
+				return Constraints{}, false
+			}
+		}
+	}
+	return Constraints{}, false
+}
+
 // matchAndConvert can't be a method of Collection because methods can't be generic, but we can
 // take a *Collection as an argument.
 func matchAndConvert[T any](
@@ -472,6 +489,13 @@ func matchAndConvert[T any](
 
 	typedVal, convertErr := converter(val)
 	if convertErr != nil && matchErr == nil {
+		if rejecter, ok := c.client.(valueRejecter); ok {
+			if constraints, ok := findClientConstraints(cvs, defaultCVs, precedence); ok &&
+				rejecter.rejectValue(key, constraints, cvs, convertErr) {
+				// the value changed since it was read, read it again
+				return matchAndConvert(c, key, defaultValue, precedence, converter)
+			}
+		}
 		// We failed to convert the value to the desired type. Try converting the default. note
 		// that if matchErr != nil then val _is_ defaultValue and we don't have to try this again.
 		if c.throttleLog() {
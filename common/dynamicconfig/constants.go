@@ -68,6 +68,8 @@ const (
 	VisibilityDisableOrderByClause = "system.visibilityDisableOrderByClause"
 	// VisibilityEnableManualPagination is the config to enable manual pagination for Elasticsearch
 	VisibilityEnableManualPagination = "system.visibilityEnableManualPagination"
+	// VisibilityMaxCountGroups is the max number of groups returned by a CountWorkflowExecutions query with GROUP BY
+	VisibilityMaxCountGroups = "system.visibilityMaxCountGroups"
 
 	// HistoryArchivalState is key for the state of history archival
 	HistoryArchivalState = "system.historyArchivalState"
@@ -197,6 +199,9 @@ const (
 	// task queue. Update requests which would cause the versioning data to exceed this number will fail with a
 	// FailedPrecondition error.
 	VersionBuildIdLimitPerQueue = "limit.versionBuildIdLimitPerQueue"
+	// PausedBuildIdLimitPerQueue is the max number of build IDs dispatch can be paused for on a task queue. Pause
+	// requests which would cause the paused build IDs to exceed this number will fail with a FailedPrecondition error.
+	PausedBuildIdLimitPerQueue = "limit.pausedBuildIdLimitPerQueue"
 	// ReachabilityTaskQueueScanLimit limits the number of task queues to scan when responding to a
 	// GetWorkerTaskReachability query.
 	ReachabilityTaskQueueScanLimit = "limit.reachabilityTaskQueueScan"
@@ -424,6 +429,15 @@ const (
 	MatchingMinTaskThrottlingBurstSize = "matching.minTaskThrottlingBurstSize"
 	// MatchingGetTasksBatchSize is the maximum batch size to fetch from the task buffer
 	MatchingGetTasksBatchSize = "matching.getTasksBatchSize"
+	// MatchingPriorityWeightRatio controls how backlog tasks of different priorities share dispatch.
+	// 0 dispatches strictly by priority. n >= 1 gives each priority n times the dispatches of the next
+	// lower priority. Priorities only reorder the backlog tasks loaded in memory, up to
+	// matching.getTasksBatchSize per partition, as all priorities share one persisted queue.
+	MatchingPriorityWeightRatio = "matching.priorityWeightRatio"
+	// MatchingFairnessKeyWeights maps fairness keys to their round robin weight within a priority.
+	// Keys that are not listed have weight 1. Like priorities, the round robin only covers the backlog
+	// tasks loaded in memory.
+	MatchingFairnessKeyWeights = "matching.fairnessKeyWeights"
 	// MatchingLongPollExpirationInterval is the long poll expiration interval in the matching service
 	MatchingLongPollExpirationInterval = "matching.longPollExpirationInterval"
 	// MatchingSyncMatchWaitDuration is to wait time for sync match
@@ -452,6 +466,25 @@ const (
 	MatchingNumTaskqueueWritePartitions = "matching.numTaskqueueWritePartitions"
 	// MatchingNumTaskqueueReadPartitions is the number of read partitions for a task queue
 	MatchingNumTaskqueueReadPartitions = "matching.numTaskqueueReadPartitions"
+	// MatchingPartitionScalingEnabled lets matching scale the number of partitions of a task queue based on load.
+	// The partition count is recorded in task queue user data and takes precedence over
+	// MatchingNumTaskqueueReadPartitions and MatchingNumTaskqueueWritePartitions, which only set the initial count.
+	MatchingPartitionScalingEnabled = "matching.partitionScalingEnabled"
+	// MatchingPartitionScalingInterval is how often the root partition reconsiders the partition count of a task queue
+	MatchingPartitionScalingInterval = "matching.partitionScalingInterval"
+	// MatchingPartitionScalingMaxPartitions is the max number of partitions partition scaling grows a task queue to
+	MatchingPartitionScalingMaxPartitions = "matching.partitionScalingMaxPartitions"
+	// MatchingPartitionScalingAddRateThreshold is the tasks add rate per write partition above which partitions are added
+	MatchingPartitionScalingAddRateThreshold = "matching.partitionScalingAddRateThreshold"
+	// MatchingPartitionScalingPollRateThreshold is the poll rate per read partition above which partitions are added
+	MatchingPartitionScalingPollRateThreshold = "matching.partitionScalingPollRateThreshold"
+	// MatchingPartitionScalingForwardContentionThreshold is the rate of tasks that could not be forwarded to the parent
+	// partition for lack of a forwarder token above which partitions are added
+	MatchingPartitionScalingForwardContentionThreshold = "matching.partitionScalingForwardContentionThreshold"
+	// MatchingPartitionCountCacheTTL is how long matching clients keep a partition count without it being confirmed by
+	// a matching response. Partitions that are no longer written to are only removed from the read partitions once
+	// they have stayed empty for twice this long, so it must be set to the same value on all services.
+	MatchingPartitionCountCacheTTL = "matching.partitionCountCacheTTL"
 	// MatchingForwarderMaxOutstandingPolls is the max number of inflight polls from the forwarder
 	MatchingForwarderMaxOutstandingPolls = "matching.forwarderMaxOutstandingPolls"
 	// MatchingForwarderMaxOutstandingTasks is the max number of inflight addTask/queryTask from the forwarder
@@ -951,7 +984,17 @@ const (
 	WorkerStickyCacheSize = "worker.stickyCacheSize"
 	// SchedulerNamespaceStartWorkflowRPS is the per-namespace limit for starting workflows by schedules
 	SchedulerNamespaceStartWorkflowRPS = "worker.schedulerNamespaceStartWorkflowRPS"
+	// WorkerSchedulerHolidayCalendars is a map of holiday calendar name to either a list of dates
+	// ("2006-01-02") or the path of an iCalendar file, referenced by schedule cron strings with
+	// HOLIDAYS=name. Only cron strings can reference holiday calendars.
+	WorkerSchedulerHolidayCalendars = "worker.schedulerHolidayCalendars"
+	// WorkerSchedulerRunHistorySize is the number of recent runs each schedule keeps with their
+	// final status, or 0 to not keep them
+	WorkerSchedulerRunHistorySize = "worker.schedulerRunHistorySize"
 	// WorkerDeleteNamespaceActivityLimitsConfig is a map that contains a copy of relevant sdkworker.Options
 	// settings for controlling remote activity concurrency for delete namespace workflows.
 	WorkerDeleteNamespaceActivityLimitsConfig = "worker.deleteNamespaceActivityLimitsConfig"
+	// WorkerVisibilityMigrationAutoCutover controls whether the visibility migration workflow switches
+	// reads of a namespace to the secondary visibility store once parity with the primary store is verified.
+	WorkerVisibilityMigrationAutoCutover = "worker.visibilityMigrationAutoCutover"
 )
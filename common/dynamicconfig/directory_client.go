@@ -0,0 +1,324 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+var (
+	_ Client        = (*directoryClient)(nil)
+	_ valueRejecter = (*directoryClient)(nil)
+)
+
+const (
+	// directoryReloadDelay is how long directory client waits for more file system events before reloading,
+	// so that multiple files changed at once are applied together.
+	directoryReloadDelay = 500 * time.Millisecond
+)
+
+type (
+	// DirectoryClientConfig is the config for the directory based dynamic config client.
+	// Values from all YAML files (*.yaml or *.yml) in the directory tree are merged. The same key with
+	// the same constraints must not be defined in more than one file. Hidden files and directories
+	// are ignored, symbolic links to directories are not followed.
+	DirectoryClientConfig struct {
+		Directory string `yaml:"directory"`
+	}
+
+	// directoryWatcher notifies about changes in a directory tree.
+	directoryWatcher interface {
+		Events() <-chan struct{}
+		Close()
+	}
+
+	directoryClient struct {
+		values  atomic.Value // configValueMap
+		logger  log.Logger
+		config  *DirectoryClientConfig
+		watcher directoryWatcher
+		doneCh  <-chan interface{}
+
+		sync.Mutex // protects files
+		files      map[string]*directoryFile
+	}
+
+	// directoryFile is the state of a file in the directory tree.
+	directoryFile struct {
+		// content the values were loaded from
+		content []byte
+		// values of the file that are used
+		values configValueMap
+		// values of the file before its last change, used if a value of the file is rejected
+		previous configValueMap
+		// content of the file which was rejected, the file is not loaded again until it changes
+		rejected []byte
+	}
+)
+
+// NewDirectoryClient creates a dynamic config client which reads config from a directory tree of YAML files
+// and reloads it on file system changes. A file with invalid content is rejected and the last valid content
+// of that file is used instead, all files must be valid on start. Values are type checked when they are
+// read by Collection: a file with a value that can't be converted to the type its key is read as is
+// rejected as well.
+func NewDirectoryClient(config *DirectoryClientConfig, logger log.Logger, doneCh <-chan interface{}) (*directoryClient, error) {
+	if config == nil {
+		return nil, errors.New("configuration for dynamic config client is nil")
+	}
+	info, err := os.Stat(config.Directory)
+	if err != nil {
+		return nil, fmt.Errorf("dynamic config: %s: %w", config.Directory, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("dynamic config: %s is not a directory", config.Directory)
+	}
+
+	client := &directoryClient{
+		logger: logger,
+		config: config,
+		doneCh: doneCh,
+		files:  make(map[string]*directoryFile),
+	}
+	// Start watching before the first read to not miss changes made in between.
+	client.watcher, err = newDirectoryWatcher(config.Directory, logger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to watch dynamic config directory: %w", err)
+	}
+	if err := client.update(); err != nil {
+		client.watcher.Close()
+		return nil, fmt.Errorf("unable to read dynamic config: %w", err)
+	}
+	go client.run()
+
+	return client, nil
+}
+
+func (dc *directoryClient) GetValue(key Key) []ConstrainedValue {
+	values := dc.values.Load().(configValueMap)
+	return values[strings.ToLower(key.String())]
+}
+
+func (dc *directoryClient) run() {
+	var reloadCh <-chan time.Time
+	for {
+		select {
+		case <-dc.watcher.Events():
+			if reloadCh == nil {
+				reloadCh = time.After(directoryReloadDelay)
+			}
+		case <-reloadCh:
+			reloadCh = nil
+			if err := dc.update(); err != nil {
+				dc.logger.Error("Unable to update dynamic config.", tag.Error(err))
+			}
+		case <-dc.doneCh:
+			dc.watcher.Close()
+			return
+		}
+	}
+}
+
+// update reloads all files and swaps merged values. Files which can't be loaded are replaced with their last
+// valid values and reported in the returned error.
+func (dc *directoryClient) update() error {
+	dc.Lock()
+	defer dc.Unlock()
+
+	paths, err := dc.listFiles()
+	if err != nil {
+		return fmt.Errorf("dynamic config directory: %s: %w", dc.config.Directory, err)
+	}
+
+	var errs []error
+	files := make(map[string]*directoryFile, len(paths))
+	newValues := make(configValueMap)
+	for _, path := range paths {
+		file, err := dc.loadFile(path, newValues)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("dynamic config file: %s: %w", path, err))
+			lastFile, ok := dc.files[path]
+			if !ok || validateNoConflicts(lastFile.values, newValues) != nil {
+				continue
+			}
+			dc.logger.Warn("Dynamic config file is rejected, using its last valid version.", tag.NewStringTag("file", path), tag.Error(err))
+			file = lastFile
+		}
+		files[path] = file
+		for key, cvs := range file.values {
+			newValues[key] = append(newValues[key], cvs...)
+		}
+	}
+	if len(errs) > 0 && dc.values.Load() == nil {
+		return errors.Join(errs...)
+	}
+
+	dc.files = files
+	dc.swapValues(newValues)
+	dc.logger.Info("Updated dynamic config", tag.NewStringTag("directory", dc.config.Directory))
+
+	return errors.Join(errs...)
+}
+
+// rejectValue goes back to the previous version of the file that defines the value of key with constraints.
+func (dc *directoryClient) rejectValue(key Key, constraints Constraints, values []ConstrainedValue, err error) bool {
+	dc.Lock()
+	defer dc.Unlock()
+
+	lowerKey := strings.ToLower(key.String())
+	if current := dc.values.Load().(configValueMap)[lowerKey]; len(current) != len(values) ||
+		len(current) > 0 && &current[0] != &values[0] {
+		// the values were already changed by an update or another rejection
+		return true
+	}
+	for path, file := range dc.files {
+		if !file.defines(lowerKey, constraints) {
+			continue
+		}
+		if file.previous == nil {
+			// there is no earlier version to go back to, the server default is used
+			return false
+		}
+		newValues := make(configValueMap)
+		for otherPath, other := range dc.files {
+			if otherPath == path {
+				continue
+			}
+			for key, cvs := range other.values {
+				newValues[key] = append(newValues[key], cvs...)
+			}
+		}
+		if validateNoConflicts(file.previous, newValues) != nil {
+			return false
+		}
+		for key, cvs := range file.previous {
+			newValues[key] = append(newValues[key], cvs...)
+		}
+
+		dc.logger.Warn("Dynamic config file is rejected, using its last valid version.",
+			tag.NewStringTag("file", path), tag.Key(key.String()), tag.Error(err))
+		dc.files[path] = &directoryFile{
+			content:  file.content,
+			values:   file.previous,
+			rejected: file.content,
+		}
+		dc.swapValues(newValues)
+		return true
+	}
+	return false
+}
+
+func (dc *directoryClient) swapValues(newValues configValueMap) {
+	prev := dc.values.Swap(newValues)
+	oldValues, _ := prev.(configValueMap)
+	logDiff(dc.logger, oldValues, newValues)
+}
+
+func (dc *directoryClient) listFiles() ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(dc.config.Directory, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != dc.config.Directory && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if ext := filepath.Ext(path); ext == ".yaml" || ext == ".yml" {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	// WalkDir walks files in lexical order, so paths are sorted.
+	return paths, err
+}
+
+func (dc *directoryClient) loadFile(path string, loadedValues configValueMap) (*directoryFile, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	lastFile := dc.files[path]
+	if lastFile != nil && (bytes.Equal(content, lastFile.content) || bytes.Equal(content, lastFile.rejected)) {
+		// unchanged, or still the content that was rejected
+		if err := validateNoConflicts(lastFile.values, loadedValues); err != nil {
+			return nil, err
+		}
+		return lastFile, nil
+	}
+	values, err := parseConfigValues(content)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateNoConflicts(values, loadedValues); err != nil {
+		return nil, err
+	}
+	file := &directoryFile{
+		content: content,
+		values:  values,
+	}
+	if lastFile != nil {
+		file.previous = lastFile.values
+	}
+	return file, nil
+}
+
+// defines returns true if the file defines a value of the lower case key with constraints.
+func (f *directoryFile) defines(lowerKey string, constraints Constraints) bool {
+	for _, cv := range f.values[lowerKey] {
+		if cv.Constraints == constraints {
+			return true
+		}
+	}
+	return false
+}
+
+// validateNoConflicts checks that values don't redefine any key with the same constraints as loadedValues.
+func validateNoConflicts(values configValueMap, loadedValues configValueMap) error {
+	for key, cvs := range values {
+		for _, cv := range cvs {
+			for _, loaded := range loadedValues[key] {
+				if cv.Constraints == loaded.Constraints {
+					return fmt.Errorf("key %s with constraints %+v is already defined in another file", key, cv.Constraints)
+				}
+			}
+		}
+	}
+	return nil
+}
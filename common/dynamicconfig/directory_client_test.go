@@ -0,0 +1,255 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	testDirectoryIntKey    Key = "testDirectoryGetIntPropertyKey"
+	testDirectoryStringKey Key = "testDirectoryGetStringPropertyKey"
+)
+
+type directoryClientSuite struct {
+	suite.Suite
+	*require.Assertions
+	dir    string
+	doneCh chan interface{}
+}
+
+func TestDirectoryClientSuite(t *testing.T) {
+	s := new(directoryClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *directoryClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.dir = s.T().TempDir()
+	s.doneCh = make(chan interface{})
+}
+
+func (s *directoryClientSuite) TearDownTest() {
+	close(s.doneCh)
+}
+
+func (s *directoryClientSuite) writeFile(name string, content string) {
+	path := filepath.Join(s.dir, name)
+	s.NoError(os.MkdirAll(filepath.Dir(path), 0755))
+	// Write to temporary file and rename it to not trigger reload on partially written file.
+	tmpPath := filepath.Join(s.dir, "."+filepath.Base(name)+".tmp")
+	s.NoError(os.WriteFile(tmpPath, []byte(content), 0644))
+	s.NoError(os.Rename(tmpPath, path))
+}
+
+func (s *directoryClientSuite) newCollection() *Collection {
+	client, err := NewDirectoryClient(&DirectoryClientConfig{Directory: s.dir}, log.NewNoopLogger(), s.doneCh)
+	s.NoError(err)
+	return NewCollection(client, log.NewNoopLogger())
+}
+
+func (s *directoryClientSuite) TestMergeFiles() {
+	s.writeFile("global.yaml", `
+testDirectoryGetIntPropertyKey:
+- value: 10
+`)
+	s.writeFile("teams/team1/namespace1.yml", `
+testDirectoryGetIntPropertyKey:
+- value: 20
+  constraints:
+    namespace: namespace1
+`)
+	s.writeFile("teams/team1/README.md", "not a config")
+	s.writeFile(".hidden/namespace2.yaml", `
+testDirectoryGetIntPropertyKey:
+- value: 30
+  constraints:
+    namespace: namespace2
+`)
+
+	collection := s.newCollection()
+	value := collection.GetIntPropertyFilteredByNamespace(testDirectoryIntKey, 0)
+	s.Equal(10, value("namespace"))
+	s.Equal(20, value("namespace1"))
+	s.Equal(10, value("namespace2"))
+}
+
+func (s *directoryClientSuite) TestReload() {
+	s.writeFile("global.yaml", `
+testDirectoryGetIntPropertyKey:
+- value: 10
+`)
+	collection := s.newCollection()
+	value := collection.GetIntPropertyFilteredByNamespace(testDirectoryIntKey, 0)
+	s.Equal(10, value("namespace1"))
+
+	s.writeFile("global.yaml", `
+testDirectoryGetIntPropertyKey:
+- value: 11
+`)
+	s.writeFile("teams/team1/namespace1.yaml", `
+testDirectoryGetIntPropertyKey:
+- value: 20
+  constraints:
+    namespace: namespace1
+`)
+	s.Eventually(func() bool {
+		return value("namespace") == 11 && value("namespace1") == 20
+	}, 10*time.Second, 100*time.Millisecond)
+
+	s.NoError(os.RemoveAll(filepath.Join(s.dir, "teams")))
+	s.Eventually(func() bool {
+		return value("namespace1") == 11
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func (s *directoryClientSuite) TestReload_RejectInvalidFile() {
+	s.writeFile("int.yaml", `
+testDirectoryGetIntPropertyKey:
+- value: 10
+`)
+	s.writeFile("string.yaml", `
+testDirectoryGetStringPropertyKey:
+- value: foo
+`)
+	collection := s.newCollection()
+	intValue := collection.GetIntProperty(testDirectoryIntKey, 0)
+	stringValue := collection.GetStringProperty(testDirectoryStringKey, "")
+	s.Equal(10, intValue())
+	s.Equal("foo", stringValue())
+
+	// Value has wrong type for the key: last valid version of the file is used.
+	s.writeFile("int.yaml", `
+testDirectoryGetIntPropertyKey:
+- value: eleven
+`)
+	s.writeFile("string.yaml", `
+testDirectoryGetStringPropertyKey:
+- value: bar
+`)
+	s.Eventually(func() bool {
+		return stringValue() == "bar"
+	}, 10*time.Second, 100*time.Millisecond)
+	s.Equal(10, intValue())
+
+	// Invalid YAML.
+	s.writeFile("int.yaml", `
+testDirectoryGetIntPropertyKey: [
+`)
+	s.writeFile("string.yaml", `
+testDirectoryGetStringPropertyKey:
+- value: baz
+`)
+	s.Eventually(func() bool {
+		return stringValue() == "baz"
+	}, 10*time.Second, 100*time.Millisecond)
+	s.Equal(10, intValue())
+
+	s.writeFile("int.yaml", `
+testDirectoryGetIntPropertyKey:
+- value: 12
+`)
+	s.Eventually(func() bool {
+		return intValue() == 12
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func (s *directoryClientSuite) TestReload_RejectValueReadAfterReload() {
+	s.writeFile("int.yaml", `
+testDirectoryGetIntPropertyKey:
+- value: 10
+`)
+	s.writeFile("string.yaml", `
+testDirectoryGetStringPropertyKey:
+- value: foo
+`)
+	collection := s.newCollection()
+	stringValue := collection.GetStringProperty(testDirectoryStringKey, "")
+	s.Equal("foo", stringValue())
+
+	s.writeFile("int.yaml", `
+testDirectoryGetIntPropertyKey:
+- value: eleven
+`)
+	s.writeFile("string.yaml", `
+testDirectoryGetStringPropertyKey:
+- value: bar
+`)
+	s.Eventually(func() bool {
+		return stringValue() == "bar"
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// The key is read for the first time after the reload, its value is still type checked.
+	intValue := collection.GetIntProperty(testDirectoryIntKey, 0)
+	s.Equal(10, intValue())
+}
+
+func (s *directoryClientSuite) TestInvalidValueOnStart() {
+	s.writeFile("int.yaml", `
+testDirectoryGetIntPropertyKey:
+- value: eleven
+`)
+	collection := s.newCollection()
+	// There is no valid version of the file to go back to, the default is used.
+	s.Equal(5, collection.GetIntProperty(testDirectoryIntKey, 5)())
+}
+
+func (s *directoryClientSuite) TestInvalidFileOnStart() {
+	s.writeFile("invalid.yaml", `
+testDirectoryGetIntPropertyKey: [
+`)
+	_, err := NewDirectoryClient(&DirectoryClientConfig{Directory: s.dir}, log.NewNoopLogger(), s.doneCh)
+	s.ErrorContains(err, "invalid.yaml")
+}
+
+func (s *directoryClientSuite) TestConflictingFiles() {
+	s.writeFile("a.yaml", `
+testDirectoryGetIntPropertyKey:
+- value: 10
+  constraints:
+    namespace: namespace1
+`)
+	s.writeFile("b.yaml", `
+testDirectoryGetIntPropertyKey:
+- value: 20
+  constraints:
+    namespace: namespace1
+`)
+	_, err := NewDirectoryClient(&DirectoryClientConfig{Directory: s.dir}, log.NewNoopLogger(), s.doneCh)
+	s.ErrorContains(err, "b.yaml")
+	s.ErrorContains(err, "already defined in another file")
+}
+
+func (s *directoryClientSuite) TestNotDirectory() {
+	s.writeFile("config.yaml", "")
+	_, err := NewDirectoryClient(&DirectoryClientConfig{Directory: filepath.Join(s.dir, "config.yaml")}, log.NewNoopLogger(), s.doneCh)
+	s.ErrorContains(err, "is not a directory")
+}
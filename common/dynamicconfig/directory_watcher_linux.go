@@ -0,0 +1,168 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build linux
+
+package dynamicconfig
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/sys/unix"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	inotifyMask = unix.IN_CREATE | unix.IN_DELETE | unix.IN_CLOSE_WRITE | unix.IN_MOVED_FROM | unix.IN_MOVED_TO
+	// inotifyPollTimeoutMs limits how long Close waits for the watcher goroutine to stop.
+	inotifyPollTimeoutMs = 500
+)
+
+type (
+	// inotifyWatcher watches a directory tree with inotify. Every subdirectory requires its own watch,
+	// watches are added for new subdirectories after every change.
+	inotifyWatcher struct {
+		fd        int
+		root      string
+		logger    log.Logger
+		watches   map[string]int // directory -> watch descriptor, only accessed by run
+		eventsCh  chan struct{}
+		doneCh    chan struct{}
+		closeOnce sync.Once
+	}
+)
+
+func newDirectoryWatcher(root string, logger log.Logger) (directoryWatcher, error) {
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		return nil, os.NewSyscallError("inotify_init1", err)
+	}
+
+	w := &inotifyWatcher{
+		fd:       fd,
+		root:     root,
+		logger:   logger,
+		watches:  make(map[string]int),
+		eventsCh: make(chan struct{}, 1),
+		doneCh:   make(chan struct{}),
+	}
+	if err := w.syncWatches(); err != nil {
+		_ = unix.Close(fd)
+		return nil, err
+	}
+	go w.run()
+
+	return w, nil
+}
+
+func (w *inotifyWatcher) Events() <-chan struct{} {
+	return w.eventsCh
+}
+
+func (w *inotifyWatcher) Close() {
+	w.closeOnce.Do(func() {
+		close(w.doneCh)
+	})
+}
+
+func (w *inotifyWatcher) run() {
+	defer func() { _ = unix.Close(w.fd) }()
+
+	// Events are not parsed: any change in the tree triggers full reload.
+	buf := make([]byte, 64*(unix.SizeofInotifyEvent+unix.NAME_MAX+1))
+	fds := []unix.PollFd{{Fd: int32(w.fd), Events: unix.POLLIN}}
+	for {
+		select {
+		case <-w.doneCh:
+			return
+		default:
+		}
+
+		n, err := unix.Poll(fds, inotifyPollTimeoutMs)
+		if errors.Is(err, unix.EINTR) || n == 0 {
+			continue
+		}
+		if err != nil {
+			w.logger.Error("Unable to poll dynamic config directory watcher.", tag.Error(err))
+			return
+		}
+
+		n, err = unix.Read(w.fd, buf)
+		if errors.Is(err, unix.EAGAIN) || errors.Is(err, unix.EINTR) {
+			continue
+		}
+		if err != nil {
+			w.logger.Error("Unable to read dynamic config directory watcher events.", tag.Error(err))
+			return
+		}
+		if n <= 0 {
+			continue
+		}
+
+		if err := w.syncWatches(); err != nil {
+			w.logger.Warn("Unable to watch dynamic config subdirectories.", tag.Error(err))
+		}
+		select {
+		case w.eventsCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// syncWatches adds watches for new directories and forgets removed ones.
+func (w *inotifyWatcher) syncWatches() error {
+	for dir, wd := range w.watches {
+		if _, err := os.Stat(dir); err != nil {
+			// Watch is already removed by kernel if directory is deleted.
+			_, _ = unix.InotifyRmWatch(w.fd, uint32(wd))
+			delete(w.watches, dir)
+		}
+	}
+
+	return filepath.WalkDir(w.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Directory could be removed while walking, it will be handled on the next event.
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if _, ok := w.watches[path]; ok {
+			return nil
+		}
+		wd, err := unix.InotifyAddWatch(w.fd, path, inotifyMask)
+		if err != nil {
+			return os.NewSyscallError("inotify_add_watch", err)
+		}
+		w.watches[path] = wd
+		return nil
+	})
+}
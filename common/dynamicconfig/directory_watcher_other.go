@@ -0,0 +1,130 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build !linux
+
+package dynamicconfig
+
+import (
+	"io/fs"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// pollingWatcher is used on platforms without inotify and compares the state of a directory tree
+	// every minPollInterval.
+	pollingWatcher struct {
+		root      string
+		logger    log.Logger
+		eventsCh  chan struct{}
+		doneCh    chan struct{}
+		closeOnce sync.Once
+	}
+
+	fileState struct {
+		size    int64
+		modTime time.Time
+	}
+)
+
+func newDirectoryWatcher(root string, logger log.Logger) (directoryWatcher, error) {
+	w := &pollingWatcher{
+		root:     root,
+		logger:   logger,
+		eventsCh: make(chan struct{}, 1),
+		doneCh:   make(chan struct{}),
+	}
+	state, err := w.state()
+	if err != nil {
+		return nil, err
+	}
+	go w.run(state)
+
+	return w, nil
+}
+
+func (w *pollingWatcher) Events() <-chan struct{} {
+	return w.eventsCh
+}
+
+func (w *pollingWatcher) Close() {
+	w.closeOnce.Do(func() {
+		close(w.doneCh)
+	})
+}
+
+func (w *pollingWatcher) run(state map[string]fileState) {
+	ticker := time.NewTicker(minPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			newState, err := w.state()
+			if err != nil {
+				w.logger.Warn("Unable to poll dynamic config directory.", tag.Error(err))
+				continue
+			}
+			if !fileStatesEqual(state, newState) {
+				state = newState
+				select {
+				case w.eventsCh <- struct{}{}:
+				default:
+				}
+			}
+		case <-w.doneCh:
+			return
+		}
+	}
+}
+
+func (w *pollingWatcher) state() (map[string]fileState, error) {
+	state := make(map[string]fileState)
+	err := filepath.WalkDir(w.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		state[path] = fileState{size: info.Size(), modTime: info.ModTime()}
+		return nil
+	})
+	return state, err
+}
+
+func fileStatesEqual(s1 map[string]fileState, s2 map[string]fileState) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+	for path, state := range s1 {
+		if s2[path] != state {
+			return false
+		}
+	}
+	return true
+}
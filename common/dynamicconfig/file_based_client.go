@@ -163,15 +163,30 @@ func (fc *fileBasedClient) update() error {
 		return fmt.Errorf("dynamic config file: %s: %w", fc.config.Filepath, err)
 	}
 
+	newValues, err := parseConfigValues(confContent)
+	if err != nil {
+		return err
+	}
+
+	prev := fc.values.Swap(newValues)
+	oldValues, _ := prev.(configValueMap)
+	logDiff(fc.logger, oldValues, newValues)
+	fc.logger.Info("Updated dynamic config")
+
+	return nil
+}
+
+func parseConfigValues(confContent []byte) (configValueMap, error) {
 	var yamlValues map[string][]struct {
 		Constraints map[string]any
 		Value       any
 	}
-	if err = yaml.Unmarshal(confContent, &yamlValues); err != nil {
-		return fmt.Errorf("unable to decode dynamic config: %w", err)
+	if err := yaml.Unmarshal(confContent, &yamlValues); err != nil {
+		return nil, fmt.Errorf("unable to decode dynamic config: %w", err)
 	}
 
-	newValues := make(configValueMap, len(yamlValues))
+	var err error
+	values := make(configValueMap, len(yamlValues))
 	for key, yamlCV := range yamlValues {
 		cvs := make([]ConstrainedValue, len(yamlCV))
 		for i, cv := range yamlCV {
@@ -179,22 +194,16 @@ func (fc *fileBasedClient) update() error {
 			// manually convert key type to string for all values here
 			cvs[i].Value, err = convertKeyTypeToString(cv.Value)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			cvs[i].Constraints, err = convertYamlConstraints(cv.Constraints)
 			if err != nil {
-				return err
+				return nil, err
 			}
 		}
-		newValues[strings.ToLower(key)] = cvs
+		values[strings.ToLower(key)] = cvs
 	}
-
-	prev := fc.values.Swap(newValues)
-	oldValues, _ := prev.(configValueMap)
-	fc.logDiff(oldValues, newValues)
-	fc.logger.Info("Updated dynamic config")
-
-	return nil
+	return values, nil
 }
 
 func (fc *fileBasedClient) validateConfig(config *FileBasedClientConfig) error {
@@ -210,17 +219,17 @@ func (fc *fileBasedClient) validateConfig(config *FileBasedClientConfig) error {
 	return nil
 }
 
-func (fc *fileBasedClient) logDiff(old configValueMap, new configValueMap) {
+func logDiff(logger log.Logger, old configValueMap, new configValueMap) {
 	for key, newValues := range new {
 		oldValues, ok := old[key]
 		if !ok {
 			for _, newValue := range newValues {
 				// new key added
-				fc.logValueDiff(key, nil, &newValue)
+				logValueDiff(logger, key, nil, &newValue)
 			}
 		} else {
 			// compare existing keys
-			fc.logConstraintsDiff(key, oldValues, newValues)
+			logConstraintsDiff(logger, key, oldValues, newValues)
 		}
 	}
 
@@ -228,25 +237,25 @@ func (fc *fileBasedClient) logDiff(old configValueMap, new configValueMap) {
 	for key, oldValues := range old {
 		if _, ok := new[key]; !ok {
 			for _, oldValue := range oldValues {
-				fc.logValueDiff(key, &oldValue, nil)
+				logValueDiff(logger, key, &oldValue, nil)
 			}
 		}
 	}
 }
 
-func (fc *fileBasedClient) logConstraintsDiff(key string, oldValues []ConstrainedValue, newValues []ConstrainedValue) {
+func logConstraintsDiff(logger log.Logger, key string, oldValues []ConstrainedValue, newValues []ConstrainedValue) {
 	for _, oldValue := range oldValues {
 		matchFound := false
 		for _, newValue := range newValues {
 			if oldValue.Constraints == newValue.Constraints {
 				matchFound = true
 				if !reflect.DeepEqual(oldValue.Value, newValue.Value) {
-					fc.logValueDiff(key, &oldValue, &newValue)
+					logValueDiff(logger, key, &oldValue, &newValue)
 				}
 			}
 		}
 		if !matchFound {
-			fc.logValueDiff(key, &oldValue, nil)
+			logValueDiff(logger, key, &oldValue, nil)
 		}
 	}
 
@@ -258,24 +267,24 @@ func (fc *fileBasedClient) logConstraintsDiff(key string, oldValues []Constraine
 			}
 		}
 		if !matchFound {
-			fc.logValueDiff(key, nil, &newValue)
+			logValueDiff(logger, key, nil, &newValue)
 		}
 	}
 }
 
-func (fc *fileBasedClient) logValueDiff(key string, oldValue *ConstrainedValue, newValue *ConstrainedValue) {
+func logValueDiff(logger log.Logger, key string, oldValue *ConstrainedValue, newValue *ConstrainedValue) {
 	logLine := &strings.Builder{}
 	logLine.Grow(128)
 	logLine.WriteString("dynamic config changed for the key: ")
 	logLine.WriteString(key)
 	logLine.WriteString(" oldValue: ")
-	fc.appendConstrainedValue(logLine, oldValue)
+	appendConstrainedValue(logLine, oldValue)
 	logLine.WriteString(" newValue: ")
-	fc.appendConstrainedValue(logLine, newValue)
-	fc.logger.Info(logLine.String())
+	appendConstrainedValue(logLine, newValue)
+	logger.Info(logLine.String())
 }
 
-func (fc *fileBasedClient) appendConstrainedValue(logLine *strings.Builder, value *ConstrainedValue) {
+func appendConstrainedValue(logLine *strings.Builder, value *ConstrainedValue) {
 	if value == nil {
 		logLine.WriteString("nil")
 	} else {
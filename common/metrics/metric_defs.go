@@ -532,6 +532,8 @@ const (
 	DeleteNamespaceWorkflowScope    = "DeleteNamespaceWorkflow"
 	ReclaimResourcesWorkflowScope   = "ReclaimResourcesWorkflow"
 	DeleteExecutionsWorkflowScope   = "DeleteExecutionsWorkflow"
+	// VisibilityMigrationWorkflowScope is scope used by all metrics emitted by worker.VisibilityMigration module
+	VisibilityMigrationWorkflowScope = "VisibilityMigrationWorkflow"
 )
 
 // History task type
@@ -607,6 +609,8 @@ var (
 	TlsCertsExpired                          = NewGaugeDef("certificates_expired")
 	TlsCertsExpiring                         = NewGaugeDef("certificates_expiring")
 	ServiceAuthorizationLatency              = NewTimerDef("service_authorization_latency")
+	AuthorizationAuditRecordsDropped         = NewCounterDef("authorization_audit_records_dropped")
+	AuthorizationAuditSinkErrors             = NewCounterDef("authorization_audit_sink_errors")
 	EventBlobSize                            = NewBytesHistogramDef("event_blob_size")
 	LockRequests                             = NewCounterDef("lock_requests")
 	LockLatency                              = NewTimerDef("lock_latency")
@@ -1008,6 +1012,11 @@ var (
 	DeleteExecutionFailuresCount                    = NewCounterDef("delete_execution_failures")
 	DeleteExecutionNotFoundCount                    = NewCounterDef("delete_execution_not_found")
 	RateLimiterFailuresCount                        = NewCounterDef("rate_limiter_failures")
+	VisibilityMigrationBackfillSuccessCount         = NewCounterDef("visibility_migration_backfill_success")
+	VisibilityMigrationBackfillFailuresCount        = NewCounterDef("visibility_migration_backfill_failures")
+	VisibilityMigrationSampledCount                 = NewCounterDef("visibility_migration_sampled")
+	VisibilityMigrationDivergenceCount              = NewCounterDef("visibility_migration_divergences")
+	VisibilityMigrationCutoverCount                 = NewCounterDef("visibility_migration_cutover")
 	BatcherProcessorSuccess                         = NewCounterDef(
 		"batcher_processor_requests",
 		WithDescription("The number of individual workflow execution tasks successfully processed by the batch request processor"),
@@ -1065,6 +1074,14 @@ var (
 		"schedule_terminate_workflow_errors",
 		WithDescription("The number of times a schedule got an error trying to terminate a previous run"),
 	)
+	ScheduleUpstreamFailures = NewCounterDef(
+		"schedule_upstream_failures",
+		WithDescription("The number of times a run of the schedule that a dependent schedule is waiting on did not complete successfully"),
+	)
+	ScheduleUpstreamMissedActions = NewCounterDef(
+		"schedule_upstream_missed_actions",
+		WithDescription("The number of actions of the schedule that a dependent schedule is waiting on which were no longer listed when the dependent schedule looked for them"),
+	)
 
 	// Force replication
 	EncounterZombieWorkflowCount        = NewCounterDef("encounter_zombie_workflow_count")
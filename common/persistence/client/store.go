@@ -55,7 +55,9 @@ type (
 	}
 
 	// AbstractDataStoreFactory creates a DataStoreFactory, can be used to implement custom datastore support outside
-	// of the Temporal core.
+	// of the Temporal core. Stores built on an embedded key-value engine (e.g. Pebble or Badger) for single-node
+	// deployments belong here rather than in core, so that core does not depend on the engine; single-node
+	// deployments that use a core store use SQL with the sqlite plugin.
 	AbstractDataStoreFactory interface {
 		NewFactory(
 			cfg config.CustomDatastoreConfig,
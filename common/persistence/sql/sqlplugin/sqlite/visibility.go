@@ -173,7 +173,7 @@ func (mdb *db) CountGroupByFromVisibility(
 		return nil, err
 	}
 	defer rows.Close()
-	return sqlplugin.ParseCountGroupByRows(rows, filter.GroupBy)
+	return sqlplugin.ParseCountGroupByRows(rows, filter.GroupBy, filter.Aggregations)
 }
 
 func (mdb *db) prepareRowForDB(row *sqlplugin.VisibilityRow) *sqlplugin.VisibilityRow {
@@ -82,7 +82,10 @@ type (
 
 		Query     string
 		QueryArgs []interface{}
-		GroupBy   []string
+		// GroupBy and Aggregations are the search attributes (field name, not db name) of the
+		// grouping terms and the aggregations selected by a count query, in the order of the columns.
+		GroupBy      []string
+		Aggregations []string
 	}
 
 	VisibilityGetFilter struct {
@@ -96,8 +99,9 @@ type (
 	}
 
 	VisibilityCountRow struct {
-		GroupValues []any
-		Count       int64
+		GroupValues       []any
+		Count             int64
+		AggregationValues []any
 	}
 
 	Visibility interface {
@@ -148,9 +152,9 @@ func (vsa VisibilitySearchAttributes) Value() (driver.Value, error) {
 	return json.Marshal(vsa)
 }
 
-func ParseCountGroupByRows(rows *sql.Rows, groupBy []string) ([]VisibilityCountRow, error) {
-	// Number of columns is number of group by fields plus the count column.
-	rowValues := make([]any, len(groupBy)+1)
+func ParseCountGroupByRows(rows *sql.Rows, groupBy []string, aggregations []string) ([]VisibilityCountRow, error) {
+	// Number of columns is number of group by fields plus the count column plus number of aggregations.
+	rowValues := make([]any, len(groupBy)+1+len(aggregations))
 	for i := range rowValues {
 		rowValues[i] = new(any)
 	}
@@ -168,10 +172,15 @@ func ParseCountGroupByRows(rows *sql.Rows, groupBy []string) ([]VisibilityCountR
 				return nil, err
 			}
 		}
-		count := *(rowValues[len(rowValues)-1].(*any))
+		count := *(rowValues[len(groupBy)].(*any))
+		aggregationValues := make([]any, len(aggregations))
+		for i := range aggregations {
+			aggregationValues[i] = *(rowValues[len(groupBy)+1+i].(*any))
+		}
 		res = append(res, VisibilityCountRow{
-			GroupValues: groupValues,
-			Count:       count.(int64),
+			GroupValues:       groupValues,
+			Count:             count.(int64),
+			AggregationValues: aggregationValues,
 		})
 	}
 	return res, nil
@@ -98,6 +98,7 @@ func (s *VisibilityPersistenceSuite) SetupSuite() {
 		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff),
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+		dynamicconfig.GetIntPropertyFilteredByNamespace(1000),
 		metrics.NoopMetricsHandler,
 		s.Logger,
 	)
@@ -876,6 +877,35 @@ func (s *VisibilityPersistenceSuite) TestCountGroupByWorkflowExecutions() {
 	)
 	s.NoError(err)
 	s.Equal(int64(5), resp.Count)
+
+	// Running executions have no close time, so they are not counted in the date histogram.
+	completedStatusPayload, _ := searchattribute.EncodeValue(
+		enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED.String(),
+		enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	)
+	closeDayPayload, _ := searchattribute.EncodeValue(
+		time.Date(closeTime.Year(), closeTime.Month(), closeTime.Day(), 0, 0, 0, 0, time.UTC),
+		enumspb.INDEXED_VALUE_TYPE_DATETIME,
+	)
+	maxHistoryLengthPayload, _ := searchattribute.EncodeValue(int64(5), enumspb.INDEXED_VALUE_TYPE_INT)
+	resp, err = s.VisibilityMgr.CountWorkflowExecutions(
+		s.ctx,
+		&manager.CountWorkflowExecutionsRequest{
+			NamespaceID: testNamespaceUUID,
+			Query:       "GROUP BY ExecutionStatus, date_histogram(CloseTime, 'day'), max(HistoryLength)",
+		},
+	)
+	s.NoError(err)
+	s.Equal(int64(2), resp.Count)
+	s.Equal(
+		[]*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+			{
+				GroupValues: []*commonpb.Payload{completedStatusPayload, closeDayPayload, maxHistoryLengthPayload},
+				Count:       int64(2),
+			},
+		},
+		resp.Groups,
+	)
 }
 
 func (s *VisibilityPersistenceSuite) listWithPagination(namespaceID namespace.ID, pageSize int) []*workflowpb.WorkflowExecutionInfo {
@@ -26,6 +26,7 @@ package visibility
 
 import (
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
@@ -40,6 +41,12 @@ const (
 	SecondaryVisibilityWritingModeDual = "dual"
 )
 
+const (
+	// SecondaryVisibilityReadCutoverDataKey is the namespace data key set by the visibility migration
+	// workflow once the secondary visibility store is verified to be in parity with the primary one.
+	SecondaryVisibilityReadCutoverDataKey = "temporal.io/visibility-read-from-secondary"
+)
+
 // DefaultAdvancedVisibilityWritingMode returns default advancedVisibilityWritingMode based on whether related config exists in static config file.
 func DefaultAdvancedVisibilityWritingMode(advancedVisibilityConfigExist bool) string {
 	if advancedVisibilityConfigExist {
@@ -103,6 +110,24 @@ func GetEnableReadFromSecondaryVisibilityConfig(
 	return dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
 }
 
+// WithSecondaryVisibilityReadCutover extends enableReadFromSecondaryVisibility to also read from secondary
+// visibility for namespaces which were cut over by the visibility migration workflow.
+func WithSecondaryVisibilityReadCutover(
+	enableReadFromSecondaryVisibility dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	namespaceRegistry namespace.Registry,
+) dynamicconfig.BoolPropertyFnWithNamespaceFilter {
+	return func(nsName string) bool {
+		if enableReadFromSecondaryVisibility(nsName) {
+			return true
+		}
+		ns, err := namespaceRegistry.GetNamespace(namespace.Name(nsName))
+		if err != nil {
+			return false
+		}
+		return ns.GetCustomData(SecondaryVisibilityReadCutoverDataKey) == "true"
+	}
+}
+
 //nolint:revive
 func GetSecondaryVisibilityWritingModeConfig(
 	dc *dynamicconfig.Collection,
@@ -0,0 +1,61 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+)
+
+func TestWithSecondaryVisibilityReadCutover(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+
+	cutoverNamespace := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{
+			Name: "cutover",
+			Data: map[string]string{SecondaryVisibilityReadCutoverDataKey: "true"},
+		},
+		nil,
+		"",
+	)
+	namespaceRegistry.EXPECT().GetNamespace(namespace.Name("cutover")).Return(cutoverNamespace, nil).AnyTimes()
+	namespaceRegistry.EXPECT().GetNamespace(namespace.Name("not-cutover")).Return(
+		namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Name: "not-cutover"}, nil, ""), nil).AnyTimes()
+	namespaceRegistry.EXPECT().GetNamespace(namespace.Name("unknown")).Return(nil, serviceerror.NewNamespaceNotFound("unknown")).AnyTimes()
+
+	readFromSecondary := WithSecondaryVisibilityReadCutover(dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false), namespaceRegistry)
+	require.True(t, readFromSecondary("cutover"))
+	require.False(t, readFromSecondary("not-cutover"))
+	require.False(t, readFromSecondary("unknown"))
+
+	readFromSecondary = WithSecondaryVisibilityReadCutover(dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true), namespaceRegistry)
+	require.True(t, readFromSecondary("not-cutover"))
+}
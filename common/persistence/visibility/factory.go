@@ -71,6 +71,7 @@ func NewManager(
 	secondaryVisibilityWritingMode dynamicconfig.StringPropertyFn,
 	visibilityDisableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	visibilityEnableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	visibilityMaxCountGroups dynamicconfig.IntPropertyFnWithNamespaceFilter,
 
 	metricsHandler metrics.Handler,
 	logger log.Logger,
@@ -88,6 +89,7 @@ func NewManager(
 		operatorRPSRatio,
 		visibilityDisableOrderByClause,
 		visibilityEnableManualPagination,
+		visibilityMaxCountGroups,
 		metricsHandler,
 		logger,
 	)
@@ -112,6 +114,7 @@ func NewManager(
 		operatorRPSRatio,
 		visibilityDisableOrderByClause,
 		visibilityEnableManualPagination,
+		visibilityMaxCountGroups,
 		metricsHandler,
 		logger,
 	)
@@ -203,6 +206,7 @@ func newVisibilityManagerFromDataStoreConfig(
 	operatorRPSRatio dynamicconfig.FloatPropertyFn,
 	visibilityDisableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	visibilityEnableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	visibilityMaxCountGroups dynamicconfig.IntPropertyFnWithNamespaceFilter,
 
 	metricsHandler metrics.Handler,
 	logger log.Logger,
@@ -217,6 +221,7 @@ func newVisibilityManagerFromDataStoreConfig(
 		searchAttributesMapperProvider,
 		visibilityDisableOrderByClause,
 		visibilityEnableManualPagination,
+		visibilityMaxCountGroups,
 		metricsHandler,
 		logger,
 	)
@@ -248,6 +253,7 @@ func newVisibilityStoreFromDataStoreConfig(
 	searchAttributesMapperProvider searchattribute.MapperProvider,
 	visibilityDisableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	visibilityEnableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	visibilityMaxCountGroups dynamicconfig.IntPropertyFnWithNamespaceFilter,
 
 	metricsHandler metrics.Handler,
 	logger log.Logger,
@@ -264,6 +270,7 @@ func newVisibilityStoreFromDataStoreConfig(
 				persistenceResolver,
 				searchAttributesProvider,
 				searchAttributesMapperProvider,
+				visibilityMaxCountGroups,
 				logger,
 			)
 		default:
@@ -294,6 +301,7 @@ func newVisibilityStoreFromDataStoreConfig(
 			searchAttributesMapperProvider,
 			visibilityDisableOrderByClause,
 			visibilityEnableManualPagination,
+			visibilityMaxCountGroups,
 			metricsHandler,
 			logger,
 		)
@@ -356,6 +364,7 @@ func newElasticsearchVisibilityStore(
 	searchAttributesMapperProvider searchattribute.MapperProvider,
 	visibilityDisableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	visibilityEnableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	visibilityMaxCountGroups dynamicconfig.IntPropertyFnWithNamespaceFilter,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) store.VisibilityStore {
@@ -381,6 +390,7 @@ func newElasticsearchVisibilityStore(
 		esProcessorAckTimeout,
 		visibilityDisableOrderByClause,
 		visibilityEnableManualPagination,
+		visibilityMaxCountGroups,
 		metricsHandler)
 	return s
 }
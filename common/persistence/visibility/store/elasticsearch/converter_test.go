@@ -37,19 +37,22 @@ import (
 )
 
 var errorCases = map[string]string{
-	"delete":                                 query.MalformedSqlQueryErrMessage,
-	"update x":                               query.MalformedSqlQueryErrMessage,
-	"insert ":                                query.MalformedSqlQueryErrMessage,
-	"insert into a values(1,2)":              query.NotSupportedErrMessage,
-	"update a set id = 1":                    query.NotSupportedErrMessage,
-	"delete from a where id=1":               query.NotSupportedErrMessage,
-	"select * from a where NOT(id=1)":        query.NotSupportedErrMessage,
-	"select * from a where 1 = 1":            query.InvalidExpressionErrMessage,
-	"select * from a where 1=a":              query.InvalidExpressionErrMessage,
-	"select * from a where zz(k=2)":          query.NotSupportedErrMessage,
-	"select * from a group by k, m":          query.NotSupportedErrMessage,
-	"select * from a group by k order by id": query.NotSupportedErrMessage,
-	"invalid query":                          query.MalformedSqlQueryErrMessage,
+	"delete":                                             query.MalformedSqlQueryErrMessage,
+	"update x":                                           query.MalformedSqlQueryErrMessage,
+	"insert ":                                            query.MalformedSqlQueryErrMessage,
+	"insert into a values(1,2)":                          query.NotSupportedErrMessage,
+	"update a set id = 1":                                query.NotSupportedErrMessage,
+	"delete from a where id=1":                           query.NotSupportedErrMessage,
+	"select * from a where NOT(id=1)":                    query.NotSupportedErrMessage,
+	"select * from a where 1 = 1":                        query.InvalidExpressionErrMessage,
+	"select * from a where 1=a":                          query.InvalidExpressionErrMessage,
+	"select * from a where zz(k=2)":                      query.NotSupportedErrMessage,
+	"select * from a group by k, k":                      query.InvalidExpressionErrMessage,
+	"select * from a group by avg(m), k":                 query.InvalidExpressionErrMessage,
+	"select * from a group by sum(m)":                    query.NotSupportedErrMessage,
+	"select * from a group by date_histogram(k, 'week')": query.InvalidExpressionErrMessage,
+	"select * from a group by k order by id":             query.NotSupportedErrMessage,
+	"invalid query":                                      query.MalformedSqlQueryErrMessage,
 	"select * from a where  a= 1 and multi_match(zz=1, query='this is a test', fields=(title,title.origin), type=phrase)": query.NotSupportedErrMessage,
 }
 
@@ -117,15 +120,31 @@ var supportedWhereOrderCases = map[string]struct {
 
 var supportedWhereGroupByCases = map[string]struct {
 	query   string
-	groupBy []string
+	groupBy []query.GroupByField
 }{
 	"group by status": {
 		query:   ``,
-		groupBy: []string{"status"},
+		groupBy: []query.GroupByField{{Name: "status"}},
 	},
 	"id = 1 group by status": {
 		query:   `{"bool":{"filter":{"match":{"id":{"query":1}}}}}`,
-		groupBy: []string{"status"},
+		groupBy: []query.GroupByField{{Name: "status"}},
+	},
+	"group by status, type": {
+		query:   ``,
+		groupBy: []query.GroupByField{{Name: "status"}, {Name: "type"}},
+	},
+	"group by date_histogram(start, 'Day'), max(duration), AVG(duration)": {
+		query: ``,
+		groupBy: []query.GroupByField{
+			{Name: "start", Interval: "day"},
+			{Name: "duration", Aggregation: "max"},
+			{Name: "duration", Aggregation: "avg"},
+		},
+	},
+	"group by min(duration)": {
+		query:   ``,
+		groupBy: []query.GroupByField{{Name: "duration", Aggregation: "min"}},
 	},
 }
 
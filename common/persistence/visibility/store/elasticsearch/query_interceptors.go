@@ -111,12 +111,9 @@ func (ni *nameInterceptor) Name(name string, usage query.FieldNameUsage) (string
 				enumspb.INDEXED_VALUE_TYPE_KEYWORD.String(),
 			)
 		}
-	case query.FieldNameGroupBy:
-		if fieldName != searchattribute.ExecutionStatus {
-			return "", query.NewConverterError(
-				"'group by' clause is only supported for %s search attribute",
-				searchattribute.ExecutionStatus,
-			)
+	case query.FieldNameGroupBy, query.FieldNameDateHistogram, query.FieldNameAggregation:
+		if err := query.ValidateGroupByFieldType(name, usage, fieldType); err != nil {
+			return "", err
 		}
 	}
 
@@ -60,6 +60,10 @@ const (
 	delimiter                    = "~"
 	scrollKeepAliveInterval      = "1m"
 	pointInTimeKeepAliveInterval = "1m"
+
+	// countAllAggName is the name of the aggregation used when there are only aggregations and no
+	// grouping terms in the 'group by' clause.
+	countAllAggName = "all"
 )
 
 type (
@@ -72,6 +76,7 @@ type (
 		processorAckTimeout            dynamicconfig.DurationPropertyFn
 		disableOrderByClause           dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		enableManualPagination         dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		maxCountGroups                 dynamicconfig.IntPropertyFnWithNamespaceFilter
 		metricsHandler                 metrics.Handler
 	}
 
@@ -139,6 +144,7 @@ func NewVisibilityStore(
 	processorAckTimeout dynamicconfig.DurationPropertyFn,
 	disableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	enableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	maxCountGroups dynamicconfig.IntPropertyFnWithNamespaceFilter,
 	metricsHandler metrics.Handler,
 ) *visibilityStore {
 
@@ -151,6 +157,7 @@ func NewVisibilityStore(
 		processorAckTimeout:            processorAckTimeout,
 		disableOrderByClause:           disableOrderByClause,
 		enableManualPagination:         enableManualPagination,
+		maxCountGroups:                 maxCountGroups,
 		metricsHandler:                 metricsHandler.WithTags(metrics.OperationTag(metrics.ElasticsearchVisibility)),
 	}
 }
@@ -621,7 +628,7 @@ func (s *visibilityStore) CountWorkflowExecutions(
 	}
 
 	if len(queryParams.GroupBy) > 0 {
-		return s.countGroupByWorkflowExecutions(ctx, queryParams)
+		return s.countGroupByWorkflowExecutions(ctx, queryParams, s.maxCountGroups(request.Namespace.String()))
 	}
 
 	count, err := s.esClient.Count(ctx, s.index, queryParams.Query)
@@ -636,11 +643,14 @@ func (s *visibilityStore) CountWorkflowExecutions(
 func (s *visibilityStore) countGroupByWorkflowExecutions(
 	ctx context.Context,
 	queryParams *query.QueryParams,
+	maxGroups int,
 ) (*manager.CountWorkflowExecutionsResponse, error) {
-	groupByFields := queryParams.GroupBy
+	groupingFields := query.GroupingFields(queryParams.GroupBy)
+	aggregationFields := query.AggregationFields(queryParams.GroupBy)
 
 	// Elasticsearch aggregation is nested. so need to loop backwards to build it.
-	// Example: when grouping by (field1, field2), the object looks like
+	// Aggregations are computed as metric sub-aggregations of the innermost grouping term.
+	// Example: when grouping by (field1, date_histogram(field2, 'day'), avg(field3)), the object looks like
 	// {
 	//   "aggs": {
 	//     "field1": {
@@ -648,32 +658,97 @@ func (s *visibilityStore) countGroupByWorkflowExecutions(
 	//         "field": "field1"
 	//       },
 	//       "aggs": {
-	//         "field2": {
-	//           "terms": {
-	//             "field": "field2"
+	//         "date_histogram(field2, 'day')": {
+	//           "date_histogram": {
+	//             "field": "field2",
+	//             "calendar_interval": "day"
+	//           },
+	//           "aggs": {
+	//             "avg(field3)": {
+	//               "avg": {
+	//                 "field": "field3"
+	//               }
+	//             }
 	//           }
 	//         }
 	//       }
 	//     }
 	//   }
 	// }
-	termsAgg := elastic.NewTermsAggregation().Field(groupByFields[len(groupByFields)-1])
-	for i := len(groupByFields) - 2; i >= 0; i-- {
-		termsAgg = elastic.NewTermsAggregation().
-			Field(groupByFields[i]).
-			SubAggregation(groupByFields[i+1], termsAgg)
+	// Requesting one bucket more than the limit for each term is enough to detect too many groups.
+	var agg elastic.Aggregation
+	var aggName string
+	for i := len(groupingFields) - 1; i >= 0; i-- {
+		field := groupingFields[i]
+		subAggs := map[string]elastic.Aggregation{}
+		if i == len(groupingFields)-1 {
+			for _, aggField := range aggregationFields {
+				subAggs[aggField.String()] = newMetricAggregation(aggField)
+			}
+		} else {
+			subAggs[aggName] = agg
+		}
+		if field.IsDateHistogram() {
+			histogramAgg := elastic.NewDateHistogramAggregation().
+				Field(field.Name).
+				CalendarInterval(field.Interval).
+				MinDocCount(1)
+			for name, subAgg := range subAggs {
+				histogramAgg.SubAggregation(name, subAgg)
+			}
+			agg = histogramAgg
+		} else {
+			termsAgg := elastic.NewTermsAggregation().
+				Field(field.Name).
+				Size(maxGroups + 1)
+			for name, subAgg := range subAggs {
+				termsAgg.SubAggregation(name, subAgg)
+			}
+			agg = termsAgg
+		}
+		aggName = field.String()
 	}
+	if len(groupingFields) == 0 {
+		// Only aggregations: all matching executions are in a single group.
+		filterAgg := elastic.NewFilterAggregation().Filter(elastic.NewMatchAllQuery())
+		for _, aggField := range aggregationFields {
+			filterAgg.SubAggregation(aggField.String(), newMetricAggregation(aggField))
+		}
+		agg = filterAgg
+		aggName = countAllAggName
+	}
+
 	esResponse, err := s.esClient.CountGroupBy(
 		ctx,
 		s.index,
 		queryParams.Query,
-		groupByFields[0],
-		termsAgg,
+		aggName,
+		agg,
 	)
 	if err != nil {
 		return nil, err
 	}
-	return s.parseCountGroupByResponse(esResponse, groupByFields)
+	response, err := s.parseCountGroupByResponse(esResponse, aggName, groupingFields, aggregationFields)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Groups) > maxGroups {
+		return nil, serviceerror.NewInvalidArgument(
+			fmt.Sprintf("Too many groups: query returned more than %d groups.", maxGroups),
+		)
+	}
+	return response, nil
+}
+
+func newMetricAggregation(field query.GroupByField) elastic.Aggregation {
+	switch field.Aggregation {
+	case query.MinFuncName:
+		return elastic.NewMinAggregation().Field(field.Name)
+	case query.MaxFuncName:
+		return elastic.NewMaxAggregation().Field(field.Name)
+	default:
+		return elastic.NewAvgAggregation().Field(field.Name)
+	}
 }
 
 func (s *visibilityStore) GetWorkflowExecution(
@@ -1203,7 +1278,9 @@ func (s *visibilityStore) parseESDoc(docID string, docSource json.RawMessage, sa
 //nolint:revive // cognitive complexity 27 (> max enabled 25)
 func (s *visibilityStore) parseCountGroupByResponse(
 	searchResult *elastic.SearchResult,
-	groupByFields []string,
+	aggName string,
+	groupingFields []query.GroupByField,
+	aggregationFields []query.GroupByField,
 ) (*manager.CountWorkflowExecutionsResponse, error) {
 	response := &manager.CountWorkflowExecutionsResponse{}
 	typeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
@@ -1212,14 +1289,22 @@ func (s *visibilityStore) parseCountGroupByResponse(
 			fmt.Sprintf("Unable to read search attribute types: %v", err),
 		)
 	}
-	groupByTypes := make([]enumspb.IndexedValueType, len(groupByFields))
-	for i, saName := range groupByFields {
-		tp, err := typeMap.GetType(saName)
+	groupByTypes := make([]enumspb.IndexedValueType, len(groupingFields))
+	for i, field := range groupingFields {
+		tp, err := typeMap.GetType(field.Name)
 		if err != nil {
 			return nil, err
 		}
 		groupByTypes[i] = tp
 	}
+	aggregationTypes := make([]enumspb.IndexedValueType, len(aggregationFields))
+	for i, field := range aggregationFields {
+		tp, err := typeMap.GetType(field.Name)
+		if err != nil {
+			return nil, err
+		}
+		aggregationTypes[i] = query.AggregationType(field, tp)
+	}
 
 	parseJsonNumber := func(val any) (int64, error) {
 		numberVal, isNumber := val.(json.Number)
@@ -1231,15 +1316,27 @@ func (s *visibilityStore) parseCountGroupByResponse(
 
 	var parseInternal func(map[string]any, []*commonpb.Payload) error
 	parseInternal = func(aggs map[string]any, bucketValues []*commonpb.Payload) error {
-		if len(bucketValues) == len(groupByFields) {
+		if len(bucketValues) == len(groupingFields) {
 			cnt, err := parseJsonNumber(aggs["doc_count"])
 			if err != nil {
 				return fmt.Errorf("Unable to parse 'doc_count' field: %w", err)
 			}
-			groupValues := make([]*commonpb.Payload, len(groupByFields))
+			groupValues := make([]*commonpb.Payload, len(groupingFields), len(groupingFields)+len(aggregationFields))
 			for i := range bucketValues {
 				groupValues[i] = bucketValues[i]
 			}
+			for i, field := range aggregationFields {
+				metricAgg, _ := aggs[field.String()].(map[string]any)
+				value, err := parseMetricAggregationValue(metricAgg["value"], aggregationTypes[i])
+				if err != nil {
+					return fmt.Errorf("Failed to parse value of %s: %w", field.String(), err)
+				}
+				payload, err := searchattribute.EncodeValue(value, aggregationTypes[i])
+				if err != nil {
+					return fmt.Errorf("Failed to encode value %v: %w", value, err)
+				}
+				groupValues = append(groupValues, payload)
+			}
 			response.Groups = append(
 				response.Groups,
 				&workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
@@ -1252,13 +1349,18 @@ func (s *visibilityStore) parseCountGroupByResponse(
 		}
 
 		index := len(bucketValues)
-		fieldName := groupByFields[index]
-		buckets := aggs[fieldName].(map[string]any)["buckets"].([]any)
+		field := groupingFields[index]
+		buckets := aggs[field.String()].(map[string]any)["buckets"].([]any)
 		for i := range buckets {
 			bucket := buckets[i].(map[string]any)
-			value, err := finishParseJSONValue(bucket["key"], groupByTypes[index])
+			// Date histogram buckets keys are epoch milliseconds, and are formatted in "key_as_string".
+			key := bucket["key"]
+			if field.IsDateHistogram() {
+				key = bucket["key_as_string"]
+			}
+			value, err := finishParseJSONValue(key, groupByTypes[index])
 			if err != nil {
-				return fmt.Errorf("Failed to parse value %v: %w", bucket["key"], err)
+				return fmt.Errorf("Failed to parse value %v: %w", key, err)
 			}
 			payload, err := searchattribute.EncodeValue(value, groupByTypes[index])
 			if err != nil {
@@ -1273,26 +1375,44 @@ func (s *visibilityStore) parseCountGroupByResponse(
 	}
 
 	var bucketsJson map[string]any
-	dec := json.NewDecoder(bytes.NewReader(searchResult.Aggregations[groupByFields[0]]))
+	dec := json.NewDecoder(bytes.NewReader(searchResult.Aggregations[aggName]))
 	dec.UseNumber()
 	if err := dec.Decode(&bucketsJson); err != nil {
 		return nil, serviceerror.NewInternal(fmt.Sprintf("unable to unmarshal json response: %v", err))
 	}
-	if err := parseInternal(map[string]any{groupByFields[0]: bucketsJson}, nil); err != nil {
+	if len(groupingFields) == 0 {
+		// Aggregations without grouping terms are computed in a single bucket.
+		if err := parseInternal(bucketsJson, nil); err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+	if err := parseInternal(map[string]any{aggName: bucketsJson}, nil); err != nil {
 		return nil, err
 	}
 	return response, nil
 }
 
-// finishParseJSONValue finishes JSON parsing after json.Decode.
-// json.Decode returns:
-//
-//	bool, for JSON booleans
-//	json.Number, for JSON numbers (because of d.UseNumber())
-//	string, for JSON strings
-//	[]interface{}, for JSON arrays
-//	map[string]interface{}, for JSON objects (should never be a case)
-//	nil for JSON null
+// parseMetricAggregationValue parses the value of a metric aggregation, which is null if there
+// are no values to aggregate. Elasticsearch returns metric values as doubles even for long fields.
+func parseMetricAggregationValue(val any, t enumspb.IndexedValueType) (any, error) {
+	if val == nil {
+		return nil, nil
+	}
+	numberVal, isNumber := val.(json.Number)
+	if !isNumber {
+		return nil, fmt.Errorf("%w: expected json.Number got %T", errUnexpectedJSONFieldType, val)
+	}
+	floatVal, err := numberVal.Float64()
+	if err != nil {
+		return nil, err
+	}
+	if t == enumspb.INDEXED_VALUE_TYPE_INT {
+		return int64(floatVal), nil
+	}
+	return floatVal, nil
+}
+
 func finishParseJSONValue(val interface{}, t enumspb.IndexedValueType) (interface{}, error) {
 	// Custom search attributes support array of particular type.
 	if arrayValue, isArray := val.([]interface{}); isArray {
@@ -84,6 +84,8 @@ var (
 	testRunID        = "test-rid"
 	testStatus       = enumspb.WORKFLOW_EXECUTION_STATUS_FAILED
 
+	testMaxCountGroups = 10
+
 	testSearchResult = &elastic.SearchResult{
 		Hits: &elastic.SearchHits{},
 	}
@@ -127,6 +129,7 @@ func (s *ESVisibilitySuite) SetupTest() {
 	esProcessorAckTimeout := dynamicconfig.GetDurationPropertyFn(1 * time.Minute * debug.TimeoutMultiplier)
 	visibilityDisableOrderByClause := dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
 	visibilityEnableManualPagination := dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	visibilityMaxCountGroups := dynamicconfig.GetIntPropertyFilteredByNamespace(testMaxCountGroups)
 
 	s.controller = gomock.NewController(s.T())
 	s.mockMetricsHandler = metrics.NewMockHandler(s.controller)
@@ -143,6 +146,7 @@ func (s *ESVisibilitySuite) SetupTest() {
 		esProcessorAckTimeout,
 		visibilityDisableOrderByClause,
 		visibilityEnableManualPagination,
+		visibilityMaxCountGroups,
 		s.mockMetricsHandler,
 	)
 }
@@ -1403,7 +1407,7 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
 				Filter(elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String())).
 				MustNot(namespaceDivisionExists),
 			searchattribute.ExecutionStatus,
-			elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus),
+			elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(testMaxCountGroups+1),
 		).
 		Return(
 			&elastic.SearchResult{
@@ -1442,18 +1446,32 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
 		resp),
 	)
 
-	// test only allowed to group by a single field
-	request.Query = "GROUP BY ExecutionStatus, WorkflowType"
+	// test only allowed to group by Keyword search attributes
+	request.Query = "GROUP BY StartTime"
+	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.Error(err)
+	s.Contains(err.Error(), "search attribute 'StartTime' in 'group by' clause must be of type Keyword, got Datetime")
+	s.Nil(resp)
+
+	// test only allowed to build date histograms over Datetime search attributes
+	request.Query = "GROUP BY date_histogram(WorkflowType, 'day')"
 	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
 	s.Error(err)
-	s.Contains(err.Error(), "'group by' clause supports only a single field")
+	s.Contains(err.Error(), "search attribute 'WorkflowType' in 'group by' clause must be of type Datetime, got Keyword")
 	s.Nil(resp)
 
-	// test only allowed to group by ExecutionStatus
-	request.Query = "GROUP BY WorkflowType"
+	// test only allowed to aggregate Int and Double search attributes
+	request.Query = "GROUP BY ExecutionStatus, max(StartTime)"
 	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
 	s.Error(err)
-	s.Contains(err.Error(), "'group by' clause is only supported for ExecutionStatus search attribute")
+	s.Contains(err.Error(), "search attribute 'StartTime' in 'group by' clause must be of type Int or Double, got Datetime")
+	s.Nil(resp)
+
+	// test aggregations must come after grouping terms
+	request.Query = "GROUP BY avg(ExecutionDuration), ExecutionStatus"
+	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.Error(err)
+	s.Contains(err.Error(), "aggregations must come after all grouping terms in 'group by' clause")
 	s.Nil(resp)
 }
 
@@ -1474,19 +1492,41 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 	wfId4Payload, _ := searchattribute.EncodeValue("wf-id-4", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
 	wfId5Payload, _ := searchattribute.EncodeValue("wf-id-5", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
 
+	day1Payload, _ := searchattribute.EncodeValue(
+		time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		enumspb.INDEXED_VALUE_TYPE_DATETIME,
+	)
+	day2Payload, _ := searchattribute.EncodeValue(
+		time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+		enumspb.INDEXED_VALUE_TYPE_DATETIME,
+	)
+	avgDuration1Payload, _ := searchattribute.EncodeValue(1.5e9, enumspb.INDEXED_VALUE_TYPE_DOUBLE)
+	avgDuration2Payload, _ := searchattribute.EncodeValue(nil, enumspb.INDEXED_VALUE_TYPE_DOUBLE)
+	maxDuration1Payload, _ := searchattribute.EncodeValue(int64(2e9), enumspb.INDEXED_VALUE_TYPE_INT)
+	maxDuration2Payload, _ := searchattribute.EncodeValue(nil, enumspb.INDEXED_VALUE_TYPE_INT)
+
+	statusGroupBy := query.GroupByField{Name: searchattribute.ExecutionStatus}
+	wfTypeGroupBy := query.GroupByField{Name: searchattribute.WorkflowType}
+	wfIdGroupBy := query.GroupByField{Name: searchattribute.WorkflowID}
+	startTimeDayGroupBy := query.GroupByField{Name: searchattribute.StartTime, Interval: "day"}
+	avgDurationGroupBy := query.GroupByField{Name: searchattribute.ExecutionDuration, Aggregation: query.AvgFuncName}
+	maxDurationGroupBy := query.GroupByField{Name: searchattribute.ExecutionDuration, Aggregation: query.MaxFuncName}
+
 	testCases := []struct {
 		name         string
-		groupBy      []string
+		groupBy      []query.GroupByField
+		maxGroups    int
 		aggName      string
 		agg          elastic.Aggregation
 		mockResponse *elastic.SearchResult
 		response     *manager.CountWorkflowExecutionsResponse
+		err          string
 	}{
 		{
 			name:    "group by one field",
-			groupBy: []string{searchattribute.ExecutionStatus},
+			groupBy: []query.GroupByField{statusGroupBy},
 			aggName: searchattribute.ExecutionStatus,
-			agg:     elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus),
+			agg:     elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(testMaxCountGroups + 1),
 			mockResponse: &elastic.SearchResult{
 				Aggregations: map[string]json.RawMessage{
 					searchattribute.ExecutionStatus: json.RawMessage(
@@ -1522,11 +1562,11 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 
 		{
 			name:    "group by two fields",
-			groupBy: []string{searchattribute.ExecutionStatus, searchattribute.WorkflowType},
+			groupBy: []query.GroupByField{statusGroupBy, wfTypeGroupBy},
 			aggName: searchattribute.ExecutionStatus,
-			agg: elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).SubAggregation(
+			agg: elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(testMaxCountGroups+1).SubAggregation(
 				searchattribute.WorkflowType,
-				elastic.NewTermsAggregation().Field(searchattribute.WorkflowType),
+				elastic.NewTermsAggregation().Field(searchattribute.WorkflowType).Size(testMaxCountGroups+1),
 			),
 			mockResponse: &elastic.SearchResult{
 				Aggregations: map[string]json.RawMessage{
@@ -1594,18 +1634,15 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 		},
 
 		{
-			name: "group by three fields",
-			groupBy: []string{
-				searchattribute.ExecutionStatus,
-				searchattribute.WorkflowType,
-				searchattribute.WorkflowID,
-			},
-			aggName: searchattribute.ExecutionStatus,
-			agg: elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).SubAggregation(
+			name:      "group by three fields",
+			groupBy:   []query.GroupByField{statusGroupBy, wfTypeGroupBy, wfIdGroupBy},
+			maxGroups: 5,
+			aggName:   searchattribute.ExecutionStatus,
+			agg: elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(6).SubAggregation(
 				searchattribute.WorkflowType,
-				elastic.NewTermsAggregation().Field(searchattribute.WorkflowType).SubAggregation(
+				elastic.NewTermsAggregation().Field(searchattribute.WorkflowType).Size(6).SubAggregation(
 					searchattribute.WorkflowID,
-					elastic.NewTermsAggregation().Field(searchattribute.WorkflowID),
+					elastic.NewTermsAggregation().Field(searchattribute.WorkflowID).Size(6),
 				),
 			),
 			mockResponse: &elastic.SearchResult{
@@ -1712,6 +1749,117 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 				},
 			},
 		},
+		{
+			name:    "group by date histogram with aggregations",
+			groupBy: []query.GroupByField{startTimeDayGroupBy, avgDurationGroupBy, maxDurationGroupBy},
+			aggName: "date_histogram(StartTime, 'day')",
+			agg: elastic.NewDateHistogramAggregation().
+				Field(searchattribute.StartTime).
+				CalendarInterval("day").
+				MinDocCount(1).
+				SubAggregation(
+					"avg(ExecutionDuration)",
+					elastic.NewAvgAggregation().Field(searchattribute.ExecutionDuration),
+				).
+				SubAggregation(
+					"max(ExecutionDuration)",
+					elastic.NewMaxAggregation().Field(searchattribute.ExecutionDuration),
+				),
+			mockResponse: &elastic.SearchResult{
+				Aggregations: map[string]json.RawMessage{
+					"date_histogram(StartTime, 'day')": json.RawMessage(
+						`{
+							"buckets":[
+								{
+									"key_as_string": "2023-01-01T00:00:00.000Z",
+									"key": 1672531200000,
+									"doc_count": 100,
+									"avg(ExecutionDuration)": {
+										"value": 1.5E9
+									},
+									"max(ExecutionDuration)": {
+										"value": 2.0E9
+									}
+								},
+								{
+									"key_as_string": "2023-01-02T00:00:00.000Z",
+									"key": 1672617600000,
+									"doc_count": 10,
+									"avg(ExecutionDuration)": {
+										"value": null
+									},
+									"max(ExecutionDuration)": {
+										"value": null
+									}
+								}
+							]
+						}`,
+					),
+				},
+			},
+			response: &manager.CountWorkflowExecutionsResponse{
+				Count: 110,
+				Groups: []*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+					{
+						GroupValues: []*commonpb.Payload{day1Payload, avgDuration1Payload, maxDuration1Payload},
+						Count:       100,
+					},
+					{
+						GroupValues: []*commonpb.Payload{day2Payload, avgDuration2Payload, maxDuration2Payload},
+						Count:       10,
+					},
+				},
+			},
+		},
+
+		{
+			name:    "aggregations only",
+			groupBy: []query.GroupByField{avgDurationGroupBy},
+			aggName: "all",
+			agg: elastic.NewFilterAggregation().
+				Filter(elastic.NewMatchAllQuery()).
+				SubAggregation(
+					"avg(ExecutionDuration)",
+					elastic.NewAvgAggregation().Field(searchattribute.ExecutionDuration),
+				),
+			mockResponse: &elastic.SearchResult{
+				Aggregations: map[string]json.RawMessage{
+					"all": json.RawMessage(
+						`{
+							"doc_count": 110,
+							"avg(ExecutionDuration)": {
+								"value": 1.5E9
+							}
+						}`,
+					),
+				},
+			},
+			response: &manager.CountWorkflowExecutionsResponse{
+				Count: 110,
+				Groups: []*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+					{
+						GroupValues: []*commonpb.Payload{avgDuration1Payload},
+						Count:       110,
+					},
+				},
+			},
+		},
+
+		{
+			name:      "too many groups",
+			groupBy:   []query.GroupByField{statusGroupBy},
+			maxGroups: 1,
+			aggName:   searchattribute.ExecutionStatus,
+			agg:       elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(2),
+			mockResponse: &elastic.SearchResult{
+				Aggregations: map[string]json.RawMessage{
+					searchattribute.ExecutionStatus: json.RawMessage(
+						`{"buckets":[{"key":"Completed","doc_count":100},{"key":"Running","doc_count":10}]}`,
+					),
+				},
+			},
+			err: "Too many groups: query returned more than 1 groups.",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1733,7 +1881,17 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 					tc.agg,
 				).
 				Return(tc.mockResponse, nil)
-			resp, err := s.visibilityStore.countGroupByWorkflowExecutions(context.Background(), searchParams)
+			maxGroups := tc.maxGroups
+			if maxGroups == 0 {
+				maxGroups = testMaxCountGroups
+			}
+			resp, err := s.visibilityStore.countGroupByWorkflowExecutions(context.Background(), searchParams, maxGroups)
+			if tc.err != "" {
+				s.Error(err)
+				s.Contains(err.Error(), tc.err)
+				s.Nil(resp)
+				return
+			}
 			s.NoError(err)
 			s.True(temporalproto.DeepEqual(tc.response, resp))
 		})
@@ -1941,6 +2099,7 @@ func (s *ESVisibilitySuite) TestProcessPageToken() {
 				dynamicconfig.GetDurationPropertyFn(1*time.Minute*debug.TimeoutMultiplier),
 				dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 				dynamicconfig.GetBoolPropertyFnFilteredByNamespace(tc.manualPagination),
+				dynamicconfig.GetIntPropertyFilteredByNamespace(testMaxCountGroups),
 				s.mockMetricsHandler,
 			)
 			params := &client.SearchParameters{
@@ -84,7 +84,7 @@ type (
 	QueryParams struct {
 		Query   elastic.Query
 		Sorter  []elastic.Sorter
-		GroupBy []string
+		GroupBy []GroupByField
 	}
 )
 
@@ -239,15 +239,20 @@ func (c *Converter) convertSelect(sel *sqlparser.Select) (*QueryParams, error) {
 		queryParams.Query = query
 	}
 
-	if len(sel.GroupBy) > 1 {
-		return nil, NewConverterError("%s: 'group by' clause supports only a single field", NotSupportedErrMessage)
-	}
 	for _, groupByExpr := range sel.GroupBy {
-		colName, err := convertColName(c.fnInterceptor, groupByExpr, FieldNameGroupBy)
+		colNameExpr, interval, aggregation, err := ParseGroupByExpr(groupByExpr)
+		if err != nil {
+			return nil, err
+		}
+		field := GroupByField{Interval: interval, Aggregation: aggregation}
+		field.Name, err = convertColName(c.fnInterceptor, colNameExpr, field.FieldNameUsage())
 		if err != nil {
 			return nil, wrapConverterError("unable to convert 'group by' column name", err)
 		}
-		queryParams.GroupBy = append(queryParams.GroupBy, colName)
+		queryParams.GroupBy = append(queryParams.GroupBy, field)
+	}
+	if err := ValidateGroupBy(queryParams.GroupBy); err != nil {
+		return nil, err
 	}
 
 	for _, orderByExpr := range sel.OrderBy {
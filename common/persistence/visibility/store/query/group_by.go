@@ -0,0 +1,243 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/temporalio/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+	"golang.org/x/exp/slices"
+)
+
+type (
+	// GroupByField is a term of a 'group by' clause. It's either a search attribute to group by,
+	// a date histogram of a Datetime search attribute, or an aggregation computed for each group.
+	GroupByField struct {
+		// Name is the field name of the search attribute.
+		Name string
+		// Interval is the interval of the buckets of a date histogram, empty for other terms.
+		Interval string
+		// Aggregation is the aggregation function of an aggregation, empty for other terms.
+		Aggregation string
+	}
+)
+
+const (
+	DateHistogramFuncName = "date_histogram"
+	MinFuncName           = "min"
+	MaxFuncName           = "max"
+	AvgFuncName           = "avg"
+)
+
+var (
+	// SupportedDateHistogramIntervals are the calendar intervals supported by date histograms.
+	SupportedDateHistogramIntervals = []string{"minute", "hour", "day", "month", "year"}
+
+	supportedAggregationFuncNames = []string{MinFuncName, MaxFuncName, AvgFuncName}
+)
+
+// IsAggregation returns whether the term is an aggregation rather than a grouping term.
+func (f GroupByField) IsAggregation() bool {
+	return f.Aggregation != ""
+}
+
+// IsDateHistogram returns whether the term groups by buckets of a date histogram.
+func (f GroupByField) IsDateHistogram() bool {
+	return f.Interval != ""
+}
+
+func (f GroupByField) String() string {
+	switch {
+	case f.IsDateHistogram():
+		return fmt.Sprintf("%s(%s, '%s')", DateHistogramFuncName, f.Name, f.Interval)
+	case f.IsAggregation():
+		return fmt.Sprintf("%s(%s)", f.Aggregation, f.Name)
+	default:
+		return f.Name
+	}
+}
+
+// ParseGroupByExpr parses a term of a 'group by' clause. It returns the column name expression of the
+// term, the interval if it's a date histogram, and the function name if it's an aggregation.
+func ParseGroupByExpr(expr sqlparser.Expr) (sqlparser.Expr, string, string, error) {
+	funcExpr, isFuncExpr := expr.(*sqlparser.FuncExpr)
+	if !isFuncExpr {
+		return expr, "", "", nil
+	}
+	funcName := funcExpr.Name.Lowered()
+	if funcExpr.Distinct || !funcExpr.Qualifier.IsEmpty() {
+		return nil, "", "", NewConverterError(
+			"%s: function '%s' in 'group by' clause",
+			NotSupportedErrMessage,
+			sqlparser.String(funcExpr),
+		)
+	}
+	args := make([]sqlparser.Expr, len(funcExpr.Exprs))
+	for i, arg := range funcExpr.Exprs {
+		aliasedExpr, isAliasedExpr := arg.(*sqlparser.AliasedExpr)
+		if !isAliasedExpr {
+			return nil, "", "", NewConverterError(
+				"%s: invalid argument '%s' of function '%s'",
+				InvalidExpressionErrMessage,
+				sqlparser.String(arg),
+				funcName,
+			)
+		}
+		args[i] = aliasedExpr.Expr
+	}
+
+	switch {
+	case funcName == DateHistogramFuncName:
+		if len(args) != 2 {
+			return nil, "", "", NewConverterError(
+				"%s: function '%s' expects a search attribute and an interval",
+				InvalidExpressionErrMessage,
+				DateHistogramFuncName,
+			)
+		}
+		interval, isSQLVal := args[1].(*sqlparser.SQLVal)
+		if !isSQLVal || interval.Type != sqlparser.StrVal ||
+			!slices.Contains(SupportedDateHistogramIntervals, strings.ToLower(string(interval.Val))) {
+			return nil, "", "", NewConverterError(
+				"%s: interval of function '%s' must be one of %s",
+				InvalidExpressionErrMessage,
+				DateHistogramFuncName,
+				strings.Join(SupportedDateHistogramIntervals, ", "),
+			)
+		}
+		return args[0], strings.ToLower(string(interval.Val)), "", nil
+	case slices.Contains(supportedAggregationFuncNames, funcName):
+		if len(args) != 1 {
+			return nil, "", "", NewConverterError(
+				"%s: function '%s' expects a single search attribute",
+				InvalidExpressionErrMessage,
+				funcName,
+			)
+		}
+		return args[0], "", funcName, nil
+	default:
+		return nil, "", "", NewConverterError(
+			"%s: function '%s' in 'group by' clause",
+			NotSupportedErrMessage,
+			funcName,
+		)
+	}
+}
+
+// FieldNameUsage returns how the search attribute of the term is used.
+func (f GroupByField) FieldNameUsage() FieldNameUsage {
+	switch {
+	case f.IsDateHistogram():
+		return FieldNameDateHistogram
+	case f.IsAggregation():
+		return FieldNameAggregation
+	default:
+		return FieldNameGroupBy
+	}
+}
+
+// ValidateGroupByFieldType validates the type of a search attribute used in a 'group by' clause.
+// Search attributes are grouped by if they are of Keyword type, date histograms are built over
+// Datetime search attributes, and aggregations are computed over Int and Double ones.
+func ValidateGroupByFieldType(name string, usage FieldNameUsage, saType enumspb.IndexedValueType) error {
+	var allowedTypes []enumspb.IndexedValueType
+	switch usage {
+	case FieldNameGroupBy:
+		allowedTypes = []enumspb.IndexedValueType{enumspb.INDEXED_VALUE_TYPE_KEYWORD}
+	case FieldNameDateHistogram:
+		allowedTypes = []enumspb.IndexedValueType{enumspb.INDEXED_VALUE_TYPE_DATETIME}
+	case FieldNameAggregation:
+		allowedTypes = []enumspb.IndexedValueType{enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DOUBLE}
+	default:
+		return nil
+	}
+	if !slices.Contains(allowedTypes, saType) {
+		typeNames := make([]string, len(allowedTypes))
+		for i, allowedType := range allowedTypes {
+			typeNames[i] = allowedType.String()
+		}
+		return NewConverterError(
+			"%s: search attribute '%s' in 'group by' clause must be of type %s, got %s",
+			NotSupportedErrMessage,
+			name,
+			strings.Join(typeNames, " or "),
+			saType.String(),
+		)
+	}
+	return nil
+}
+
+// ValidateGroupBy validates the terms of a 'group by' clause, where aggregations must come after
+// all grouping terms, and terms can't be repeated.
+func ValidateGroupBy(fields []GroupByField) error {
+	seen := make(map[GroupByField]struct{}, len(fields))
+	for i, field := range fields {
+		if _, ok := seen[field]; ok {
+			return NewConverterError(
+				"%s: '%s' is repeated in 'group by' clause",
+				InvalidExpressionErrMessage,
+				field.String(),
+			)
+		}
+		seen[field] = struct{}{}
+		if i > 0 && fields[i-1].IsAggregation() && !field.IsAggregation() {
+			return NewConverterError(
+				"%s: aggregations must come after all grouping terms in 'group by' clause",
+				InvalidExpressionErrMessage,
+			)
+		}
+	}
+	return nil
+}
+
+// GroupingFields returns the terms of a 'group by' clause which aren't aggregations.
+func GroupingFields(fields []GroupByField) []GroupByField {
+	var res []GroupByField
+	for _, field := range fields {
+		if !field.IsAggregation() {
+			res = append(res, field)
+		}
+	}
+	return res
+}
+
+// AggregationFields returns the aggregations of a 'group by' clause.
+func AggregationFields(fields []GroupByField) []GroupByField {
+	var res []GroupByField
+	for _, field := range fields {
+		if field.IsAggregation() {
+			res = append(res, field)
+		}
+	}
+	return res
+}
+
+// AggregationType returns the type of the values of an aggregation over a search attribute of type saType.
+func AggregationType(field GroupByField, saType enumspb.IndexedValueType) enumspb.IndexedValueType {
+	if field.Aggregation == AvgFuncName {
+		return enumspb.INDEXED_VALUE_TYPE_DOUBLE
+	}
+	return saType
+}
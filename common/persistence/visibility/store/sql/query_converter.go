@@ -54,10 +54,19 @@ type (
 			token *pageToken,
 		) (string, []any)
 
-		buildCountStmt(namespaceID namespace.ID, queryString string, groupBy []string) (string, []any)
+		buildCountStmt(
+			namespaceID namespace.ID,
+			queryString string,
+			groupBy []string,
+			aggregations []string,
+			limit int,
+		) (string, []any)
 
 		getDatetimeFormat() string
 
+		// getDateHistogramExpr returns the expression truncating the datetime column to the interval.
+		getDateHistogramExpr(colName string, interval string) string
+
 		getCoalesceCloseTimeExpr() sqlparser.Expr
 	}
 
@@ -74,8 +83,8 @@ type (
 
 	queryParams struct {
 		queryString string
-		// List of search attributes to group by (field name, not db name).
-		groupBy []string
+		// List of terms of the 'group by' clause (field name, not db name).
+		groupBy []query.GroupByField
 	}
 )
 
@@ -179,21 +188,62 @@ func (c *QueryConverter) BuildSelectStmt(
 	return &sqlplugin.VisibilitySelectFilter{Query: queryString, QueryArgs: queryArgs}, nil
 }
 
-func (c *QueryConverter) BuildCountStmt() (*sqlplugin.VisibilitySelectFilter, error) {
+// BuildCountStmt builds the count statement of the query. If the query has a 'group by' clause,
+// the statement returns at most maxGroups+1 groups, so the caller can detect too many groups.
+// It also returns the terms of the 'group by' clause.
+func (c *QueryConverter) BuildCountStmt(
+	maxGroups int,
+) (*sqlplugin.VisibilitySelectFilter, []query.GroupByField, error) {
 	qp, err := c.convertWhereString(c.queryString)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	groupByDbNames := make([]string, len(qp.groupBy))
-	for i, fieldName := range qp.groupBy {
-		groupByDbNames[i] = searchattribute.GetSqlDbColName(fieldName)
+
+	var (
+		groupByExprs      []string
+		groupByFields     []string
+		aggregationExprs  []string
+		aggregationFields []string
+		whereClauses      []string
+		limit             int
+	)
+	if len(qp.queryString) > 0 {
+		whereClauses = append(whereClauses, qp.queryString)
+	}
+	for _, field := range qp.groupBy {
+		dbColName := searchattribute.GetSqlDbColName(field.Name)
+		switch {
+		case field.IsAggregation():
+			aggregationExprs = append(
+				aggregationExprs,
+				fmt.Sprintf("%s(%s)", strings.ToUpper(field.Aggregation), dbColName),
+			)
+			aggregationFields = append(aggregationFields, field.Name)
+			continue
+		case field.IsDateHistogram():
+			groupByExprs = append(groupByExprs, c.getDateHistogramExpr(dbColName, field.Interval))
+		default:
+			groupByExprs = append(groupByExprs, dbColName)
+		}
+		groupByFields = append(groupByFields, field.Name)
+		// Like Elasticsearch, executions without a value for a grouping term are not counted.
+		whereClauses = append(whereClauses, fmt.Sprintf("%s is not null", dbColName))
+		limit = maxGroups + 1
 	}
-	queryString, queryArgs := c.buildCountStmt(c.namespaceID, qp.queryString, groupByDbNames)
+
+	queryString, queryArgs := c.buildCountStmt(
+		c.namespaceID,
+		strings.Join(whereClauses, " and "),
+		groupByExprs,
+		aggregationExprs,
+		limit,
+	)
 	return &sqlplugin.VisibilitySelectFilter{
-		Query:     queryString,
-		QueryArgs: queryArgs,
-		GroupBy:   qp.groupBy,
-	}, nil
+		Query:        queryString,
+		QueryArgs:    queryArgs,
+		GroupBy:      groupByFields,
+		Aggregations: aggregationFields,
+	}, qp.groupBy, nil
 }
 
 func (c *QueryConverter) convertWhereString(queryString string) (*queryParams, error) {
@@ -211,30 +261,25 @@ func (c *QueryConverter) convertWhereString(queryString string) (*queryParams, e
 	}
 
 	selectStmt, _ := stmt.(*sqlparser.Select)
-	err = c.convertSelectStmt(selectStmt)
+	groupBy, err := c.convertSelectStmt(selectStmt)
 	if err != nil {
 		return nil, err
 	}
 
-	res := &queryParams{}
+	res := &queryParams{groupBy: groupBy}
 	if selectStmt.Where != nil {
 		res.queryString = sqlparser.String(selectStmt.Where.Expr)
 	}
-	for _, groupByExpr := range selectStmt.GroupBy {
-		// The parser already ensures the type is saColName.
-		colName := groupByExpr.(*saColName)
-		res.groupBy = append(res.groupBy, colName.fieldName)
-	}
 	return res, nil
 }
 
-func (c *QueryConverter) convertSelectStmt(sel *sqlparser.Select) error {
+func (c *QueryConverter) convertSelectStmt(sel *sqlparser.Select) ([]query.GroupByField, error) {
 	if sel.OrderBy != nil {
-		return query.NewConverterError("%s: 'order by' clause", query.NotSupportedErrMessage)
+		return nil, query.NewConverterError("%s: 'order by' clause", query.NotSupportedErrMessage)
 	}
 
 	if sel.Limit != nil {
-		return query.NewConverterError("%s: 'limit' clause", query.NotSupportedErrMessage)
+		return nil, query.NewConverterError("%s: 'limit' clause", query.NotSupportedErrMessage)
 	}
 
 	if sel.Where == nil {
@@ -247,7 +292,7 @@ func (c *QueryConverter) convertSelectStmt(sel *sqlparser.Select) error {
 	if sel.Where.Expr != nil {
 		err := c.convertWhereExpr(&sel.Where.Expr)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// Wrap user's query in parenthesis. This is to ensure that further changes
@@ -282,27 +327,35 @@ func (c *QueryConverter) convertSelectStmt(sel *sqlparser.Select) error {
 		}
 	}
 
-	if len(sel.GroupBy) > 1 {
-		return query.NewConverterError(
-			"%s: 'group by' clause supports only a single field",
-			query.NotSupportedErrMessage,
-		)
-	}
-	for k := range sel.GroupBy {
-		colName, err := c.convertColName(&sel.GroupBy[k])
+	return c.convertGroupBy(sel.GroupBy)
+}
+
+func (c *QueryConverter) convertGroupBy(groupBy sqlparser.GroupBy) ([]query.GroupByField, error) {
+	var res []query.GroupByField
+	for _, expr := range groupBy {
+		colNameExpr, interval, aggregation, err := query.ParseGroupByExpr(expr)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		if colName.fieldName != searchattribute.ExecutionStatus {
-			return query.NewConverterError(
-				"%s: 'group by' clause is only supported for %s search attribute",
-				query.NotSupportedErrMessage,
-				searchattribute.ExecutionStatus,
-			)
+		colName, err := c.convertColName(&colNameExpr)
+		if err != nil {
+			return nil, err
 		}
+		field := query.GroupByField{
+			Name:        colName.fieldName,
+			Interval:    interval,
+			Aggregation: aggregation,
+		}
+		err = query.ValidateGroupByFieldType(colName.alias, field.FieldNameUsage(), colName.valueType)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, field)
 	}
-
-	return nil
+	if err := query.ValidateGroupBy(res); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 func (c *QueryConverter) convertWhereExpr(expr *sqlparser.Expr) error {
@@ -61,6 +61,16 @@ type (
 var (
 	convertTypeDatetime = &sqlparser.ConvertType{Type: "datetime"}
 	convertTypeJSON     = &sqlparser.ConvertType{Type: "json"}
+
+	// mysqlDateHistogramFormats maps the date histogram intervals to the DATE_FORMAT formats
+	// truncating a datetime to the start of the interval.
+	mysqlDateHistogramFormats = map[string]string{
+		"minute": "%Y-%m-%d %H:%i:00",
+		"hour":   "%Y-%m-%d %H:00:00",
+		"day":    "%Y-%m-%d 00:00:00",
+		"month":  "%Y-%m-01 00:00:00",
+		"year":   "%Y-01-01 00:00:00",
+	}
 )
 
 var _ sqlparser.Expr = (*castExpr)(nil)
@@ -113,6 +123,10 @@ func (c *mysqlQueryConverter) getCoalesceCloseTimeExpr() sqlparser.Expr {
 	)
 }
 
+func (c *mysqlQueryConverter) getDateHistogramExpr(colName string, interval string) string {
+	return fmt.Sprintf("DATE_FORMAT(%s, '%s')", colName, mysqlDateHistogramFormats[interval])
+}
+
 func (c *mysqlQueryConverter) convertKeywordListComparisonExpr(
 	expr *sqlparser.ComparisonExpr,
 ) (sqlparser.Expr, error) {
@@ -276,6 +290,8 @@ func (c *mysqlQueryConverter) buildCountStmt(
 	namespaceID namespace.ID,
 	queryString string,
 	groupBy []string,
+	aggregations []string,
+	limit int,
 ) (string, []any) {
 	var whereClauses []string
 	var queryArgs []any
@@ -294,6 +310,10 @@ func (c *mysqlQueryConverter) buildCountStmt(
 	if len(groupBy) > 0 {
 		groupByClause = fmt.Sprintf("GROUP BY %s", strings.Join(groupBy, ", "))
 	}
+	if limit > 0 {
+		groupByClause += " LIMIT ?"
+		queryArgs = append(queryArgs, limit)
+	}
 
 	return fmt.Sprintf(
 		`SELECT %s
@@ -302,7 +322,7 @@ func (c *mysqlQueryConverter) buildCountStmt(
 		USING (%s, %s)
 		WHERE %s
 		%s`,
-		strings.Join(append(groupBy, "COUNT(*)"), ", "),
+		strings.Join(append(append(groupBy, "COUNT(*)"), aggregations...), ", "),
 		searchattribute.GetSqlDbColName(searchattribute.NamespaceID),
 		searchattribute.GetSqlDbColName(searchattribute.RunID),
 		strings.Join(whereClauses, " AND "),
@@ -57,6 +57,17 @@ func (s *mysqlQueryConverterSuite) TestGetCoalesceCloseTimeExpr() {
 	)
 }
 
+func (s *mysqlQueryConverterSuite) TestGetDateHistogramExpr() {
+	s.Equal(
+		"DATE_FORMAT(start_time, '%Y-%m-%d %H:00:00')",
+		s.queryConverter.getDateHistogramExpr("start_time", "hour"),
+	)
+	s.Equal(
+		"DATE_FORMAT(start_time, '%Y-%m-01 00:00:00')",
+		s.queryConverter.getDateHistogramExpr("start_time", "month"),
+	)
+}
+
 func (s *mysqlQueryConverterSuite) TestConvertKeywordListComparisonExpr() {
 	var tests = []testCase{
 		{
@@ -91,6 +91,10 @@ func (c *pgQueryConverter) getCoalesceCloseTimeExpr() sqlparser.Expr {
 	)
 }
 
+func (c *pgQueryConverter) getDateHistogramExpr(colName string, interval string) string {
+	return fmt.Sprintf("date_trunc('%s', %s)", interval, colName)
+}
+
 func (c *pgQueryConverter) convertKeywordListComparisonExpr(
 	expr *sqlparser.ComparisonExpr,
 ) (sqlparser.Expr, error) {
@@ -279,6 +283,8 @@ func (c *pgQueryConverter) buildCountStmt(
 	namespaceID namespace.ID,
 	queryString string,
 	groupBy []string,
+	aggregations []string,
+	limit int,
 ) (string, []any) {
 	var whereClauses []string
 	var queryArgs []any
@@ -297,10 +303,14 @@ func (c *pgQueryConverter) buildCountStmt(
 	if len(groupBy) > 0 {
 		groupByClause = fmt.Sprintf("GROUP BY %s", strings.Join(groupBy, ", "))
 	}
+	if limit > 0 {
+		groupByClause += " LIMIT ?"
+		queryArgs = append(queryArgs, limit)
+	}
 
 	return fmt.Sprintf(
 		"SELECT %s FROM executions_visibility WHERE %s %s",
-		strings.Join(append(groupBy, "COUNT(*)"), ", "),
+		strings.Join(append(append(groupBy, "COUNT(*)"), aggregations...), ", "),
 		strings.Join(whereClauses, " AND "),
 		groupByClause,
 	), queryArgs
@@ -57,6 +57,17 @@ func (s *postgresqlQueryConverterSuite) TestGetCoalesceCloseTimeExpr() {
 	)
 }
 
+func (s *postgresqlQueryConverterSuite) TestGetDateHistogramExpr() {
+	s.Equal(
+		"date_trunc('hour', start_time)",
+		s.queryConverter.getDateHistogramExpr("start_time", "hour"),
+	)
+	s.Equal(
+		"date_trunc('month', start_time)",
+		s.queryConverter.getDateHistogramExpr("start_time", "month"),
+	)
+}
+
 func (s *postgresqlQueryConverterSuite) TestConvertKeywordListComparisonExpr() {
 	var tests = []testCase{
 		{
@@ -27,6 +27,7 @@ package sql
 import (
 	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/temporalio/sqlparser"
 
@@ -47,6 +48,18 @@ const (
 	textTypeFtsTableName        = "executions_visibility_fts_text"
 )
 
+var (
+	// sqliteDateHistogramFormats maps the date histogram intervals to the strftime formats
+	// truncating a datetime to the start of the interval.
+	sqliteDateHistogramFormats = map[string]string{
+		"minute": "%Y-%m-%d %H:%M:00",
+		"hour":   "%Y-%m-%d %H:00:00",
+		"day":    "%Y-%m-%d 00:00:00",
+		"month":  "%Y-%m-01 00:00:00",
+		"year":   "%Y-01-01 00:00:00",
+	}
+)
+
 func newSqliteQueryConverter(
 	namespaceName namespace.Name,
 	namespaceID namespace.ID,
@@ -76,6 +89,10 @@ func (c *sqliteQueryConverter) getCoalesceCloseTimeExpr() sqlparser.Expr {
 	)
 }
 
+func (c *sqliteQueryConverter) getDateHistogramExpr(colName string, interval string) string {
+	return fmt.Sprintf("strftime('%s', %s)", sqliteDateHistogramFormats[interval], colName)
+}
+
 func (c *sqliteQueryConverter) convertKeywordListComparisonExpr(
 	expr *sqlparser.ComparisonExpr,
 ) (sqlparser.Expr, error) {
@@ -190,7 +207,7 @@ func (c *sqliteQueryConverter) convertTextComparisonExpr(
 			sqlparser.String(expr.Right),
 		)
 	}
-	tokens := tokenizeTextQueryString(valueExpr.Val)
+	tokens := tokenizeFtsTextQueryString(valueExpr.Val)
 	if len(tokens) == 0 {
 		return nil, query.NewConverterError(
 			"%s: unexpected value for Text type search attribute (no tokens found in %s)",
@@ -320,6 +337,8 @@ func (c *sqliteQueryConverter) buildCountStmt(
 	namespaceID namespace.ID,
 	queryString string,
 	groupBy []string,
+	aggregations []string,
+	limit int,
 ) (string, []any) {
 	var whereClauses []string
 	var queryArgs []any
@@ -338,10 +357,14 @@ func (c *sqliteQueryConverter) buildCountStmt(
 	if len(groupBy) > 0 {
 		groupByClause = fmt.Sprintf("GROUP BY %s", strings.Join(groupBy, ", "))
 	}
+	if limit > 0 {
+		groupByClause += " LIMIT ?"
+		queryArgs = append(queryArgs, limit)
+	}
 
 	return fmt.Sprintf(
 		"SELECT %s FROM executions_visibility WHERE %s %s",
-		strings.Join(append(groupBy, "COUNT(*)"), ", "),
+		strings.Join(append(append(groupBy, "COUNT(*)"), aggregations...), ", "),
 		strings.Join(whereClauses, " AND "),
 		groupByClause,
 	), queryArgs
@@ -351,3 +374,12 @@ func buildFtsQueryString(colname string, values ...string) string {
 	// FTS query format: 'colname : ("token1" OR "token2" OR ...)'
 	return fmt.Sprintf(`%s : ("%s")`, colname, strings.Join(values, `" OR "`))
 }
+
+// tokenizeFtsTextQueryString splits a Text search attribute value into the tokens the unicode61
+// tokenizer of the FTS5 text table produces: runs of letters, numbers and private use characters.
+// Matching any of the tokens gives the same boolean semantics as the Elasticsearch match query.
+func tokenizeFtsTextQueryString(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && !unicode.Is(unicode.Co, r)
+	})
+}
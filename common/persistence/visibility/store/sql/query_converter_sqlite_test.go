@@ -57,6 +57,17 @@ func (s *sqliteQueryConverterSuite) TestGetCoalesceCloseTimeExpr() {
 	)
 }
 
+func (s *sqliteQueryConverterSuite) TestGetDateHistogramExpr() {
+	s.Equal(
+		"strftime('%Y-%m-%d %H:00:00', start_time)",
+		s.queryConverter.getDateHistogramExpr("start_time", "hour"),
+	)
+	s.Equal(
+		"strftime('%Y-%m-01 00:00:00', start_time)",
+		s.queryConverter.getDateHistogramExpr("start_time", "month"),
+	)
+}
+
 func (s *sqliteQueryConverterSuite) TestConvertKeywordListComparisonExpr() {
 	var tests = []testCase{
 		{
@@ -139,6 +150,28 @@ func (s *sqliteQueryConverterSuite) TestConvertTextComparisonExpr() {
 			output: `rowid not in (select rowid from executions_visibility_fts_text where executions_visibility_fts_text = 'Text01 : ("foo" OR "bar")')`,
 			err:    nil,
 		},
+		{
+			name:   "punctuation separates tokens",
+			input:  `AliasForText01 = 'foo-bar, "baz"  qux.'`,
+			output: `rowid in (select rowid from executions_visibility_fts_text where executions_visibility_fts_text = 'Text01 : ("foo" OR "bar" OR "baz" OR "qux")')`,
+			err:    nil,
+		},
+		{
+			name:   "unicode tokens",
+			input:  "AliasForText01 = 'café_42 日本'",
+			output: `rowid in (select rowid from executions_visibility_fts_text where executions_visibility_fts_text = 'Text01 : ("café" OR "42" OR "日本")')`,
+			err:    nil,
+		},
+		{
+			name:   "no tokens",
+			input:  "AliasForText01 = ' -- '",
+			output: "",
+			err: query.NewConverterError(
+				"%s: unexpected value for Text type search attribute (no tokens found in %s)",
+				query.InvalidExpressionErrMessage,
+				"' -- '",
+			),
+		},
 	}
 
 	for _, tc := range tests {
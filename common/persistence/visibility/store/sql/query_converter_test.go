@@ -121,27 +121,66 @@ func (s *queryConverterSuite) TestConvertWhereString() {
 			input: "GROUP BY ExecutionStatus",
 			output: &queryParams{
 				queryString: "TemporalNamespaceDivision is null",
-				groupBy:     []string{searchattribute.ExecutionStatus},
+				groupBy:     []query.GroupByField{{Name: searchattribute.ExecutionStatus}},
 			},
 			err: nil,
 		},
 		{
-			name:   "group by two fields not supported",
-			input:  "GROUP BY ExecutionStatus, WorkflowType",
+			name:  "group by multiple fields",
+			input: "GROUP BY ExecutionStatus, WorkflowType, AliasForKeyword01",
+			output: &queryParams{
+				queryString: "TemporalNamespaceDivision is null",
+				groupBy: []query.GroupByField{
+					{Name: searchattribute.ExecutionStatus},
+					{Name: searchattribute.WorkflowType},
+					{Name: "Keyword01"},
+				},
+			},
+			err: nil,
+		},
+		{
+			name:  "group by date histogram and aggregations",
+			input: "GROUP BY date_histogram(CloseTime, 'Hour'), avg(ExecutionDuration), MAX(AliasForDouble01)",
+			output: &queryParams{
+				queryString: "TemporalNamespaceDivision is null",
+				groupBy: []query.GroupByField{
+					{Name: searchattribute.CloseTime, Interval: "hour"},
+					{Name: searchattribute.ExecutionDuration, Aggregation: query.AvgFuncName},
+					{Name: "Double01", Aggregation: query.MaxFuncName},
+				},
+			},
+			err: nil,
+		},
+		{
+			name:   "group by non keyword not supported",
+			input:  "GROUP BY AliasForInt01",
 			output: nil,
 			err: query.NewConverterError(
-				"%s: 'group by' clause supports only a single field",
+				"%s: search attribute '%s' in 'group by' clause must be of type %s, got %s",
 				query.NotSupportedErrMessage,
+				"AliasForInt01",
+				"Keyword",
+				"Int",
 			),
 		},
 		{
-			name:   "group by non ExecutionStatus",
-			input:  "GROUP BY WorkflowType",
+			name:   "date histogram interval not supported",
+			input:  "GROUP BY date_histogram(StartTime, 'week')",
 			output: nil,
 			err: query.NewConverterError(
-				"%s: 'group by' clause is only supported for %s search attribute",
-				query.NotSupportedErrMessage,
-				searchattribute.ExecutionStatus,
+				"%s: interval of function '%s' must be one of %s",
+				query.InvalidExpressionErrMessage,
+				query.DateHistogramFuncName,
+				"minute, hour, day, month, year",
+			),
+		},
+		{
+			name:   "aggregation before grouping term",
+			input:  "GROUP BY min(ExecutionDuration), ExecutionStatus",
+			output: nil,
+			err: query.NewConverterError(
+				"%s: aggregations must come after all grouping terms in 'group by' clause",
+				query.InvalidExpressionErrMessage,
 			),
 		},
 		{
@@ -180,6 +219,55 @@ func (s *queryConverterSuite) TestConvertWhereString() {
 	}
 }
 
+func (s *queryConverterSuite) TestBuildCountStmt() {
+	qc := newQueryConverterInternal(
+		s.pqc,
+		testNamespaceName,
+		testNamespaceID,
+		searchattribute.TestNameTypeMap,
+		&searchattribute.TestMapper{},
+		"AliasForInt01 = 1",
+	)
+	filter, groupBy, err := qc.BuildCountStmt(100)
+	s.NoError(err)
+	s.Empty(groupBy)
+	s.Empty(filter.GroupBy)
+	s.Empty(filter.Aggregations)
+	s.Equal([]any{testNamespaceID.String()}, filter.QueryArgs)
+	s.NotContains(filter.Query, "GROUP BY")
+
+	qc = newQueryConverterInternal(
+		s.pqc,
+		testNamespaceName,
+		testNamespaceID,
+		searchattribute.TestNameTypeMap,
+		&searchattribute.TestMapper{},
+		"GROUP BY WorkflowType, date_histogram(CloseTime, 'day'), avg(ExecutionDuration)",
+	)
+	filter, groupBy, err = qc.BuildCountStmt(100)
+	s.NoError(err)
+	s.Equal(
+		[]query.GroupByField{
+			{Name: searchattribute.WorkflowType},
+			{Name: searchattribute.CloseTime, Interval: "day"},
+			{Name: searchattribute.ExecutionDuration, Aggregation: query.AvgFuncName},
+		},
+		groupBy,
+	)
+	s.Equal([]string{searchattribute.WorkflowType, searchattribute.CloseTime}, filter.GroupBy)
+	s.Equal([]string{searchattribute.ExecutionDuration}, filter.Aggregations)
+	s.Equal([]any{testNamespaceID.String(), 101}, filter.QueryArgs)
+	s.Contains(filter.Query, "workflow_type_name is not null and close_time is not null")
+	s.Contains(
+		filter.Query,
+		fmt.Sprintf(
+			"workflow_type_name, %s, COUNT(*), AVG(execution_duration)",
+			s.pqc.getDateHistogramExpr("close_time", "day"),
+		),
+	)
+	s.Contains(filter.Query, "LIMIT ?")
+}
+
 func (s *queryConverterSuite) TestConvertAndExpr() {
 	var tests = []testCase{
 		{
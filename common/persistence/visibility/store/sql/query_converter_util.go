@@ -145,7 +145,7 @@ func formatComparisonExprStringForError(expr sqlparser.ComparisonExpr) string {
 }
 
 // Simple tokenizer by spaces. It's a temporary solution as it doesn't cover tokenizer used by
-// PostgreSQL or SQLite.
+// PostgreSQL. SQLite uses tokenizeFtsTextQueryString instead.
 func tokenizeTextQueryString(s string) []string {
 	tokens := strings.Split(s, " ")
 	nonEmptyTokens := make([]string, 0, len(tokens))
@@ -28,6 +28,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -37,6 +38,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
@@ -54,6 +56,7 @@ type (
 		sqlStore                       persistencesql.SqlStore
 		searchAttributesProvider       searchattribute.Provider
 		searchAttributesMapperProvider searchattribute.MapperProvider
+		maxCountGroups                 dynamicconfig.IntPropertyFnWithNamespaceFilter
 	}
 )
 
@@ -61,12 +64,16 @@ var _ store.VisibilityStore = (*VisibilityStore)(nil)
 
 var maxTime, _ = time.Parse(time.RFC3339, "9999-12-31T23:59:59Z")
 
+// countGroupByDatetimeFormat is the format of the date histogram buckets returned as strings.
+const countGroupByDatetimeFormat = "2006-01-02 15:04:05"
+
 // NewSQLVisibilityStore creates an instance of VisibilityStore
 func NewSQLVisibilityStore(
 	cfg config.SQL,
 	r resolver.ServiceResolver,
 	searchAttributesProvider searchattribute.Provider,
 	searchAttributesMapperProvider searchattribute.MapperProvider,
+	maxCountGroups dynamicconfig.IntPropertyFnWithNamespaceFilter,
 	logger log.Logger,
 ) (*VisibilityStore, error) {
 	refDbConn := persistencesql.NewRefCountedDBConn(sqlplugin.DbKindVisibility, &cfg, r)
@@ -78,6 +85,7 @@ func NewSQLVisibilityStore(
 		sqlStore:                       persistencesql.NewSqlStore(db, logger),
 		searchAttributesProvider:       searchAttributesProvider,
 		searchAttributesMapperProvider: searchAttributesMapperProvider,
+		maxCountGroups:                 maxCountGroups,
 	}, nil
 }
 
@@ -428,7 +436,8 @@ func (s *VisibilityStore) CountWorkflowExecutions(
 		saMapper,
 		request.Query,
 	)
-	selectFilter, err := converter.BuildCountStmt()
+	maxGroups := s.maxCountGroups(request.Namespace.String())
+	selectFilter, groupBy, err := converter.BuildCountStmt(maxGroups)
 	if err != nil {
 		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
 		var converterErr *query.ConverterError
@@ -438,8 +447,8 @@ func (s *VisibilityStore) CountWorkflowExecutions(
 		return nil, err
 	}
 
-	if len(selectFilter.GroupBy) > 0 {
-		return s.countGroupByWorkflowExecutions(ctx, selectFilter, saTypeMap)
+	if len(groupBy) > 0 {
+		return s.countGroupByWorkflowExecutions(ctx, selectFilter, groupBy, saTypeMap, maxGroups)
 	}
 
 	count, err := s.sqlStore.Db.CountFromVisibility(ctx, *selectFilter)
@@ -454,15 +463,27 @@ func (s *VisibilityStore) CountWorkflowExecutions(
 func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	ctx context.Context,
 	selectFilter *sqlplugin.VisibilitySelectFilter,
+	groupBy []query.GroupByField,
 	saTypeMap searchattribute.NameTypeMap,
+	maxGroups int,
 ) (*manager.CountWorkflowExecutionsResponse, error) {
-	var err error
-	groupByTypes := make([]enumspb.IndexedValueType, len(selectFilter.GroupBy))
-	for i, fieldName := range selectFilter.GroupBy {
-		groupByTypes[i], err = saTypeMap.GetType(fieldName)
+	// Values of the grouping terms are followed by the values of the aggregations.
+	groupingFields := query.GroupingFields(groupBy)
+	aggregationFields := query.AggregationFields(groupBy)
+	valueTypes := make([]enumspb.IndexedValueType, 0, len(groupBy))
+	for _, field := range groupingFields {
+		tp, err := saTypeMap.GetType(field.Name)
+		if err != nil {
+			return nil, err
+		}
+		valueTypes = append(valueTypes, tp)
+	}
+	for _, field := range aggregationFields {
+		tp, err := saTypeMap.GetType(field.Name)
 		if err != nil {
 			return nil, err
 		}
+		valueTypes = append(valueTypes, query.AggregationType(field, tp))
 	}
 
 	rows, err := s.sqlStore.Db.CountGroupByFromVisibility(ctx, *selectFilter)
@@ -470,14 +491,24 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 		return nil, serviceerror.NewUnavailable(
 			fmt.Sprintf("CountWorkflowExecutions operation failed. Query failed: %v", err))
 	}
+	if len(rows) > maxGroups {
+		return nil, serviceerror.NewInvalidArgument(
+			fmt.Sprintf("Too many groups: query returned more than %d groups.", maxGroups),
+		)
+	}
 	resp := &manager.CountWorkflowExecutionsResponse{
 		Count:  0,
 		Groups: make([]*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup, 0, len(rows)),
 	}
 	for _, row := range rows {
-		groupValues := make([]*common.Payload, len(row.GroupValues))
-		for i, val := range row.GroupValues {
-			groupValues[i], err = searchattribute.EncodeValue(val, groupByTypes[i])
+		values := append(append([]any{}, row.GroupValues...), row.AggregationValues...)
+		groupValues := make([]*common.Payload, len(values))
+		for i, val := range values {
+			val, err = parseCountGroupByValue(val, valueTypes[i])
+			if err != nil {
+				return nil, err
+			}
+			groupValues[i], err = searchattribute.EncodeValue(val, valueTypes[i])
 			if err != nil {
 				return nil, err
 			}
@@ -756,3 +787,48 @@ func (s *VisibilityStore) buildQueryStringFromListRequest(
 
 	return strings.Join(queryTerms, " AND ")
 }
+
+// parseCountGroupByValue converts a value of a count query column, as returned by the DB driver,
+// to the Go type of the search attribute type. Drivers return strings as []byte, decimals as
+// strings, and truncated datetimes either as time.Time or as strings depending on the DB.
+func parseCountGroupByValue(value any, t enumspb.IndexedValueType) (any, error) {
+	if bytesValue, ok := value.([]byte); ok {
+		value = string(bytesValue)
+	}
+	if value == nil {
+		return nil, nil
+	}
+	var (
+		res = value
+		err error
+	)
+	switch t {
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		switch v := value.(type) {
+		case time.Time:
+			res = v.UTC()
+		case string:
+			res, err = time.ParseInLocation(countGroupByDatetimeFormat, v, time.UTC)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		switch v := value.(type) {
+		case float64:
+			res = int64(v)
+		case string:
+			res, err = strconv.ParseInt(v, 10, 64)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		switch v := value.(type) {
+		case int64:
+			res = float64(v)
+		case string:
+			res, err = strconv.ParseFloat(v, 64)
+		}
+	}
+	if err != nil {
+		return nil, serviceerror.NewInternal(
+			fmt.Sprintf("Unable to parse value from DB (got: %v of type: %T, expected type: %v)", value, value, t),
+		)
+	}
+	return res, nil
+}
@@ -0,0 +1,109 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package taskpriority defines the priority of tasks dispatched by matching.
+//
+// Workflows and activities choose their priority with the HeaderKey header on the start
+// request or the ScheduleActivityTask command. The value is a JSON-encoded integer from
+// Highest (1) to Lowest (5). Workflow tasks use the priority of the workflow.
+//
+// Within a priority, tasks are dispatched round-robin across fairness keys, so that one tenant's
+// backlog does not delay the others. The key is set the same way with the FairnessKeyHeaderKey
+// header, as a JSON-encoded string.
+//
+// Matching applies both only to the backlog tasks it has loaded in memory, up to
+// matching.getTasksBatchSize per partition. Tasks persisted behind a larger backlog wait until
+// they are loaded.
+package taskpriority
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/payload"
+)
+
+const (
+	// HeaderKey is the header that sets the priority of a workflow or activity.
+	HeaderKey = "temporal-task-priority"
+
+	Highest int32 = 1
+	Lowest  int32 = 5
+	// Default is used for tasks without a priority.
+	Default int32 = 3
+
+	// NumLevels is the number of distinct priorities.
+	NumLevels = int(Lowest - Highest + 1)
+
+	// FairnessKeyHeaderKey is the header that sets the fairness key of a workflow or activity.
+	FairnessKeyHeaderKey = "temporal-fairness-key"
+	// MaxFairnessKeyLength bounds the fairness keys kept in memory and persistence.
+	MaxFairnessKeyLength = 64
+)
+
+// FromHeader returns the priority set in header, or 0 if there is none. Priorities outside
+// of [Highest, Lowest] are clamped.
+func FromHeader(header *commonpb.Header) int32 {
+	p, ok := header.GetFields()[HeaderKey]
+	if !ok {
+		return 0
+	}
+	var priority int32
+	if err := payload.Decode(p, &priority); err != nil || priority == 0 {
+		return 0
+	}
+	return Normalize(priority)
+}
+
+// Normalize maps 0 to Default and clamps priorities to [Highest, Lowest].
+func Normalize(priority int32) int32 {
+	switch {
+	case priority == 0:
+		return Default
+	case priority < Highest:
+		return Highest
+	case priority > Lowest:
+		return Lowest
+	}
+	return priority
+}
+
+// Index returns the position of a normalized priority in [0, NumLevels).
+func Index(priority int32) int {
+	return int(Normalize(priority) - Highest)
+}
+
+// FairnessKeyFromHeader returns the fairness key set in header, or "" if there is none. Longer
+// keys are truncated to MaxFairnessKeyLength bytes.
+func FairnessKeyFromHeader(header *commonpb.Header) string {
+	p, ok := header.GetFields()[FairnessKeyHeaderKey]
+	if !ok {
+		return ""
+	}
+	var key string
+	if err := payload.Decode(p, &key); err != nil {
+		return ""
+	}
+	if len(key) > MaxFairnessKeyLength {
+		key = key[:MaxFairnessKeyLength]
+	}
+	return key
+}
@@ -0,0 +1,68 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package taskpriority
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/payload"
+)
+
+func TestFromHeader(t *testing.T) {
+	header := func(value any) *commonpb.Header {
+		p, err := payload.Encode(value)
+		assert.NoError(t, err)
+		return &commonpb.Header{Fields: map[string]*commonpb.Payload{HeaderKey: p}}
+	}
+
+	assert.Equal(t, int32(0), FromHeader(nil))
+	assert.Equal(t, int32(0), FromHeader(&commonpb.Header{}))
+	assert.Equal(t, int32(0), FromHeader(header("urgent")))
+	assert.Equal(t, int32(0), FromHeader(header(0)))
+	assert.Equal(t, int32(2), FromHeader(header(2)))
+	assert.Equal(t, Highest, FromHeader(header(-3)))
+	assert.Equal(t, Lowest, FromHeader(header(100)))
+}
+
+func TestIndex(t *testing.T) {
+	assert.Equal(t, 0, Index(Highest))
+	assert.Equal(t, int(Default-Highest), Index(0))
+	assert.Equal(t, NumLevels-1, Index(Lowest))
+}
+
+func TestFairnessKeyFromHeader(t *testing.T) {
+	header := func(value any) *commonpb.Header {
+		p, err := payload.Encode(value)
+		assert.NoError(t, err)
+		return &commonpb.Header{Fields: map[string]*commonpb.Payload{FairnessKeyHeaderKey: p}}
+	}
+
+	assert.Equal(t, "", FairnessKeyFromHeader(nil))
+	assert.Equal(t, "", FairnessKeyFromHeader(header(3)))
+	assert.Equal(t, "tenant-a", FairnessKeyFromHeader(header("tenant-a")))
+	assert.Len(t, FairnessKeyFromHeader(header(strings.Repeat("x", 100))), MaxFairnessKeyLength)
+}
@@ -41,7 +41,10 @@ import (
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/client/history"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/channel"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/primitives"
@@ -50,8 +53,11 @@ import (
 	"go.temporal.io/server/service/worker/dlq"
 
 	"github.com/pborman/uuid"
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
+	querypb "go.temporal.io/api/query/v1"
 	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
@@ -62,8 +68,11 @@ import (
 
 	clusterspb "go.temporal.io/server/api/cluster/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	schedspb "go.temporal.io/server/api/schedule/v1"
+	tokenspb "go.temporal.io/server/api/token/v1"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/client/frontend"
@@ -78,8 +87,10 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
@@ -89,12 +100,15 @@ import (
 	"go.temporal.io/server/common/xdc"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+	restoreFromArchivePageSize              = 100
+	restoreFromArchivePagesPerCall          = 10
 )
 
 type (
@@ -118,6 +132,7 @@ type (
 		clientFactory              serverClient.Factory
 		clientBean                 serverClient.Bean
 		historyClient              historyservice.HistoryServiceClient
+		matchingClient             matchingservice.MatchingServiceClient
 		sdkClientFactory           sdk.ClientFactory
 		membershipMonitor          membership.Monitor
 		hostInfoProvider           membership.HostInfoProvider
@@ -127,6 +142,8 @@ type (
 		saManager                  searchattribute.Manager
 		clusterMetadata            cluster.Metadata
 		healthServer               *health.Server
+		archiverProvider           provider.ArchiverProvider
+		archivalMetadata           archiver.ArchivalMetadata
 
 		// DEPRECATED
 		persistenceExecutionManager persistence.ExecutionManager
@@ -164,6 +181,9 @@ type (
 		PersistenceExecutionManager persistence.ExecutionManager
 
 		CategoryRegistry tasks.TaskCategoryRegistry
+		ArchiverProvider provider.ArchiverProvider
+		ArchivalMetadata archiver.ArchivalMetadata
+		MatchingClient   matchingservice.MatchingServiceClient
 	}
 )
 
@@ -204,6 +224,7 @@ func NewAdminHandler(
 		clientFactory:               args.ClientFactory,
 		clientBean:                  args.ClientBean,
 		historyClient:               args.HistoryClient,
+		matchingClient:              args.MatchingClient,
 		sdkClientFactory:            args.sdkClientFactory,
 		membershipMonitor:           args.MembershipMonitor,
 		hostInfoProvider:            args.HostInfoProvider,
@@ -214,6 +235,8 @@ func NewAdminHandler(
 		clusterMetadata:             args.ClusterMetadata,
 		healthServer:                args.HealthServer,
 		taskCategoryRegistry:        args.CategoryRegistry,
+		archiverProvider:            args.ArchiverProvider,
+		archivalMetadata:            args.ArchivalMetadata,
 	}
 }
 
@@ -706,6 +729,403 @@ func (adh *AdminHandler) ImportWorkflowExecution(
 	}, nil
 }
 
+// RestoreWorkflowExecutionFromArchive reads the archived history of a workflow execution and imports it
+// back into the persistence store through the history importer.
+func (adh *AdminHandler) RestoreWorkflowExecutionFromArchive(
+	ctx context.Context,
+	request *adminservice.RestoreWorkflowExecutionFromArchiveRequest,
+) (_ *adminservice.RestoreWorkflowExecutionFromArchiveResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.Execution.GetRunId() == "" {
+		return nil, errRunIDNotSet
+	}
+
+	if !adh.archivalMetadata.GetHistoryConfig().ReadEnabled() {
+		return nil, errClusterIsNotConfiguredForReadingArchivalHistory
+	}
+
+	namespaceEntry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	URIString := namespaceEntry.HistoryArchivalState().URI
+	if URIString == "" {
+		return nil, errNamespaceIsNotConfiguredForHistoryArchival
+	}
+	URI, err := archiver.NewURI(URIString)
+	if err != nil {
+		return nil, err
+	}
+	historyArchiver, err := adh.archiverProvider.GetHistoryArchiver(URI.Scheme(), string(primitives.FrontendService))
+	if err != nil {
+		return nil, err
+	}
+
+	continuation := &tokenspb.RestoreFromArchiveContinuation{
+		VersionHistory: versionhistory.NewVersionHistory(nil, nil),
+	}
+	if len(request.NextPageToken) != 0 {
+		continuation = &tokenspb.RestoreFromArchiveContinuation{}
+		if err := continuation.Unmarshal(request.NextPageToken); err != nil {
+			return nil, errInvalidNextPageToken
+		}
+	}
+
+	// The history importer needs the version history of the whole workflow with every page,
+	// so the archived history is read once to build it before it is read again to be imported.
+	// Each call reads a bounded number of pages and returns a token to continue from, so that
+	// restoring a large history is not bound by the deadline of a single call.
+	for i := 0; i < restoreFromArchivePagesPerCall; i++ {
+		resp, err := historyArchiver.Get(ctx, URI, &archiver.GetHistoryRequest{
+			NamespaceID:   namespaceEntry.ID().String(),
+			WorkflowID:    request.Execution.GetWorkflowId(),
+			RunID:         request.Execution.GetRunId(),
+			PageSize:      restoreFromArchivePageSize,
+			NextPageToken: continuation.ArchivePageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if continuation.Importing {
+			err = adh.importArchivedHistory(ctx, namespaceEntry.ID(), request.Execution, continuation, resp.HistoryBatches)
+		} else {
+			err = addArchivedHistoryToVersionHistory(continuation.VersionHistory, resp.HistoryBatches)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		continuation.ArchivePageToken = resp.NextPageToken
+		if len(resp.NextPageToken) != 0 {
+			continue
+		}
+		if !continuation.Importing {
+			if versionhistory.IsEmptyVersionHistory(continuation.VersionHistory) {
+				return nil, errHistoryNotFound
+			}
+			continuation.Importing = true
+			continue
+		}
+
+		// an import without history batches commits the imported workflow
+		if _, err := adh.historyClient.ImportWorkflowExecution(ctx, &historyservice.ImportWorkflowExecutionRequest{
+			NamespaceId:        namespaceEntry.ID().String(),
+			Execution:          request.Execution,
+			VersionHistory:     continuation.VersionHistory,
+			Token:              continuation.ImportToken,
+			RestoreFromArchive: true,
+		}); err != nil {
+			return nil, err
+		}
+		return &adminservice.RestoreWorkflowExecutionFromArchiveResponse{}, nil
+	}
+
+	nextPageToken, err := continuation.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.RestoreWorkflowExecutionFromArchiveResponse{
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (adh *AdminHandler) importArchivedHistory(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+	continuation *tokenspb.RestoreFromArchiveContinuation,
+	batches []*historypb.History,
+) error {
+	if len(batches) == 0 {
+		// an import without history batches would commit the workflow
+		return nil
+	}
+	historyBatches := make([]*commonpb.DataBlob, 0, len(batches))
+	for _, batch := range batches {
+		blob, err := adh.eventSerializer.SerializeEvents(batch.Events, enumspb.ENCODING_TYPE_PROTO3)
+		if err != nil {
+			return err
+		}
+		historyBatches = append(historyBatches, blob)
+	}
+	resp, err := adh.historyClient.ImportWorkflowExecution(ctx, &historyservice.ImportWorkflowExecutionRequest{
+		NamespaceId:    namespaceID.String(),
+		Execution:      execution,
+		HistoryBatches: historyBatches,
+		VersionHistory: continuation.VersionHistory,
+		Token:          continuation.ImportToken,
+	})
+	if err != nil {
+		return err
+	}
+	continuation.ImportToken = resp.Token
+	return nil
+}
+
+// PreviewScheduleBackfill queries the scheduler workflow of a schedule for the actions a backfill would
+// take, without starting any workflows.
+func (adh *AdminHandler) PreviewScheduleBackfill(
+	ctx context.Context,
+	request *adminservice.PreviewScheduleBackfillRequest,
+) (_ *adminservice.PreviewScheduleBackfillResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if !adh.config.EnableSchedules(request.GetNamespace()) {
+		return nil, errSchedulesNotAllowed
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	queryPayload, err := sdk.PreferProtoDataConverter.ToPayloads(&schedspb.BackfillPreviewRequest{
+		BackfillRequest: request.BackfillRequest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := adh.historyClient.QueryWorkflow(ctx, &historyservice.QueryWorkflowRequest{
+		NamespaceId: namespaceID.String(),
+		Request: &workflowservice.QueryWorkflowRequest{
+			Namespace: request.GetNamespace(),
+			Execution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowIDPrefix + request.GetScheduleId()},
+			Query: &querypb.WorkflowQuery{
+				QueryType: scheduler.QueryNameBackfillPreview,
+				QueryArgs: queryPayload,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var preview schedspb.BackfillPreviewResponse
+	if err := payloads.Decode(res.GetResponse().GetQueryResult(), &preview); err != nil {
+		return nil, err
+	}
+	return &adminservice.PreviewScheduleBackfillResponse{
+		Entries:   preview.Entries,
+		Truncated: preview.Truncated,
+	}, nil
+}
+
+// DescribeScheduleRunHistory queries the scheduler workflow of a schedule for the runs it recently
+// started and their final status.
+func (adh *AdminHandler) DescribeScheduleRunHistory(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRunHistoryRequest,
+) (_ *adminservice.DescribeScheduleRunHistoryResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if !adh.config.EnableSchedules(request.GetNamespace()) {
+		return nil, errSchedulesNotAllowed
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	queryPayload, err := sdk.PreferProtoDataConverter.ToPayloads(&schedspb.RunHistoryRequest{
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := adh.historyClient.QueryWorkflow(ctx, &historyservice.QueryWorkflowRequest{
+		NamespaceId: namespaceID.String(),
+		Request: &workflowservice.QueryWorkflowRequest{
+			Namespace: request.GetNamespace(),
+			Execution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowIDPrefix + request.GetScheduleId()},
+			Query: &querypb.WorkflowQuery{
+				QueryType: scheduler.QueryNameRunHistory,
+				QueryArgs: queryPayload,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var history schedspb.RunHistoryResponse
+	if err := payloads.Decode(res.GetResponse().GetQueryResult(), &history); err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeScheduleRunHistoryResponse{
+		Runs:          history.Runs,
+		NextPageToken: history.NextPageToken,
+	}, nil
+}
+
+// DescribeTaskQueuePartition returns the internal state of one task queue partition.
+func (adh *AdminHandler) DescribeTaskQueuePartition(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueuePartitionRequest,
+) (_ *adminservice.DescribeTaskQueuePartitionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetTaskQueue().GetName() == "" {
+		return nil, errTaskQueueNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	taskQueueType := request.GetTaskQueueType()
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		taskQueueType = enumspb.TASK_QUEUE_TYPE_WORKFLOW
+	}
+	resp, err := adh.matchingClient.DescribeTaskQueue(ctx, &matchingservice.DescribeTaskQueueRequest{
+		NamespaceId: namespaceID.String(),
+		DescRequest: &workflowservice.DescribeTaskQueueRequest{
+			Namespace:              request.GetNamespace(),
+			TaskQueue:              request.GetTaskQueue(),
+			TaskQueueType:          taskQueueType,
+			IncludeTaskQueueStatus: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeTaskQueuePartitionResponse{
+		Pollers:          resp.GetPollers(),
+		TaskQueueStatus:  resp.GetTaskQueueStatus(),
+		PriorityBacklogs: resp.GetPriorityBacklogs(),
+		FairnessBacklogs: resp.GetFairnessBacklogs(),
+	}, nil
+}
+
+// DescribeTaskQueueBacklog returns approximate backlog statistics of a task queue, combined
+// across all of its partitions.
+func (adh *AdminHandler) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueBacklogRequest,
+) (_ *adminservice.DescribeTaskQueueBacklogResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetTaskQueue() == "" {
+		return nil, errTaskQueueNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	taskQueueType := request.GetTaskQueueType()
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		taskQueueType = enumspb.TASK_QUEUE_TYPE_WORKFLOW
+	}
+	resp, err := adh.matchingClient.DescribeTaskQueueBacklog(ctx, &matchingservice.DescribeTaskQueueBacklogRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     request.GetTaskQueue(),
+		TaskQueueType: taskQueueType,
+		BuildIds:      request.GetBuildIds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeTaskQueueBacklogResponse{
+		Stats:            resp.GetStats(),
+		StatsByBuildId:   resp.GetStatsByBuildId(),
+		FailedPartitions: resp.GetFailedPartitions(),
+	}, nil
+}
+
+// PauseTaskQueue stops dispatching tasks of a task queue to pollers.
+func (adh *AdminHandler) PauseTaskQueue(
+	ctx context.Context,
+	request *adminservice.PauseTaskQueueRequest,
+) (_ *adminservice.PauseTaskQueueResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetTaskQueue() == "" {
+		return nil, errTaskQueueNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.SetTaskQueuePaused(ctx, &matchingservice.SetTaskQueuePausedRequest{
+		NamespaceId: namespaceID.String(),
+		TaskQueue:   request.GetTaskQueue(),
+		Paused:      true,
+		BuildId:     request.GetBuildId(),
+		Reason:      request.GetReason(),
+		Identity:    request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.PauseTaskQueueResponse{PauseState: resp.GetPauseState()}, nil
+}
+
+// ResumeTaskQueue resumes dispatching tasks of a paused task queue.
+func (adh *AdminHandler) ResumeTaskQueue(
+	ctx context.Context,
+	request *adminservice.ResumeTaskQueueRequest,
+) (_ *adminservice.ResumeTaskQueueResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetTaskQueue() == "" {
+		return nil, errTaskQueueNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.SetTaskQueuePaused(ctx, &matchingservice.SetTaskQueuePausedRequest{
+		NamespaceId: namespaceID.String(),
+		TaskQueue:   request.GetTaskQueue(),
+		Paused:      false,
+		BuildId:     request.GetBuildId(),
+		Identity:    request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ResumeTaskQueueResponse{PauseState: resp.GetPauseState()}, nil
+}
+
 // DescribeMutableState returns information about the specified workflow execution.
 func (adh *AdminHandler) DescribeMutableState(ctx context.Context, request *adminservice.DescribeMutableStateRequest) (_ *adminservice.DescribeMutableStateResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
@@ -2031,6 +2451,24 @@ func (adh *AdminHandler) parseDLQKey(key *commonspb.HistoryDLQKey) (*persistence
 	}, nil
 }
 
+// getArchivedHistory reads all pages of an archived history and calls handlePage with the history batches of each page.
+func addArchivedHistoryToVersionHistory(
+	versionHistory *historyspb.VersionHistory,
+	batches []*historypb.History,
+) error {
+	for _, batch := range batches {
+		for _, event := range batch.Events {
+			if err := versionhistory.AddOrUpdateVersionHistoryItem(
+				versionHistory,
+				versionhistory.NewVersionHistoryItem(event.GetEventId(), event.GetVersion()),
+			); err != nil {
+				return serviceerror.NewInternal(fmt.Sprintf("archived history is invalid: %v", err))
+			}
+		}
+	}
+	return nil
+}
+
 func convertClusterReplicationConfigToProto(
 	input []string,
 ) []*replicationpb.ClusterReplicationConfig {
@@ -29,8 +29,10 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
@@ -39,40 +41,56 @@ import (
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
+	schedpb "go.temporal.io/api/schedule/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	schedspb "go.temporal.io/server/api/schedule/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
+	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resourcetest"
+	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/testing/mocksdk"
+	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 type (
@@ -170,6 +188,9 @@ func (s *adminHandlerSuite) SetupTest() {
 		clock.NewRealTimeSource(),
 		s.mockResource.GetExecutionManager(),
 		tasks.NewDefaultTaskCategoryRegistry(),
+		s.mockResource.GetArchiverProvider(),
+		s.mockResource.GetArchivalMetadata(),
+		s.mockResource.GetMatchingClient(),
 	}
 	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(uuid.New()).AnyTimes()
 	s.handler = NewAdminHandler(args)
@@ -1634,3 +1655,383 @@ func (s *adminHandlerSuite) TestListQueues_Err() {
 	_, err := s.handler.ListQueues(context.Background(), &adminservice.ListQueuesRequest{})
 	s.ErrorIs(err, assert.AnError)
 }
+
+func (s *adminHandlerSuite) TestRestoreWorkflowExecutionFromArchive() {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	namespaceEntry := namespace.NewNamespaceForTest(
+		&persistencespb.NamespaceInfo{
+			Name: s.namespace.String(),
+			Id:   s.namespaceID.String(),
+		},
+		&persistencespb.NamespaceConfig{
+			HistoryArchivalState: enumspb.ARCHIVAL_STATE_ENABLED,
+			HistoryArchivalUri:   "test:///history/archival",
+		},
+		false,
+		nil,
+		int64(100),
+	)
+	// one batch of one event per page, with more pages than are read with a single call
+	pageCount := 2*restoreFromArchivePagesPerCall + 3
+	pages := make([]*historypb.History, pageCount)
+	for i := range pages {
+		version := int64(100)
+		if i == pageCount-1 {
+			version = 200
+		}
+		pages[i] = &historypb.History{Events: []*historypb.HistoryEvent{{EventId: int64(i + 1), Version: version}}}
+	}
+	expectedVersionHistory := versionhistory.NewVersionHistory(nil, []*historyspb.VersionHistoryItem{
+		versionhistory.NewVersionHistoryItem(int64(pageCount-1), 100),
+		versionhistory.NewVersionHistoryItem(int64(pageCount), 200),
+	})
+
+	s.mockResource.ArchivalMetadata.EXPECT().GetHistoryConfig().Return(archiver.NewArchivalConfig(
+		"enabled", dynamicconfig.GetStringPropertyFn("enabled"), dynamicconfig.GetBoolPropertyFn(true), "enabled", "test:///history/archival",
+	)).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil).AnyTimes()
+	historyArchiver := archiver.NewMockHistoryArchiver(s.controller)
+	s.mockResource.ArchiverProvider.EXPECT().GetHistoryArchiver("test", string(primitives.FrontendService)).Return(historyArchiver, nil).AnyTimes()
+	historyArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ archiver.URI, request *archiver.GetHistoryRequest) (*archiver.GetHistoryResponse, error) {
+			s.Equal(s.namespaceID.String(), request.NamespaceID)
+			s.Equal(execution.WorkflowId, request.WorkflowID)
+			s.Equal(execution.RunId, request.RunID)
+			page := 0
+			if request.NextPageToken != nil {
+				var err error
+				page, err = strconv.Atoi(string(request.NextPageToken))
+				s.NoError(err)
+			}
+			resp := &archiver.GetHistoryResponse{HistoryBatches: pages[page : page+1]}
+			if page+1 < pageCount {
+				resp.NextPageToken = []byte(strconv.Itoa(page + 1))
+			}
+			return resp, nil
+		},
+	).Times(2 * pageCount)
+
+	serializer := serialization.NewSerializer()
+	var importedEvents []*historypb.HistoryEvent
+	var tokens [][]byte
+	committed := false
+	s.mockHistoryClient.EXPECT().ImportWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ImportWorkflowExecutionRequest, _ ...interface{}) (*historyservice.ImportWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID.String(), request.NamespaceId)
+			protorequire.ProtoEqual(s.T(), execution, request.Execution)
+			protorequire.ProtoEqual(s.T(), expectedVersionHistory, request.VersionHistory)
+			s.False(committed)
+			tokens = append(tokens, request.Token)
+			for _, blob := range request.HistoryBatches {
+				events, err := serializer.DeserializeEvents(blob)
+				s.NoError(err)
+				importedEvents = append(importedEvents, events...)
+			}
+			if len(request.HistoryBatches) == 0 {
+				// only the commit restores the retention of the workflow
+				s.True(request.RestoreFromArchive)
+				committed = true
+				return &historyservice.ImportWorkflowExecutionResponse{}, nil
+			}
+			s.False(request.RestoreFromArchive)
+			return &historyservice.ImportWorkflowExecutionResponse{Token: []byte(fmt.Sprintf("token-%d", len(tokens)))}, nil
+		},
+	).Times(pageCount + 1)
+
+	var nextPageToken []byte
+	calls := 0
+	for {
+		resp, err := s.handler.RestoreWorkflowExecutionFromArchive(context.Background(), &adminservice.RestoreWorkflowExecutionFromArchiveRequest{
+			Namespace:     s.namespace.String(),
+			Execution:     execution,
+			NextPageToken: nextPageToken,
+		})
+		s.NoError(err)
+		calls++
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+		s.False(committed)
+	}
+	s.True(committed)
+	s.Equal((2*pageCount+restoreFromArchivePagesPerCall-1)/restoreFromArchivePagesPerCall, calls)
+	s.Len(tokens, pageCount+1)
+	s.Nil(tokens[0])
+	for i := 1; i < len(tokens); i++ {
+		s.Equal([]byte(fmt.Sprintf("token-%d", i)), tokens[i])
+	}
+	s.Len(importedEvents, pageCount)
+	for i, event := range importedEvents {
+		s.Equal(int64(i+1), event.EventId)
+	}
+}
+
+func (s *adminHandlerSuite) TestRestoreWorkflowExecutionFromArchive_InvalidNextPageToken() {
+	s.mockResource.ArchivalMetadata.EXPECT().GetHistoryConfig().Return(archiver.NewArchivalConfig(
+		"enabled", dynamicconfig.GetStringPropertyFn("enabled"), dynamicconfig.GetBoolPropertyFn(true), "enabled", "test:///history/archival",
+	))
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespace.NewNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: s.namespace.String(), Id: s.namespaceID.String()},
+		&persistencespb.NamespaceConfig{
+			HistoryArchivalState: enumspb.ARCHIVAL_STATE_ENABLED,
+			HistoryArchivalUri:   "test:///history/archival",
+		},
+		false,
+		nil,
+		int64(100),
+	), nil)
+	s.mockResource.ArchiverProvider.EXPECT().GetHistoryArchiver("test", string(primitives.FrontendService)).Return(archiver.NewMockHistoryArchiver(s.controller), nil)
+	_, err := s.handler.RestoreWorkflowExecutionFromArchive(context.Background(), &adminservice.RestoreWorkflowExecutionFromArchiveRequest{
+		Namespace:     s.namespace.String(),
+		Execution:     &commonpb.WorkflowExecution{WorkflowId: "some random workflow ID", RunId: uuid.New()},
+		NextPageToken: []byte("invalid token"),
+	})
+	s.Equal(errInvalidNextPageToken, err)
+}
+
+func (s *adminHandlerSuite) TestRestoreWorkflowExecutionFromArchive_InvalidRequest() {
+	_, err := s.handler.RestoreWorkflowExecutionFromArchive(context.Background(), &adminservice.RestoreWorkflowExecutionFromArchiveRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "some random workflow ID"},
+	})
+	s.Equal(errRunIDNotSet, err)
+
+	s.mockResource.ArchivalMetadata.EXPECT().GetHistoryConfig().Return(archiver.NewDisabledArchvialConfig()).Times(1)
+	_, err = s.handler.RestoreWorkflowExecutionFromArchive(context.Background(), &adminservice.RestoreWorkflowExecutionFromArchiveRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "some random workflow ID", RunId: uuid.New()},
+	})
+	s.Equal(errClusterIsNotConfiguredForReadingArchivalHistory, err)
+}
+
+func (s *adminHandlerSuite) TestRestoreWorkflowExecutionFromArchive_NamespaceNotConfigured() {
+	s.mockResource.ArchivalMetadata.EXPECT().GetHistoryConfig().Return(archiver.NewArchivalConfig(
+		"enabled", dynamicconfig.GetStringPropertyFn("enabled"), dynamicconfig.GetBoolPropertyFn(true), "disabled", "",
+	))
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil)
+	_, err := s.handler.RestoreWorkflowExecutionFromArchive(context.Background(), &adminservice.RestoreWorkflowExecutionFromArchiveRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "some random workflow ID", RunId: uuid.New()},
+	})
+	s.Equal(errNamespaceIsNotConfiguredForHistoryArchival, err)
+}
+
+func (s *adminHandlerSuite) TestPreviewScheduleBackfill() {
+	s.handler.config.EnableSchedules = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	backfill := []*schedpb.BackfillRequest{{
+		StartTime: timestamppb.New(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)),
+		EndTime:   timestamppb.New(time.Date(2022, 6, 2, 0, 0, 0, 0, time.UTC)),
+	}}
+	entries := []*schedspb.BackfillPreviewEntry{{
+		NominalTime:   timestamppb.New(time.Date(2022, 6, 1, 1, 0, 0, 0, time.UTC)),
+		ActualTime:    timestamppb.New(time.Date(2022, 6, 1, 1, 0, 0, 0, time.UTC)),
+		OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_SKIP,
+		Outcome:       enumsspb.BACKFILL_PREVIEW_OUTCOME_START,
+	}}
+	result, err := sdk.PreferProtoDataConverter.ToPayloads(&schedspb.BackfillPreviewResponse{Entries: entries, Truncated: true})
+	s.NoError(err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.QueryWorkflowRequest, _ ...grpc.CallOption) (*historyservice.QueryWorkflowResponse, error) {
+			s.Equal(s.namespaceID.String(), request.NamespaceId)
+			s.Equal(scheduler.WorkflowIDPrefix+"my-schedule", request.Request.Execution.WorkflowId)
+			s.Equal(scheduler.QueryNameBackfillPreview, request.Request.Query.QueryType)
+			var query schedspb.BackfillPreviewRequest
+			s.NoError(payloads.Decode(request.Request.Query.QueryArgs, &query))
+			protorequire.ProtoEqual(s.T(), &schedspb.BackfillPreviewRequest{BackfillRequest: backfill}, &query)
+			return &historyservice.QueryWorkflowResponse{
+				Response: &workflowservice.QueryWorkflowResponse{QueryResult: result},
+			}, nil
+		})
+
+	resp, err := s.handler.PreviewScheduleBackfill(context.Background(), &adminservice.PreviewScheduleBackfillRequest{
+		Namespace:       s.namespace.String(),
+		ScheduleId:      "my-schedule",
+		BackfillRequest: backfill,
+	})
+	s.NoError(err)
+	s.True(resp.Truncated)
+	s.Len(resp.Entries, 1)
+	protorequire.ProtoEqual(s.T(), entries[0], resp.Entries[0])
+
+	s.handler.config.EnableSchedules = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
+	_, err = s.handler.PreviewScheduleBackfill(context.Background(), &adminservice.PreviewScheduleBackfillRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "my-schedule",
+	})
+	s.Equal(errSchedulesNotAllowed, err)
+}
+
+func (s *adminHandlerSuite) TestDescribeScheduleRunHistory() {
+	s.handler.config.EnableSchedules = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	runs := []*schedspb.RunRecord{{
+		Id:             2,
+		NominalTime:    timestamppb.New(time.Date(2022, 6, 1, 1, 0, 0, 0, time.UTC)),
+		ActualTime:     timestamppb.New(time.Date(2022, 6, 1, 1, 0, 1, 0, time.UTC)),
+		Execution:      &commonpb.WorkflowExecution{WorkflowId: "wf", RunId: "run"},
+		Status:         enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+		FailureMessage: "oops",
+		Duration:       durationpb.New(time.Minute),
+	}}
+	result, err := sdk.PreferProtoDataConverter.ToPayloads(&schedspb.RunHistoryResponse{Runs: runs, NextPageToken: []byte("2")})
+	s.NoError(err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.QueryWorkflowRequest, _ ...grpc.CallOption) (*historyservice.QueryWorkflowResponse, error) {
+			s.Equal(scheduler.WorkflowIDPrefix+"my-schedule", request.Request.Execution.WorkflowId)
+			s.Equal(scheduler.QueryNameRunHistory, request.Request.Query.QueryType)
+			var query schedspb.RunHistoryRequest
+			s.NoError(payloads.Decode(request.Request.Query.QueryArgs, &query))
+			protorequire.ProtoEqual(s.T(), &schedspb.RunHistoryRequest{PageSize: 1, NextPageToken: []byte("5")}, &query)
+			return &historyservice.QueryWorkflowResponse{
+				Response: &workflowservice.QueryWorkflowResponse{QueryResult: result},
+			}, nil
+		})
+
+	resp, err := s.handler.DescribeScheduleRunHistory(context.Background(), &adminservice.DescribeScheduleRunHistoryRequest{
+		Namespace:     s.namespace.String(),
+		ScheduleId:    "my-schedule",
+		PageSize:      1,
+		NextPageToken: []byte("5"),
+	})
+	s.NoError(err)
+	s.Equal([]byte("2"), resp.NextPageToken)
+	s.Len(resp.Runs, 1)
+	protorequire.ProtoEqual(s.T(), runs[0], resp.Runs[0])
+
+	s.handler.config.EnableSchedules = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
+	_, err = s.handler.DescribeScheduleRunHistory(context.Background(), &adminservice.DescribeScheduleRunHistoryRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "my-schedule",
+	})
+	s.Equal(errSchedulesNotAllowed, err)
+}
+
+func (s *adminHandlerSuite) TestDescribeTaskQueuePartition() {
+	taskQueue := &taskqueuepb.TaskQueue{Name: "/_sys/my-queue/2", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	backlogs := []*taskqueuespb.PriorityBacklog{{Priority: 1, Count: 3}, {Priority: 3, Count: 7}}
+	fairnessBacklogs := []*taskqueuespb.FairnessBacklog{{FairnessKey: "tenant-a", Count: 10}}
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.MatchingClient.EXPECT().DescribeTaskQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.DescribeTaskQueueRequest, _ ...grpc.CallOption) (*matchingservice.DescribeTaskQueueResponse, error) {
+			s.Equal(s.namespaceID.String(), request.NamespaceId)
+			s.Equal(taskQueue.Name, request.DescRequest.TaskQueue.Name)
+			s.Equal(enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.DescRequest.TaskQueueType)
+			s.True(request.DescRequest.IncludeTaskQueueStatus)
+			return &matchingservice.DescribeTaskQueueResponse{
+				TaskQueueStatus:  &taskqueuepb.TaskQueueStatus{BacklogCountHint: 10},
+				PriorityBacklogs: backlogs,
+				FairnessBacklogs: fairnessBacklogs,
+			}, nil
+		})
+
+	resp, err := s.handler.DescribeTaskQueuePartition(context.Background(), &adminservice.DescribeTaskQueuePartitionRequest{
+		Namespace: s.namespace.String(),
+		TaskQueue: taskQueue,
+	})
+	s.NoError(err)
+	s.Equal(int64(10), resp.TaskQueueStatus.BacklogCountHint)
+	s.Len(resp.PriorityBacklogs, 2)
+	protorequire.ProtoEqual(s.T(), backlogs[1], resp.PriorityBacklogs[1])
+	s.Len(resp.FairnessBacklogs, 1)
+	protorequire.ProtoEqual(s.T(), fairnessBacklogs[0], resp.FairnessBacklogs[0])
+
+	_, err = s.handler.DescribeTaskQueuePartition(context.Background(), &adminservice.DescribeTaskQueuePartitionRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.Equal(errTaskQueueNotSet, err)
+}
+
+func (s *adminHandlerSuite) TestDescribeTaskQueueBacklog() {
+	stats := &taskqueuespb.BacklogStats{ApproximateBacklogCount: 42, TasksAddRate: 1.5}
+	buildStats := map[string]*taskqueuespb.BacklogStats{"1.0": {ApproximateBacklogCount: 40}}
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.MatchingClient.EXPECT().DescribeTaskQueueBacklog(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.DescribeTaskQueueBacklogRequest, _ ...grpc.CallOption) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+			s.Equal(s.namespaceID.String(), request.NamespaceId)
+			s.Equal("my-queue", request.TaskQueue)
+			s.Equal(enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.TaskQueueType)
+			s.Equal([]string{"1.0"}, request.BuildIds)
+			s.False(request.PartitionOnly)
+			return &matchingservice.DescribeTaskQueueBacklogResponse{
+				Stats:            stats,
+				StatsByBuildId:   buildStats,
+				FailedPartitions: []string{"/_sys/my-queue/3"},
+			}, nil
+		})
+
+	resp, err := s.handler.DescribeTaskQueueBacklog(context.Background(), &adminservice.DescribeTaskQueueBacklogRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "my-queue",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		BuildIds:      []string{"1.0"},
+	})
+	s.NoError(err)
+	protorequire.ProtoEqual(s.T(), stats, resp.Stats)
+	protorequire.ProtoEqual(s.T(), buildStats["1.0"], resp.StatsByBuildId["1.0"])
+	s.Equal([]string{"/_sys/my-queue/3"}, resp.FailedPartitions)
+
+	_, err = s.handler.DescribeTaskQueueBacklog(context.Background(), &adminservice.DescribeTaskQueueBacklogRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.Equal(errTaskQueueNotSet, err)
+}
+
+func (s *adminHandlerSuite) TestPauseTaskQueue() {
+	pauseState := &persistencespb.TaskQueuePauseState{PausedBuildIds: []string{"1.0"}, Reason: "bad deploy"}
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.MatchingClient.EXPECT().SetTaskQueuePaused(gomock.Any(), &matchingservice.SetTaskQueuePausedRequest{
+		NamespaceId: s.namespaceID.String(),
+		TaskQueue:   "my-queue",
+		Paused:      true,
+		BuildId:     "1.0",
+		Reason:      "bad deploy",
+		Identity:    "operator",
+	}).Return(&matchingservice.SetTaskQueuePausedResponse{PauseState: pauseState}, nil)
+
+	resp, err := s.handler.PauseTaskQueue(context.Background(), &adminservice.PauseTaskQueueRequest{
+		Namespace: s.namespace.String(),
+		TaskQueue: "my-queue",
+		BuildId:   "1.0",
+		Reason:    "bad deploy",
+		Identity:  "operator",
+	})
+	s.NoError(err)
+	protorequire.ProtoEqual(s.T(), pauseState, resp.PauseState)
+
+	_, err = s.handler.PauseTaskQueue(context.Background(), &adminservice.PauseTaskQueueRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.Equal(errTaskQueueNotSet, err)
+}
+
+func (s *adminHandlerSuite) TestResumeTaskQueue() {
+	pauseState := &persistencespb.TaskQueuePauseState{Identity: "operator"}
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.MatchingClient.EXPECT().SetTaskQueuePaused(gomock.Any(), &matchingservice.SetTaskQueuePausedRequest{
+		NamespaceId: s.namespaceID.String(),
+		TaskQueue:   "my-queue",
+		Identity:    "operator",
+	}).Return(&matchingservice.SetTaskQueuePausedResponse{PauseState: pauseState}, nil)
+
+	resp, err := s.handler.ResumeTaskQueue(context.Background(), &adminservice.ResumeTaskQueueRequest{
+		Namespace: s.namespace.String(),
+		TaskQueue: "my-queue",
+		Identity:  "operator",
+	})
+	s.NoError(err)
+	protorequire.ProtoEqual(s.T(), pauseState, resp.PauseState)
+
+	_, err = s.handler.ResumeTaskQueue(context.Background(), nil)
+	s.Equal(errRequestNotSet, err)
+}
@@ -85,6 +85,8 @@ var Module = fx.Options(
 	fx.Provide(NamespaceRateLimitInterceptorProvider),
 	fx.Provide(SDKVersionInterceptorProvider),
 	fx.Provide(CallerInfoInterceptorProvider),
+	fx.Provide(AuthorizationAuditLoggerProvider),
+	fx.Provide(WorkflowTypeResolverProvider),
 	fx.Provide(GrpcServerOptionsProvider),
 	fx.Provide(VisibilityManagerProvider),
 	fx.Provide(ThrottledLoggerRpsFnProvider),
@@ -100,6 +102,7 @@ var Module = fx.Options(
 	fx.Provide(HTTPAPIServerProvider),
 	fx.Provide(NewServiceProvider),
 	fx.Invoke(ServiceLifetimeHooks),
+	fx.Invoke(AuthorizerLifetimeHooks),
 )
 
 func NewServiceProvider(
@@ -164,6 +167,8 @@ func GrpcServerOptionsProvider(
 	authorizer authorization.Authorizer,
 	claimMapper authorization.ClaimMapper,
 	audienceGetter authorization.JWTAudienceMapper,
+	auditLogger authorization.AuditLogger,
+	workflowTypeResolver authorization.WorkflowTypeResolver,
 	customInterceptors []grpc.UnaryServerInterceptor,
 	metricsHandler metrics.Handler,
 ) GrpcServerOptions {
@@ -208,6 +213,8 @@ func GrpcServerOptionsProvider(
 			audienceGetter,
 			cfg.Global.Authorization.AuthHeaderName,
 			cfg.Global.Authorization.AuthExtraHeaderName,
+			auditLogger,
+			workflowTypeResolver,
 		),
 		healthInterceptor.Intercept,
 		namespaceValidatorInterceptor.StateValidationIntercept,
@@ -238,6 +245,32 @@ func GrpcServerOptionsProvider(
 	return GrpcServerOptions{Options: grpcServerOptions, UnaryInterceptors: unaryInterceptors}
 }
 
+// AuthorizationAuditLoggerProvider creates the audit logger for authorization decisions.
+// Only the external frontend audits calls, internal-frontend serves system callers.
+func AuthorizationAuditLoggerProvider(
+	lc fx.Lifecycle,
+	cfg *config.Config,
+	serviceName primitives.ServiceName,
+	auditSinks []authorization.AuditSink,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) (authorization.AuditLogger, error) {
+	if serviceName != primitives.FrontendService {
+		return nil, nil
+	}
+	auditLogger, err := authorization.NewAuditLoggerFromConfig(
+		&cfg.Global.Authorization.Audit,
+		auditSinks,
+		metricsHandler,
+		logger,
+	)
+	if err != nil || auditLogger == nil {
+		return nil, err
+	}
+	lc.Append(fx.StartStopHook(auditLogger.Start, auditLogger.Stop))
+	return auditLogger, nil
+}
+
 func ConfigProvider(
 	dc *dynamicconfig.Collection,
 	persistenceConfig config.Persistence,
@@ -444,6 +477,7 @@ func VisibilityManagerProvider(
 	persistenceServiceResolver resolver.ServiceResolver,
 	searchAttributesMapperProvider searchattribute.MapperProvider,
 	saProvider searchattribute.Provider,
+	namespaceRegistry namespace.Registry,
 ) (manager.VisibilityManager, error) {
 	return visibility.NewManager(
 		*persistenceConfig,
@@ -456,10 +490,11 @@ func VisibilityManagerProvider(
 		serviceConfig.VisibilityPersistenceMaxReadQPS,
 		serviceConfig.VisibilityPersistenceMaxWriteQPS,
 		serviceConfig.OperatorRPSRatio,
-		serviceConfig.EnableReadFromSecondaryVisibility,
+		visibility.WithSecondaryVisibilityReadCutover(serviceConfig.EnableReadFromSecondaryVisibility, namespaceRegistry),
 		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff), // frontend visibility never write
 		serviceConfig.VisibilityDisableOrderByClause,
 		serviceConfig.VisibilityEnableManualPagination,
+		serviceConfig.VisibilityMaxCountGroups,
 		metricsHandler,
 		logger,
 	)
@@ -510,6 +545,9 @@ func AdminHandlerProvider(
 	eventSerializer serialization.Serializer,
 	timeSource clock.TimeSource,
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
+	archiverProvider provider.ArchiverProvider,
+	archivalMetadata archiver.ArchivalMetadata,
+	matchingClient resource.MatchingClient,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -538,6 +576,9 @@ func AdminHandlerProvider(
 		timeSource,
 		persistenceExecutionManager,
 		taskCategoryRegistry,
+		archiverProvider,
+		archivalMetadata,
+		matchingClient,
 	}
 	return NewAdminHandler(args)
 }
@@ -665,3 +706,10 @@ func HTTPAPIServerProvider(
 func ServiceLifetimeHooks(lc fx.Lifecycle, svc *Service) {
 	lc.Append(fx.StartStopHook(svc.Start, svc.Stop))
 }
+
+// AuthorizerLifetimeHooks stops authorizers which run in the background, like the policy authorizer.
+func AuthorizerLifetimeHooks(lc fx.Lifecycle, authorizer authorization.Authorizer) {
+	if stoppable, ok := authorizer.(interface{ Stop() }); ok {
+		lc.Append(fx.StopHook(stoppable.Stop))
+	}
+}
@@ -3639,6 +3639,10 @@ func (wh *WorkflowHandler) DescribeBatchOperation(
 		operationType = enumspb.BATCH_OPERATION_TYPE_DELETE
 	case batcher.BatchTypeReset:
 		operationType = enumspb.BATCH_OPERATION_TYPE_RESET
+	case batcher.BatchTypeUpsertMemo, batcher.BatchTypeUpdate:
+		// BatchOperationType has no values for these, they can only be started by starting the batch
+		// workflow directly
+		operationType = enumspb.BATCH_OPERATION_TYPE_UNSPECIFIED
 	default:
 		operationType = enumspb.BATCH_OPERATION_TYPE_UNSPECIFIED
 		wh.throttledLogger.Warn("Unknown batch operation type", tag.NewStringTag("batch-operation-type", operationTypeString))
@@ -3662,6 +3666,8 @@ func (wh *WorkflowHandler) DescribeBatchOperation(
 		batchOperationResp.FailureOperationCount = int64(stats.NumFailure)
 		batchOperationResp.CompleteOperationCount = int64(stats.NumSuccess)
 	} else {
+		// The response has no fields for the paused state, RPS and failures of the operation. They
+		// are in the heartbeat details of the pending activity, see batcher.HeartBeatDetails.
 		if len(resp.GetPendingActivities()) > 0 {
 			hbdPayload := resp.GetPendingActivities()[0].HeartbeatDetails
 			var hbd batcher.HeartBeatDetails
@@ -3670,8 +3676,8 @@ func (wh *WorkflowHandler) DescribeBatchOperation(
 				return nil, err
 			}
 			batchOperationResp.TotalOperationCount = hbd.TotalEstimate
-			batchOperationResp.CompleteOperationCount = int64(hbd.SuccessCount)
-			batchOperationResp.FailureOperationCount = int64(hbd.ErrorCount)
+			batchOperationResp.CompleteOperationCount = int64(hbd.SuccessCount + hbd.PageSuccessCount)
+			batchOperationResp.FailureOperationCount = int64(hbd.ErrorCount + hbd.PageErrorCount)
 		}
 	}
 	return batchOperationResp, nil
@@ -0,0 +1,84 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/resource"
+)
+
+type (
+	// workflowTypeResolver looks up the workflow type of an execution from its mutable state,
+	// for authorizers that match on the workflow type of calls like SignalWorkflowExecution.
+	workflowTypeResolver struct {
+		namespaceRegistry namespace.Registry
+		historyClient     historyservice.HistoryServiceClient
+	}
+)
+
+var _ authorization.WorkflowTypeResolver = (*workflowTypeResolver)(nil)
+
+func WorkflowTypeResolverProvider(
+	namespaceRegistry namespace.Registry,
+	historyClient resource.HistoryClient,
+) authorization.WorkflowTypeResolver {
+	return &workflowTypeResolver{
+		namespaceRegistry: namespaceRegistry,
+		historyClient:     historyClient,
+	}
+}
+
+func (r *workflowTypeResolver) ResolveWorkflowType(
+	ctx context.Context,
+	ns string,
+	execution *commonpb.WorkflowExecution,
+) (string, error) {
+	namespaceID, err := r.namespaceRegistry.GetNamespaceID(namespace.Name(ns))
+	if err != nil {
+		var notFound *serviceerror.NamespaceNotFound
+		if errors.As(err, &notFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	resp, err := r.historyClient.GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   execution,
+	})
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return resp.GetWorkflowType().GetName(), nil
+}
@@ -83,6 +83,8 @@ func Invoke(
 		scheduledEventID       int64
 		scheduleToStartTimeout time.Duration
 		directive              *taskqueuespb.TaskVersionDirective
+		priority               int32
+		fairnessKey            string
 	)
 
 	err := api.GetAndUpdateWorkflowWithNew(
@@ -177,6 +179,8 @@ func Invoke(
 				ms.GetWorkerVersionStamp(),
 				ms.GetLastWorkflowTaskStartedEventID(),
 			)
+			priority = ms.GetExecutionInfo().TaskPriority
+			fairnessKey = ms.GetExecutionInfo().TaskFairnessKey
 
 			return &api.UpdateWorkflowAction{
 				Noop:               true,
@@ -199,7 +203,7 @@ func Invoke(
 	// TODO (alex): This code is copied from transferQueueActiveTaskExecutor.processWorkflowTask.
 	//   Helper function needs to be extracted to avoid code duplication.
 	if scheduledEventID != common.EmptyEventID {
-		err = addWorkflowTaskToMatching(ctx, wfKey, taskQueue, scheduledEventID, scheduleToStartTimeout, namespace.ID(req.GetNamespaceId()), directive, shardCtx, matchingClient)
+		err = addWorkflowTaskToMatching(ctx, wfKey, taskQueue, scheduledEventID, scheduleToStartTimeout, namespace.ID(req.GetNamespaceId()), directive, priority, fairnessKey, shardCtx, matchingClient)
 
 		if _, isStickyWorkerUnavailable := err.(*serviceerrors.StickyWorkerUnavailable); isStickyWorkerUnavailable {
 			// If sticky worker is unavailable, switch to original normal task queue.
@@ -207,7 +211,7 @@ func Invoke(
 				Name: normalTaskQueueName,
 				Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
 			}
-			err = addWorkflowTaskToMatching(ctx, wfKey, taskQueue, scheduledEventID, scheduleToStartTimeout, namespace.ID(req.GetNamespaceId()), directive, shardCtx, matchingClient)
+			err = addWorkflowTaskToMatching(ctx, wfKey, taskQueue, scheduledEventID, scheduleToStartTimeout, namespace.ID(req.GetNamespaceId()), directive, priority, fairnessKey, shardCtx, matchingClient)
 		}
 
 		if err != nil {
@@ -266,6 +270,8 @@ func addWorkflowTaskToMatching(
 	wtScheduleToStartTimeout time.Duration,
 	nsID namespace.ID,
 	directive *taskqueuespb.TaskVersionDirective,
+	priority int32,
+	fairnessKey string,
 	shardCtx shard.Context,
 	matchingClient matchingservice.MatchingServiceClient,
 ) error {
@@ -285,6 +291,8 @@ func addWorkflowTaskToMatching(
 		ScheduleToStartTimeout: durationpb.New(wtScheduleToStartTimeout),
 		Clock:                  clock,
 		VersionDirective:       directive,
+		Priority:               priority,
+		FairnessKey:            fairnessKey,
 	})
 	if err != nil {
 		return err
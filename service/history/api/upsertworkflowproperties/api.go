@@ -0,0 +1,104 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package upsertworkflowproperties
+
+import (
+	"context"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/shard"
+)
+
+func Invoke(
+	ctx context.Context,
+	req *historyservice.UpsertWorkflowPropertiesRequest,
+	shard shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (resp *historyservice.UpsertWorkflowPropertiesResponse, retError error) {
+	namespaceEntry, err := api.GetActiveNamespace(shard, namespace.ID(req.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	namespaceName := namespaceEntry.Name().String()
+
+	if len(req.GetMemo().GetFields()) == 0 {
+		return nil, serviceerror.NewInvalidArgument("memo is not set on request.")
+	}
+
+	err = api.GetAndUpdateWorkflowWithNew(
+		ctx,
+		nil,
+		api.BypassMutableStateConsistencyPredicate,
+		definition.NewWorkflowKey(
+			req.NamespaceId,
+			req.WorkflowExecution.WorkflowId,
+			req.WorkflowExecution.RunId,
+		),
+		func(workflowContext api.WorkflowContext) (*api.UpdateWorkflowAction, error) {
+			mutableState := workflowContext.GetMutableState()
+			releaseFn := workflowContext.GetReleaseFn()
+			if !mutableState.IsWorkflowExecutionRunning() {
+				// in-memory mutable state is still clean, release the lock with nil error to prevent
+				// clearing and reloading mutable state
+				releaseFn(nil)
+				return nil, consts.ErrWorkflowCompleted
+			}
+
+			// size limit applies to the result of the upsert
+			memoSize := (&commonpb.Memo{
+				Fields: payload.MergeMapOfPayload(mutableState.GetExecutionInfo().Memo, req.GetMemo().GetFields()),
+			}).Size()
+			if memoLimit := shard.GetConfig().MemoSizeLimitError(namespaceName); memoSize > memoLimit {
+				releaseFn(nil)
+				return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("memo size %d exceeds limit %d", memoSize, memoLimit))
+			}
+
+			if _, err := mutableState.AddWorkflowPropertiesModifiedExternallyEvent(req.GetMemo()); err != nil {
+				return nil, err
+			}
+
+			// Like a signal, the workflow gets a workflow task to see the change. Do not create one
+			// when the workflow has first workflow task backoff and execution is not started yet.
+			return &api.UpdateWorkflowAction{
+				Noop:               false,
+				CreateWorkflowTask: !mutableState.IsWorkflowPendingOnWorkflowTaskBackoff(),
+			}, nil
+		},
+		nil,
+		shard,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.UpsertWorkflowPropertiesResponse{}, nil
+}
@@ -28,7 +28,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -128,7 +127,7 @@ func (e *archivalQueueTaskExecutor) processArchiveExecutionTask(ctx context.Cont
 			return err
 		}
 	}
-	return e.addDeletionTask(ctx, logger, task, request.CloseTime.AsTime())
+	return e.addDeletionTask(ctx, logger, task)
 }
 
 // getArchiveTaskRequest returns an archival request for the given archive execution task.
@@ -242,7 +241,6 @@ func (e *archivalQueueTaskExecutor) addDeletionTask(
 	ctx context.Context,
 	logger log.Logger,
 	task *tasks.ArchiveExecutionTask,
-	closeTime time.Time,
 ) error {
 	mutableState, err := e.loadAndVersionCheckMutableState(ctx, logger, task)
 	if err != nil {
@@ -252,13 +250,19 @@ func (e *archivalQueueTaskExecutor) addDeletionTask(
 		mutableState.Release(err)
 	}()
 
+	// the retention of a workflow restored from its archived history starts when it was restored
+	retentionStartTime, err := mutableState.GetWorkflowRetentionStartTime(ctx)
+	if err != nil {
+		return err
+	}
+
 	taskGenerator := workflow.NewTaskGenerator(
 		e.shardContext.GetNamespaceRegistry(),
 		mutableState,
 		e.shardContext.GetConfig(),
 		e.shardContext.GetArchivalMetadata(),
 	)
-	err = taskGenerator.GenerateDeleteHistoryEventTask(closeTime)
+	err = taskGenerator.GenerateDeleteHistoryEventTask(retentionStartTime)
 	if err != nil {
 		return err
 	}
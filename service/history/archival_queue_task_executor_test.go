@@ -219,6 +219,14 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 				p.ExpectAddTask = false
 			},
 		},
+		{
+			Name: "restored workflow",
+			Configure: func(p *params) {
+				// the archived close time is kept, but the retention starts when the workflow was restored
+				p.RetentionStartTime = p.CloseTime.Add(48 * time.Hour)
+				p.ExpectedDeleteTime = p.RetentionStartTime.Add(time.Hour)
+			},
+		},
 		{
 			Name: "get current branch token error",
 			Configure: func(p *params) {
@@ -317,6 +325,7 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 			p.StartTime = time.Unix(0, 0).UTC()
 			p.ExecutionTime = time.Unix(0, 0).UTC()
 			p.CloseTime = time.Unix(0, 0).UTC().Add(time.Minute * 2)
+			p.RetentionStartTime = p.CloseTime
 			p.Retention = durationpb.New(time.Hour)
 			// delete time = close time + retention
 			// delete time = 2 minutes + 1 hour = 1 hour 2 minutes
@@ -415,6 +424,10 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 					p.CloseTime,
 					p.GetWorkflowCloseTimeError,
 				).AnyTimes()
+				mutableState.EXPECT().GetWorkflowRetentionStartTime(gomock.Any()).Return(
+					p.RetentionStartTime,
+					p.GetWorkflowCloseTimeError,
+				).AnyTimes()
 				executionInfo := &persistence.WorkflowExecutionInfo{
 					NamespaceId:                  tests.NamespaceID.String(),
 					StartTime:                    timestamppb.New(p.StartTime),
@@ -562,6 +575,7 @@ type params struct {
 	StartTime                              time.Time
 	ExecutionTime                          time.Time
 	CloseTime                              time.Time
+	RetentionStartTime                     time.Time
 	GetNamespaceByIDError                  error
 	HistoryURI                             string
 	VisibilityURI                          string
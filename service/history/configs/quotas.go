@@ -85,6 +85,7 @@ var (
 		"GenerateLastHistoryReplicationTasks":    1,
 		"GetReplicationStatus":                   1,
 		"DeleteWorkflowVisibilityRecord":         1,
+		"UpsertWorkflowProperties":               1,
 		"UpdateWorkflowExecution":                1,
 		"PollWorkflowExecutionUpdate":            1,
 		"StreamWorkflowReplicationMessages":      1,
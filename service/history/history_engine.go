@@ -89,6 +89,7 @@ import (
 	"go.temporal.io/server/service/history/api/startworkflow"
 	"go.temporal.io/server/service/history/api/terminateworkflow"
 	"go.temporal.io/server/service/history/api/updateworkflow"
+	"go.temporal.io/server/service/history/api/upsertworkflowproperties"
 	"go.temporal.io/server/service/history/api/verifychildworkflowcompletionrecorded"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
@@ -593,6 +594,14 @@ func (e *historyEngineImpl) RemoveSignalMutableState(
 	return removesignalmutablestate.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker)
 }
 
+// UpsertWorkflowProperties upserts the memo of a workflow from outside the workflow
+func (e *historyEngineImpl) UpsertWorkflowProperties(
+	ctx context.Context,
+	req *historyservice.UpsertWorkflowPropertiesRequest,
+) (*historyservice.UpsertWorkflowPropertiesResponse, error) {
+	return upsertworkflowproperties.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker)
+}
+
 func (e *historyEngineImpl) TerminateWorkflowExecution(
 	ctx context.Context,
 	req *historyservice.TerminateWorkflowExecutionRequest,
@@ -703,6 +712,7 @@ func (e *historyEngineImpl) ImportWorkflowExecution(
 		request.VersionHistory.Items,
 		historyEvents,
 		request.Token,
+		request.RestoreFromArchive,
 	)
 	if err != nil {
 		return nil, err
@@ -343,6 +343,23 @@ func (s *historyBuilderSuite) TestWorkflowExecutionSignaled() {
 	}, event)
 }
 
+func (s *historyBuilderSuite) TestWorkflowExecutionMemoModifiedExternally() {
+	event := s.historyBuilder.AddWorkflowPropertiesModifiedExternallyEvent(testMemo)
+	s.Equal(event, s.flush())
+	s.Equal(&historypb.HistoryEvent{
+		EventId:   common.BufferedEventID,
+		TaskId:    common.EmptyEventTaskID,
+		EventTime: timestamppb.New(s.now),
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY,
+		Version:   s.version,
+		Attributes: &historypb.HistoryEvent_WorkflowPropertiesModifiedExternallyEventAttributes{
+			WorkflowPropertiesModifiedExternallyEventAttributes: &historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+				UpsertedMemo: testMemo,
+			},
+		},
+	}, event)
+}
+
 func (s *historyBuilderSuite) TestWorkflowExecutionMarkerRecord() {
 	workflowTaskCompletionEventID := rand.Int63()
 	attributes := &commandpb.RecordMarkerCommandAttributes{
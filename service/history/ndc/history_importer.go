@@ -30,6 +30,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -52,6 +53,7 @@ type (
 			versionHistoryItems []*historyspb.VersionHistoryItem,
 			events [][]*historypb.HistoryEvent,
 			token []byte,
+			restoreFromArchive bool,
 		) ([]byte, bool, error)
 	}
 
@@ -135,6 +137,7 @@ func (r *HistoryImporterImpl) ImportWorkflow(
 	versionHistoryItems []*historyspb.VersionHistoryItem,
 	eventsSlice [][]*historypb.HistoryEvent,
 	token []byte,
+	restoreFromArchive bool,
 ) (_ []byte, _ bool, retError error) {
 	if len(eventsSlice) == 0 && len(token) == 0 {
 		return nil, false, serviceerror.NewInvalidArgument("ImportWorkflowExecution cannot import empty history events")
@@ -167,6 +170,7 @@ func (r *HistoryImporterImpl) ImportWorkflow(
 		ctx,
 		ndcWorkflow,
 		mutableStateSpec,
+		restoreFromArchive,
 	); err != nil {
 		return nil, false, err
 	}
@@ -343,11 +347,18 @@ func (r *HistoryImporterImpl) commit(
 	ctx context.Context,
 	memNDCWorkflow Workflow,
 	mutableStateSpec MutableStateInitializationSpec,
+	restoreFromArchive bool,
 ) (retError error) {
 	if mutableStateSpec.IsBrandNew {
 		return serviceerror.NewInvalidArgument("HistoryImporter::commit cannot create workflow without events")
 	}
 
+	if restoreFromArchive {
+		// the close tasks refreshed below start the retention of a restored workflow from now,
+		// as the retention counted from its close time has usually passed already
+		memNDCWorkflow.GetMutableState().GetExecutionInfo().RestoreTime = timestamppb.New(r.shardContext.GetTimeSource().Now())
+	}
+
 	if !mutableStateSpec.ExistsInDB {
 		// refresh tasks to be generated
 		if err := r.taskRefresher.RefreshTasks(
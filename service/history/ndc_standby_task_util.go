@@ -112,6 +112,8 @@ type (
 		taskQueue                          string
 		activityTaskScheduleToStartTimeout time.Duration
 		versionDirective                   *taskqueuespb.TaskVersionDirective
+		priority                           int32
+		fairnessKey                        string
 	}
 
 	workflowTaskPostActionInfo struct {
@@ -120,6 +122,8 @@ type (
 		workflowTaskScheduleToStartTimeout time.Duration
 		taskqueue                          *taskqueuepb.TaskQueue
 		versionDirective                   *taskqueuespb.TaskVersionDirective
+		priority                           int32
+		fairnessKey                        string
 	}
 )
 
@@ -137,6 +141,8 @@ func newActivityTaskPostActionInfo(
 	mutableState workflow.MutableState,
 	activityScheduleToStartTimeout time.Duration,
 	useCompatibleVersion bool,
+	priority int32,
+	fairnessKey string,
 ) (*activityTaskPostActionInfo, error) {
 	resendInfo, err := getHistoryResendInfo(mutableState)
 	if err != nil {
@@ -149,6 +155,8 @@ func newActivityTaskPostActionInfo(
 		historyResendInfo:                  resendInfo,
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
 		versionDirective:                   directive,
+		priority:                           priority,
+		fairnessKey:                        fairnessKey,
 	}, nil
 }
 
@@ -157,6 +165,8 @@ func newActivityRetryTimePostActionInfo(
 	taskQueue string,
 	activityScheduleToStartTimeout time.Duration,
 	useCompatibleVersion bool,
+	priority int32,
+	fairnessKey string,
 ) (*activityTaskPostActionInfo, error) {
 	resendInfo, err := getHistoryResendInfo(mutableState)
 	if err != nil {
@@ -170,6 +180,8 @@ func newActivityRetryTimePostActionInfo(
 		taskQueue:                          taskQueue,
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
 		versionDirective:                   directive,
+		priority:                           priority,
+		fairnessKey:                        fairnessKey,
 	}, nil
 }
 
@@ -193,6 +205,8 @@ func newWorkflowTaskPostActionInfo(
 		workflowTaskScheduleToStartTimeout: workflowTaskScheduleToStartTimeout,
 		taskqueue:                          taskqueue,
 		versionDirective:                   directive,
+		priority:                           mutableState.GetExecutionInfo().TaskPriority,
+		fairnessKey:                        mutableState.GetExecutionInfo().TaskFairnessKey,
 	}, nil
 }
 
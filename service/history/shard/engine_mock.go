@@ -926,6 +926,21 @@ func (mr *MockEngineMockRecorder) UpdateWorkflowExecution(ctx, request interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).UpdateWorkflowExecution), ctx, request)
 }
 
+// UpsertWorkflowProperties mocks base method.
+func (m *MockEngine) UpsertWorkflowProperties(ctx context.Context, request *historyservice.UpsertWorkflowPropertiesRequest) (*historyservice.UpsertWorkflowPropertiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowProperties", ctx, request)
+	ret0, _ := ret[0].(*historyservice.UpsertWorkflowPropertiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkflowProperties indicates an expected call of UpsertWorkflowProperties.
+func (mr *MockEngineMockRecorder) UpsertWorkflowProperties(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowProperties", reflect.TypeOf((*MockEngine)(nil).UpsertWorkflowProperties), ctx, request)
+}
+
 // VerifyChildExecutionCompletionRecorded mocks base method.
 func (m *MockEngine) VerifyChildExecutionCompletionRecorded(ctx context.Context, request *historyservice.VerifyChildExecutionCompletionRecordedRequest) (*historyservice.VerifyChildExecutionCompletionRecordedResponse, error) {
 	m.ctrl.T.Helper()
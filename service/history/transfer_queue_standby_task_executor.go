@@ -158,7 +158,7 @@ func (t *transferQueueStandbyTaskExecutor) processActivityTask(
 		}
 
 		if activityInfo.StartedEventId == common.EmptyEventID {
-			return newActivityTaskPostActionInfo(mutableState, activityInfo.ScheduleToStartTimeout.AsDuration(), activityInfo.UseCompatibleVersion)
+			return newActivityTaskPostActionInfo(mutableState, activityInfo.ScheduleToStartTimeout.AsDuration(), activityInfo.UseCompatibleVersion, activityInfo.Priority, activityInfo.FairnessKey)
 		}
 
 		return nil, nil
@@ -542,6 +542,8 @@ func (t *transferQueueStandbyTaskExecutor) pushActivity(
 		task.(*tasks.ActivityTask),
 		timeout,
 		pushActivityInfo.versionDirective,
+		pushActivityInfo.priority,
+		pushActivityInfo.fairnessKey,
 	)
 }
 
@@ -562,6 +564,8 @@ func (t *transferQueueStandbyTaskExecutor) pushWorkflowTask(
 		pushwtInfo.taskqueue,
 		pushwtInfo.workflowTaskScheduleToStartTimeout,
 		pushwtInfo.versionDirective,
+		pushwtInfo.priority,
+		pushwtInfo.fairnessKey,
 	)
 }
 
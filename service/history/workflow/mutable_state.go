@@ -149,6 +149,7 @@ type (
 		AddTimerStartedEvent(int64, *commandpb.StartTimerCommandAttributes) (*historypb.HistoryEvent, *persistencespb.TimerInfo, error)
 		AddUpsertWorkflowSearchAttributesEvent(int64, *commandpb.UpsertWorkflowSearchAttributesCommandAttributes) (*historypb.HistoryEvent, error)
 		AddWorkflowPropertiesModifiedEvent(int64, *commandpb.ModifyWorkflowPropertiesCommandAttributes) (*historypb.HistoryEvent, error)
+		AddWorkflowPropertiesModifiedExternallyEvent(*commonpb.Memo) (*historypb.HistoryEvent, error)
 		AddWorkflowExecutionCancelRequestedEvent(*historyservice.RequestCancelWorkflowExecutionRequest) (*historypb.HistoryEvent, error)
 		AddWorkflowExecutionCanceledEvent(int64, *commandpb.CancelWorkflowExecutionCommandAttributes) (*historypb.HistoryEvent, error)
 		AddWorkflowExecutionSignaled(signalName string, input *commonpb.Payloads, identity string, header *commonpb.Header, skipGenerateWorkflowTask bool) (*historypb.HistoryEvent, error)
@@ -179,6 +180,7 @@ type (
 		GetChildExecutionInitiatedEvent(context.Context, int64) (*historypb.HistoryEvent, error)
 		GetCompletionEvent(context.Context) (*historypb.HistoryEvent, error)
 		GetWorkflowCloseTime(ctx context.Context) (time.Time, error)
+		GetWorkflowRetentionStartTime(ctx context.Context) (time.Time, error)
 		GetWorkflowTaskByID(scheduledEventID int64) *WorkflowTaskInfo
 		GetNamespaceEntry() *namespace.Namespace
 		GetStartEvent(context.Context) (*historypb.HistoryEvent, error)
@@ -267,6 +269,7 @@ type (
 		ApplyTimerStartedEvent(*historypb.HistoryEvent) (*persistencespb.TimerInfo, error)
 		ApplyTransientWorkflowTaskScheduled() (*WorkflowTaskInfo, error)
 		ApplyWorkflowPropertiesModifiedEvent(*historypb.HistoryEvent)
+		ApplyWorkflowPropertiesModifiedExternallyEvent(*historypb.HistoryEvent)
 		ApplyUpsertWorkflowSearchAttributesEvent(*historypb.HistoryEvent)
 		ApplyWorkflowExecutionCancelRequestedEvent(*historypb.HistoryEvent) error
 		ApplyWorkflowExecutionCanceledEvent(int64, *historypb.HistoryEvent) error
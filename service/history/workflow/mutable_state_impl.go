@@ -69,6 +69,7 @@ import (
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/taskpriority"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/service/history/configs"
@@ -1086,6 +1087,19 @@ func (ms *MutableStateImpl) GetWorkflowCloseTime(ctx context.Context) (time.Time
 	return ms.executionInfo.CloseTime.AsTime(), nil
 }
 
+// GetWorkflowRetentionStartTime returns the time the retention of the closed workflow starts from,
+// which is the close time, or the restore time of a workflow restored from its archived history.
+func (ms *MutableStateImpl) GetWorkflowRetentionStartTime(ctx context.Context) (time.Time, error) {
+	closeTime, err := ms.GetWorkflowCloseTime(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if restoreTime := ms.executionInfo.GetRestoreTime(); restoreTime != nil && restoreTime.AsTime().After(closeTime) {
+		return restoreTime.AsTime(), nil
+	}
+	return closeTime, nil
+}
+
 // GetStartEvent retrieves the workflow start event from mutable state
 func (ms *MutableStateImpl) GetStartEvent(
 	ctx context.Context,
@@ -1842,6 +1856,8 @@ func (ms *MutableStateImpl) ApplyWorkflowExecutionStartedEvent(
 	ms.executionInfo.WorkflowRunTimeout = event.GetWorkflowRunTimeout()
 	ms.executionInfo.WorkflowExecutionTimeout = event.GetWorkflowExecutionTimeout()
 	ms.executionInfo.DefaultWorkflowTaskTimeout = event.GetWorkflowTaskTimeout()
+	ms.executionInfo.TaskPriority = taskpriority.FromHeader(event.GetHeader())
+	ms.executionInfo.TaskFairnessKey = taskpriority.FairnessKeyFromHeader(event.GetHeader())
 
 	if err := ms.UpdateWorkflowStateStatus(
 		enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
@@ -2356,6 +2372,8 @@ func (ms *MutableStateImpl) ApplyActivityTaskScheduledEvent(
 		Attempt:                 1,
 		UseCompatibleVersion:    attributes.UseCompatibleVersion,
 		ActivityType:            attributes.GetActivityType(),
+		Priority:                taskpriority.FromHeader(attributes.GetHeader()),
+		FairnessKey:             taskpriority.FairnessKeyFromHeader(attributes.GetHeader()),
 	}
 	if ai.HasRetryPolicy {
 		ai.RetryInitialInterval = attributes.RetryPolicy.GetInitialInterval()
@@ -3221,6 +3239,37 @@ func (ms *MutableStateImpl) ApplyWorkflowPropertiesModifiedEvent(
 	}
 }
 
+// AddWorkflowPropertiesModifiedExternallyEvent upserts the memo of the workflow from outside the
+// workflow.
+func (ms *MutableStateImpl) AddWorkflowPropertiesModifiedExternallyEvent(
+	upsertedMemo *commonpb.Memo,
+) (*historypb.HistoryEvent, error) {
+	opTag := tag.WorkflowActionWorkflowPropertiesModified
+	if err := ms.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := ms.hBuilder.AddWorkflowPropertiesModifiedExternallyEvent(upsertedMemo)
+	ms.ApplyWorkflowPropertiesModifiedExternallyEvent(event)
+	// TODO merge active & passive task generation
+	if err := ms.taskGenerator.GenerateUpsertVisibilityTask(); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (ms *MutableStateImpl) ApplyWorkflowPropertiesModifiedExternallyEvent(
+	event *historypb.HistoryEvent,
+) {
+	attr := event.GetWorkflowPropertiesModifiedExternallyEventAttributes()
+	if attr.UpsertedMemo != nil {
+		upsertMemo := attr.GetUpsertedMemo().GetFields()
+		ms.approximateSize -= ms.executionInfo.Size()
+		ms.executionInfo.Memo = payload.MergeMapOfPayload(ms.executionInfo.Memo, upsertMemo)
+		ms.approximateSize += ms.executionInfo.Size()
+	}
+}
+
 func (ms *MutableStateImpl) AddExternalWorkflowExecutionSignaled(
 	initiatedID int64,
 	targetNamespace namespace.Name,
@@ -59,6 +59,7 @@ import (
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -1501,3 +1502,62 @@ func (s *mutableStateSuite) TestCollapseVisibilityTasks() {
 		)
 	}
 }
+
+func (s *mutableStateSuite) TestAddWorkflowPropertiesModifiedExternallyEvent() {
+	dbState := s.buildWorkflowMutableState()
+	dbState.ExecutionInfo.Memo = map[string]*commonpb.Payload{
+		"incident": payload.EncodeString("old"),
+		"owner":    payload.EncodeString("kept"),
+	}
+	var err error
+	s.mutableState, err = NewMutableStateFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, dbState, 123)
+	s.NoError(err)
+	s.mutableState.GetExecutionInfo().WorkflowTaskScheduledEventId = common.EmptyEventID
+	s.mutableState.GetExecutionInfo().WorkflowTaskStartedEventId = common.EmptyEventID
+
+	event, err := s.mutableState.AddWorkflowPropertiesModifiedExternallyEvent(
+		&commonpb.Memo{Fields: map[string]*commonpb.Payload{
+			"incident": payload.EncodeString("INC-1"),
+		}},
+	)
+	s.NoError(err)
+
+	s.Equal(enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY, event.EventType)
+	executionInfo := s.mutableState.GetExecutionInfo()
+	s.Equal(`"INC-1"`, payload.ToString(executionInfo.Memo["incident"]))
+	s.Equal(`"kept"`, payload.ToString(executionInfo.Memo["owner"]))
+	s.Len(s.mutableState.InsertTasks[tasks.CategoryVisibility], 1)
+	s.Equal(enumsspb.TASK_TYPE_VISIBILITY_UPSERT_EXECUTION, s.mutableState.InsertTasks[tasks.CategoryVisibility][0].GetType())
+}
+
+func (s *mutableStateSuite) TestRefreshWorkflowCloseTasks_RestoredWorkflow() {
+	now := time.Now().UTC()
+	s.mockShard.Resource.NamespaceCache.EXPECT().GetNamespaceByID(gomock.Any()).Return(tests.LocalNamespaceEntry, nil).AnyTimes()
+	refresher := NewTaskRefresher(s.mockShard, s.mockConfig, s.mockShard.GetNamespaceRegistry(), s.logger)
+
+	deleteTime := func(restoreTime *timestamppb.Timestamp) time.Time {
+		dbState := s.buildWorkflowMutableState()
+		dbState.ExecutionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED
+		dbState.ExecutionState.Status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+		// closed long before the retention of the namespace
+		dbState.ExecutionInfo.CloseTime = timestamppb.New(now.Add(-30 * 24 * time.Hour))
+		dbState.ExecutionInfo.RestoreTime = restoreTime
+		mutableState, err := NewMutableStateFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, dbState, 123)
+		s.NoError(err)
+
+		taskGenerator := NewTaskGenerator(s.mockShard.GetNamespaceRegistry(), mutableState, s.mockConfig, s.mockShard.GetArchivalMetadata())
+		s.NoError(refresher.refreshTasksForWorkflowClose(context.Background(), mutableState, taskGenerator))
+		for _, task := range mutableState.InsertTasks[tasks.CategoryTimer] {
+			if task.GetType() == enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT {
+				return task.GetVisibilityTime()
+			}
+		}
+		s.FailNow("no delete history event task")
+		return time.Time{}
+	}
+
+	// without a restore time, the workflow is deleted on the next timer tick
+	s.True(deleteTime(nil).Before(now))
+	// a restored workflow is kept for the retention of the namespace from its restore time
+	s.False(deleteTime(timestamppb.New(now)).Before(now.Add(tests.LocalNamespaceEntry.Retention())))
+}
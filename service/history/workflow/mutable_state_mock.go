@@ -729,6 +729,21 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowPropertiesModifiedEvent(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowPropertiesModifiedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowPropertiesModifiedEvent), arg0, arg1)
 }
 
+// AddWorkflowPropertiesModifiedExternallyEvent mocks base method.
+func (m *MockMutableState) AddWorkflowPropertiesModifiedExternallyEvent(arg0 *v10.Memo) (*v13.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowPropertiesModifiedExternallyEvent", arg0)
+	ret0, _ := ret[0].(*v13.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowPropertiesModifiedExternallyEvent indicates an expected call of AddWorkflowPropertiesModifiedExternallyEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowPropertiesModifiedExternallyEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowPropertiesModifiedExternallyEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowPropertiesModifiedExternallyEvent), arg0)
+}
+
 // AddWorkflowTaskCompletedEvent mocks base method.
 func (m *MockMutableState) AddWorkflowTaskCompletedEvent(arg0 *WorkflowTaskInfo, arg1 *v17.RespondWorkflowTaskCompletedRequest, arg2 WorkflowTaskCompletionLimits) (*v13.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -1369,6 +1384,18 @@ func (mr *MockMutableStateMockRecorder) ApplyWorkflowPropertiesModifiedEvent(arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyWorkflowPropertiesModifiedEvent", reflect.TypeOf((*MockMutableState)(nil).ApplyWorkflowPropertiesModifiedEvent), arg0)
 }
 
+// ApplyWorkflowPropertiesModifiedExternallyEvent mocks base method.
+func (m *MockMutableState) ApplyWorkflowPropertiesModifiedExternallyEvent(arg0 *v13.HistoryEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ApplyWorkflowPropertiesModifiedExternallyEvent", arg0)
+}
+
+// ApplyWorkflowPropertiesModifiedExternallyEvent indicates an expected call of ApplyWorkflowPropertiesModifiedExternallyEvent.
+func (mr *MockMutableStateMockRecorder) ApplyWorkflowPropertiesModifiedExternallyEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyWorkflowPropertiesModifiedExternallyEvent", reflect.TypeOf((*MockMutableState)(nil).ApplyWorkflowPropertiesModifiedExternallyEvent), arg0)
+}
+
 // ApplyWorkflowTaskCompletedEvent mocks base method.
 func (m *MockMutableState) ApplyWorkflowTaskCompletedEvent(arg0 *v13.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -2264,6 +2291,21 @@ func (mr *MockMutableStateMockRecorder) GetWorkflowKey() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowKey", reflect.TypeOf((*MockMutableState)(nil).GetWorkflowKey))
 }
 
+// GetWorkflowRetentionStartTime mocks base method.
+func (m *MockMutableState) GetWorkflowRetentionStartTime(ctx context.Context) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowRetentionStartTime", ctx)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowRetentionStartTime indicates an expected call of GetWorkflowRetentionStartTime.
+func (mr *MockMutableStateMockRecorder) GetWorkflowRetentionStartTime(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowRetentionStartTime", reflect.TypeOf((*MockMutableState)(nil).GetWorkflowRetentionStartTime), ctx)
+}
+
 // GetWorkflowStateStatus mocks base method.
 func (m *MockMutableState) GetWorkflowStateStatus() (v19.WorkflowExecutionState, v11.WorkflowExecutionStatus) {
 	m.ctrl.T.Helper()
@@ -549,6 +549,12 @@ func (b *MutableStateRebuilderImpl) applyEvents(
 				return nil, err
 			}
 
+		case enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY:
+			b.mutableState.ApplyWorkflowPropertiesModifiedExternallyEvent(event)
+			if err := taskGenerator.GenerateUpsertVisibilityTask(); err != nil {
+				return nil, err
+			}
+
 		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
 			if err := b.mutableState.ApplyWorkflowExecutionCompletedEvent(
 				firstEvent.GetEventId(),
@@ -681,9 +687,8 @@ func (b *MutableStateRebuilderImpl) applyEvents(
 				return nil, err
 			}
 
-		case enumspb.EVENT_TYPE_ACTIVITY_PROPERTIES_MODIFIED_EXTERNALLY,
-			enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY:
-			return nil, serviceerror.NewUnimplemented("Workflow/activity property modification not implemented")
+		case enumspb.EVENT_TYPE_ACTIVITY_PROPERTIES_MODIFIED_EXTERNALLY:
+			return nil, serviceerror.NewUnimplemented("Activity property modification not implemented")
 
 		default:
 			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Unknown event type: %v", event.GetEventType()))
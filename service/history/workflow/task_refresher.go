@@ -195,13 +195,13 @@ func (r *TaskRefresherImpl) refreshTasksForWorkflowClose(
 	if executionState.Status == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
 		return nil
 	}
-	closeEventTime, err := mutableState.GetWorkflowCloseTime(ctx)
+	retentionStartTime, err := mutableState.GetWorkflowRetentionStartTime(ctx)
 	if err != nil {
 		return err
 	}
 
 	return taskGenerator.GenerateWorkflowCloseTasks(
-		closeEventTime,
+		retentionStartTime,
 		false,
 	)
 }
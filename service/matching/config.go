@@ -56,16 +56,26 @@ type (
 
 		RangeSize                         int64
 		GetTasksBatchSize                 dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		PriorityWeightRatio               dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		FairnessKeyWeights                dynamicconfig.MapPropertyFnWithNamespaceFilter
 		UpdateAckInterval                 dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
 		MaxTaskQueueIdleTime              dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
 		NumTaskqueueWritePartitions       dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		NumTaskqueueReadPartitions        dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		PartitionScalingEnabled           dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		PartitionScalingInterval          dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		PartitionScalingMaxPartitions     dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		PartitionScalingAddRateThreshold  dynamicconfig.FloatPropertyFnWithTaskQueueInfoFilters
+		PartitionScalingPollRateThreshold dynamicconfig.FloatPropertyFnWithTaskQueueInfoFilters
+		PartitionScalingFwdThreshold      dynamicconfig.FloatPropertyFnWithTaskQueueInfoFilters
+		PartitionCountCacheTTL            dynamicconfig.DurationPropertyFn
 		ForwarderMaxOutstandingPolls      dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		ForwarderMaxOutstandingTasks      dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		ForwarderMaxRatePerSecond         dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		ForwarderMaxChildrenPerNode       dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		VersionCompatibleSetLimitPerQueue dynamicconfig.IntPropertyFnWithNamespaceFilter
 		VersionBuildIdLimitPerQueue       dynamicconfig.IntPropertyFnWithNamespaceFilter
+		PausedBuildIdLimitPerQueue        dynamicconfig.IntPropertyFnWithNamespaceFilter
 		TaskQueueLimitPerBuildId          dynamicconfig.IntPropertyFnWithNamespaceFilter
 		GetUserDataLongPollTimeout        dynamicconfig.DurationPropertyFn
 		BacklogNegligibleAge              dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
@@ -90,6 +100,7 @@ type (
 		EnableReadFromSecondaryVisibility dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		VisibilityDisableOrderByClause    dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		VisibilityEnableManualPagination  dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		VisibilityMaxCountGroups          dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 		LoadUserData dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
 
@@ -114,6 +125,8 @@ type (
 		LongPollExpirationInterval func() time.Duration
 		RangeSize                  int64
 		GetTasksBatchSize          func() int
+		PriorityWeightRatio        func() int
+		FairnessKeyWeight          func(fairnessKey string) int
 		UpdateAckInterval          func() time.Duration
 		MaxTaskQueueIdleTime       func() time.Duration
 		MinTaskThrottlingBurstSize func() int
@@ -128,6 +141,11 @@ type (
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
 
+		// If set, partition counts recorded in user data by partition scaling override NumWritePartitions and
+		// NumReadPartitions
+		PartitionScalingEnabled  func() bool
+		PartitionScalingInterval func() time.Duration
+
 		// partition qps = AdminNamespaceToPartitionDispatchRate(namespace)
 		AdminNamespaceToPartitionDispatchRate func() float64
 		// partition qps = AdminNamespaceTaskQueueToPartitionDispatchRate(namespace, task_queue)
@@ -178,6 +196,8 @@ func NewConfig(
 		OperatorRPSRatio:                      dc.GetFloat64Property(dynamicconfig.OperatorRPSRatio, common.DefaultOperatorRPSRatio),
 		RangeSize:                             100000,
 		GetTasksBatchSize:                     dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingGetTasksBatchSize, 1000),
+		PriorityWeightRatio:                   dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPriorityWeightRatio, 0),
+		FairnessKeyWeights:                    dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.MatchingFairnessKeyWeights, map[string]any{}),
 		UpdateAckInterval:                     dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingUpdateAckInterval, defaultUpdateAckInterval),
 		MaxTaskQueueIdleTime:                  dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTaskQueueIdleTime, 5*time.Minute),
 		LongPollExpirationInterval:            dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingLongPollExpirationInterval, time.Minute),
@@ -188,6 +208,13 @@ func NewConfig(
 		ThrottledLogRPS:                       dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS, 20),
 		NumTaskqueueWritePartitions:           dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueWritePartitions),
 		NumTaskqueueReadPartitions:            dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueReadPartitions),
+		PartitionScalingEnabled:               dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionScalingEnabled, false),
+		PartitionScalingInterval:              dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionScalingInterval, time.Minute),
+		PartitionScalingMaxPartitions:         dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionScalingMaxPartitions, 32),
+		PartitionScalingAddRateThreshold:      dc.GetFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionScalingAddRateThreshold, 500),
+		PartitionScalingPollRateThreshold:     dc.GetFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionScalingPollRateThreshold, 500),
+		PartitionScalingFwdThreshold:          dc.GetFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionScalingForwardContentionThreshold, 10),
+		PartitionCountCacheTTL:                dc.GetDurationProperty(dynamicconfig.MatchingPartitionCountCacheTTL, time.Minute),
 		ForwarderMaxOutstandingPolls:          dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxOutstandingPolls, 1),
 		ForwarderMaxOutstandingTasks:          dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxOutstandingTasks, 1),
 		ForwarderMaxRatePerSecond:             dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond, 10),
@@ -195,6 +222,7 @@ func NewConfig(
 		ShutdownDrainDuration:                 dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration, 0*time.Second),
 		VersionCompatibleSetLimitPerQueue:     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.VersionCompatibleSetLimitPerQueue, 10),
 		VersionBuildIdLimitPerQueue:           dc.GetIntPropertyFilteredByNamespace(dynamicconfig.VersionBuildIdLimitPerQueue, 100),
+		PausedBuildIdLimitPerQueue:            dc.GetIntPropertyFilteredByNamespace(dynamicconfig.PausedBuildIdLimitPerQueue, 20),
 		TaskQueueLimitPerBuildId:              dc.GetIntPropertyFilteredByNamespace(dynamicconfig.TaskQueuesPerBuildIdLimit, 20),
 		GetUserDataLongPollTimeout:            dc.GetDurationProperty(dynamicconfig.MatchingGetUserDataLongPollTimeout, 5*time.Minute-10*time.Second),
 		BacklogNegligibleAge:                  dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingBacklogNegligibleAge, 24*365*10*time.Hour),
@@ -208,6 +236,7 @@ func NewConfig(
 		EnableReadFromSecondaryVisibility: visibility.GetEnableReadFromSecondaryVisibilityConfig(dc, visibilityStoreConfigExist, enableReadFromES),
 		VisibilityDisableOrderByClause:    dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.VisibilityDisableOrderByClause, true),
 		VisibilityEnableManualPagination:  dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.VisibilityEnableManualPagination, true),
+		VisibilityMaxCountGroups:          dc.GetIntPropertyFilteredByNamespace(dynamicconfig.VisibilityMaxCountGroups, 1000),
 
 		FrontendAccessHistoryFraction: dc.GetFloat64Property(dynamicconfig.FrontendAccessHistoryFraction, 0.0),
 	}
@@ -222,6 +251,12 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespace namespace.Nam
 		GetTasksBatchSize: func() int {
 			return config.GetTasksBatchSize(namespace.String(), taskQueueName, taskType)
 		},
+		PriorityWeightRatio: func() int {
+			return config.PriorityWeightRatio(namespace.String(), taskQueueName, taskType)
+		},
+		FairnessKeyWeight: func(fairnessKey string) int {
+			return fairnessKeyWeight(config.FairnessKeyWeights(namespace.String()), fairnessKey)
+		},
 		UpdateAckInterval: func() time.Duration {
 			return config.UpdateAckInterval(namespace.String(), taskQueueName, taskType)
 		},
@@ -264,6 +299,12 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespace namespace.Nam
 		NumReadPartitions: func() int {
 			return max(1, config.NumTaskqueueReadPartitions(namespace.String(), taskQueueName, taskType))
 		},
+		PartitionScalingEnabled: func() bool {
+			return config.PartitionScalingEnabled(namespace.String(), taskQueueName, taskType)
+		},
+		PartitionScalingInterval: func() time.Duration {
+			return config.PartitionScalingInterval(namespace.String(), taskQueueName, taskType)
+		},
 		AdminNamespaceToPartitionDispatchRate: func() float64 {
 			return config.AdminNamespaceToPartitionDispatchRate(namespace.String())
 		},
@@ -287,3 +328,19 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespace namespace.Nam
 		GetUserDataRetryPolicy: backoff.NewExponentialRetryPolicy(1 * time.Second).WithMaximumInterval(5 * time.Minute),
 	}
 }
+
+// fairnessKeyWeight returns the positive weight configured for fairnessKey, or 1.
+func fairnessKeyWeight(weights map[string]any, fairnessKey string) int {
+	var weight int
+	switch value := weights[fairnessKey].(type) {
+	case float64:
+		weight = int(value)
+	case int:
+		weight = value
+	case int32:
+		weight = int(value)
+	case int64:
+		weight = int(value)
+	}
+	return max(weight, 1)
+}
@@ -41,6 +41,7 @@ var (
 		"AddWorkflowTask":                        1,
 		"CancelOutstandingPoll":                  1,
 		"DescribeTaskQueue":                      1,
+		"DescribeTaskQueueBacklog":               1,
 		"ListTaskQueuePartitions":                1,
 		"PollActivityTaskQueue":                  1,
 		"PollWorkflowTaskQueue":                  1,
@@ -48,6 +49,7 @@ var (
 		"RespondQueryTaskCompleted":              1,
 		"GetWorkerBuildIdCompatibility":          1,
 		"UpdateWorkerBuildIdCompatibility":       1,
+		"SetTaskQueuePaused":                     1,
 		"GetTaskQueueUserData":                   1,
 		"ApplyTaskQueueUserDataReplicationEvent": 1,
 		"GetBuildIdTaskQueueMapping":             1,
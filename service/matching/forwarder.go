@@ -154,6 +154,8 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			ScheduleToStartTimeout: expirationDuration,
 			ForwardedSource:        fwdr.taskQueueID.FullName(),
 			VersionDirective:       task.event.Data.GetVersionDirective(),
+			Priority:               task.event.Data.GetPriority(),
+			FairnessKey:            task.event.Data.GetFairnessKey(),
 		})
 	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
 		_, err = fwdr.client.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
@@ -169,6 +171,8 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			ScheduleToStartTimeout: expirationDuration,
 			ForwardedSource:        fwdr.taskQueueID.FullName(),
 			VersionDirective:       task.event.Data.GetVersionDirective(),
+			Priority:               task.event.Data.GetPriority(),
+			FairnessKey:            task.event.Data.GetFairnessKey(),
 		})
 	default:
 		return errInvalidTaskQueueType
@@ -29,6 +29,7 @@ import (
 	"sync"
 	"time"
 
+	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -154,7 +155,12 @@ func (h *Handler) AddActivityTask(
 		opMetrics.Timer(metrics.SyncMatchLatencyPerTaskQueue.Name()).Record(time.Since(startT))
 	}
 
-	return &matchingservice.AddActivityTaskResponse{}, err
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.AddActivityTaskResponse{
+		PartitionCount: h.engine.GetPartitionCount(namespace.ID(request.GetNamespaceId()), request.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.GetKnownPartitionCountVersion()),
+	}, nil
 }
 
 // AddWorkflowTask - adds a workflow task.
@@ -178,7 +184,12 @@ func (h *Handler) AddWorkflowTask(
 	if syncMatch {
 		opMetrics.Timer(metrics.SyncMatchLatencyPerTaskQueue.Name()).Record(time.Since(startT))
 	}
-	return &matchingservice.AddWorkflowTaskResponse{}, err
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.AddWorkflowTaskResponse{
+		PartitionCount: h.engine.GetPartitionCount(namespace.ID(request.GetNamespaceId()), request.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.GetKnownPartitionCountVersion()),
+	}, nil
 }
 
 // PollActivityTaskQueue - long poll for an activity task.
@@ -205,7 +216,19 @@ func (h *Handler) PollActivityTaskQueue(
 		return nil, err
 	}
 
-	return h.engine.PollActivityTaskQueue(ctx, request, opMetrics)
+	resp, err := h.engine.PollActivityTaskQueue(ctx, request, opMetrics)
+	if err != nil {
+		return nil, err
+	}
+	partitionCount := h.engine.GetPartitionCount(namespace.ID(request.GetNamespaceId()), request.GetPollRequest().GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.GetKnownPartitionCountVersion())
+	if partitionCount != nil {
+		if resp == emptyPollActivityTaskQueueResponse {
+			// Do not modify the shared empty response.
+			resp = &matchingservice.PollActivityTaskQueueResponse{}
+		}
+		resp.PartitionCount = partitionCount
+	}
+	return resp, nil
 }
 
 // PollWorkflowTaskQueue - long poll for a workflow task.
@@ -232,7 +255,19 @@ func (h *Handler) PollWorkflowTaskQueue(
 		return nil, err
 	}
 
-	return h.engine.PollWorkflowTaskQueue(ctx, request, opMetrics)
+	resp, err := h.engine.PollWorkflowTaskQueue(ctx, request, opMetrics)
+	if err != nil {
+		return nil, err
+	}
+	partitionCount := h.engine.GetPartitionCount(namespace.ID(request.GetNamespaceId()), request.GetPollRequest().GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.GetKnownPartitionCountVersion())
+	if partitionCount != nil {
+		if resp == emptyPollWorkflowTaskQueueResponse {
+			// Do not modify the shared empty response.
+			resp = &matchingservice.PollWorkflowTaskQueueResponse{}
+		}
+		resp.PartitionCount = partitionCount
+	}
+	return resp, nil
 }
 
 // QueryWorkflow queries a given workflow synchronously and return the query result.
@@ -289,6 +324,15 @@ func (h *Handler) DescribeTaskQueue(
 	return h.engine.DescribeTaskQueue(ctx, request)
 }
 
+// DescribeTaskQueueBacklog returns backlog statistics of a task queue
+func (h *Handler) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *matchingservice.DescribeTaskQueueBacklogRequest,
+) (_ *matchingservice.DescribeTaskQueueBacklogResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.DescribeTaskQueueBacklog(ctx, request)
+}
+
 // ListTaskQueuePartitions returns information about partitions for a taskQueue
 func (h *Handler) ListTaskQueuePartitions(
 	ctx context.Context,
@@ -307,6 +351,15 @@ func (h *Handler) UpdateWorkerBuildIdCompatibility(
 	return h.engine.UpdateWorkerBuildIdCompatibility(ctx, request)
 }
 
+// SetTaskQueuePaused pauses or resumes dispatch of tasks from a task queue
+func (h *Handler) SetTaskQueuePaused(
+	ctx context.Context,
+	request *matchingservice.SetTaskQueuePausedRequest,
+) (_ *matchingservice.SetTaskQueuePausedResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.SetTaskQueuePaused(ctx, request)
+}
+
 // GetWorkerBuildIdCompatibility fetches the worker versioning data for a task queue
 func (h *Handler) GetWorkerBuildIdCompatibility(
 	ctx context.Context,
@@ -35,6 +35,7 @@ import (
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
@@ -69,6 +70,7 @@ type TaskMatcher struct {
 	backlogTasksCreateTime map[int64]int   // task creation time (unix nanos) -> number of tasks with that time
 	backlogTasksLock       sync.Mutex
 	lastPoller             atomic.Int64 // unix nanos of most recent poll start time
+	forwardContention      *rateTracker // offers that could not forward for lack of a forwarder token
 }
 
 const (
@@ -84,7 +86,7 @@ var (
 
 // newTaskMatcher returns a task matcher instance. The returned instance can be used by task producers and consumers to
 // find a match. Both sync matches and non-sync matches should use this implementation
-func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, metricsHandler metrics.Handler) *TaskMatcher {
+func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, metricsHandler metrics.Handler, timeSource clock.TimeSource) *TaskMatcher {
 	dynamicRateBurst := quotas.NewMutableRateBurst(
 		defaultTaskDispatchRPS,
 		int(defaultTaskDispatchRPS),
@@ -113,6 +115,7 @@ func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, metricsHandler met
 		queryTaskC:             make(chan *internalTask),
 		numPartitions:          config.NumReadPartitions,
 		backlogTasksCreateTime: make(map[int64]int),
+		forwardContention:      newRateTracker(timeSource),
 	}
 }
 
@@ -188,6 +191,10 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, err
 			}
 			token.release()
 		default:
+			if tm.isForwardingAllowed() {
+				// all forwarder add tokens are in use
+				tm.forwardContention.record(1)
+			}
 			if !tm.isForwardingAllowed() && // we are the root partition and forwarding is not possible
 				task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && // task was from backlog (stored in db)
 				task.isForwarded() { // task came from a child partition
@@ -35,6 +35,7 @@ import (
 	"time"
 
 	"github.com/pborman/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -49,6 +50,7 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
@@ -374,6 +376,8 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 		ExpiryTime:       expirationTime,
 		CreateTime:       timestamppb.New(now),
 		VersionDirective: addRequest.VersionDirective,
+		Priority:         addRequest.GetPriority(),
+		FairnessKey:      addRequest.GetFairnessKey(),
 	}
 
 	return tqm.AddTask(ctx, addTaskParams{
@@ -426,6 +430,8 @@ func (e *matchingEngineImpl) AddActivityTask(
 		CreateTime:       timestamppb.New(now),
 		ExpiryTime:       expirationTime,
 		VersionDirective: addRequest.VersionDirective,
+		Priority:         addRequest.GetPriority(),
+		FairnessKey:      addRequest.GetFairnessKey(),
 	}
 
 	return tqm.AddTask(ctx, addTaskParams{
@@ -851,14 +857,14 @@ func (e *matchingEngineImpl) DescribeTaskQueue(
 }
 
 func (e *matchingEngineImpl) ListTaskQueuePartitions(
-	_ context.Context,
+	ctx context.Context,
 	request *matchingservice.ListTaskQueuePartitionsRequest,
 ) (*matchingservice.ListTaskQueuePartitionsResponse, error) {
-	activityTaskQueueInfo, err := e.listTaskQueuePartitions(request, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	activityTaskQueueInfo, err := e.listTaskQueuePartitions(ctx, request, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
 	if err != nil {
 		return nil, err
 	}
-	workflowTaskQueueInfo, err := e.listTaskQueuePartitions(request, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	workflowTaskQueueInfo, err := e.listTaskQueuePartitions(ctx, request, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
 	if err != nil {
 		return nil, err
 	}
@@ -869,8 +875,9 @@ func (e *matchingEngineImpl) ListTaskQueuePartitions(
 	return &resp, nil
 }
 
-func (e *matchingEngineImpl) listTaskQueuePartitions(request *matchingservice.ListTaskQueuePartitionsRequest, taskQueueType enumspb.TaskQueueType) ([]*taskqueuepb.TaskQueuePartitionMetadata, error) {
+func (e *matchingEngineImpl) listTaskQueuePartitions(ctx context.Context, request *matchingservice.ListTaskQueuePartitionsRequest, taskQueueType enumspb.TaskQueueType) ([]*taskqueuepb.TaskQueuePartitionMetadata, error) {
 	partitions, err := e.getAllPartitions(
+		ctx,
 		namespace.Name(request.GetNamespace()),
 		request.TaskQueue,
 		taskQueueType,
@@ -896,6 +903,201 @@ func (e *matchingEngineImpl) listTaskQueuePartitions(request *matchingservice.Li
 	return partitionHostInfo, nil
 }
 
+// DescribeTaskQueueBacklog returns backlog statistics of a task queue partition. On the root
+// partition, unless PartitionOnly is set, it also describes the other partitions and combines
+// their statistics. Partitions that fail to respond are listed in FailedPartitions.
+func (e *matchingEngineImpl) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *matchingservice.DescribeTaskQueueBacklogRequest,
+) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	taskQueue, err := newTaskQueueID(namespaceID, request.GetTaskQueue(), request.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := e.describePartitionBacklog(ctx, taskQueue, request.GetBuildIds())
+	if err != nil || request.GetPartitionOnly() || !taskQueue.IsRoot() {
+		return resp, err
+	}
+
+	ns, err := e.namespaceRegistry.GetNamespaceName(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	userData, err := e.rootUserData(ctx, taskQueue)
+	if err != nil {
+		return nil, err
+	}
+	// Read partitions can outnumber write partitions while they drain, so describe both.
+	numPartitions := max(e.partitionCounts(ns, taskQueue, userData))
+	partitionResps := make([]*matchingservice.DescribeTaskQueueBacklogResponse, numPartitions)
+	partitionErrs := make([]error, numPartitions)
+	var wg sync.WaitGroup
+	for i := 1; i < numPartitions; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			partitionResps[i], partitionErrs[i] = e.matchingRawClient.DescribeTaskQueueBacklog(ctx, &matchingservice.DescribeTaskQueueBacklogRequest{
+				NamespaceId:   request.GetNamespaceId(),
+				TaskQueue:     taskQueue.WithPartition(i).FullName(),
+				TaskQueueType: request.GetTaskQueueType(),
+				BuildIds:      request.GetBuildIds(),
+				PartitionOnly: true,
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 1; i < numPartitions; i++ {
+		partition := taskQueue.WithPartition(i).FullName()
+		if partitionErrs[i] != nil {
+			e.logger.Warn("Failed to describe task queue partition backlog",
+				tag.WorkflowTaskQueueName(partition), tag.Error(partitionErrs[i]))
+			resp.FailedPartitions = append(resp.FailedPartitions, partition)
+			continue
+		}
+		mergeBacklogStats(resp.Stats, partitionResps[i].GetStats())
+		for buildID, stats := range partitionResps[i].GetStatsByBuildId() {
+			mergeBacklogStats(resp.StatsByBuildId[buildID], stats)
+		}
+	}
+	return resp, nil
+}
+
+// describePartitionBacklog combines the backlog statistics of the unversioned queue of one
+// partition with those of its version set queues, and breaks them down by the given build IDs.
+// Queues that are not loaded are not loaded by this call; their backlog is read from persistence.
+func (e *matchingEngineImpl) describePartitionBacklog(
+	ctx context.Context,
+	taskQueue *taskQueueID,
+	buildIDs []string,
+) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, false)
+	if err != nil {
+		return nil, err
+	}
+	var userData *persistencespb.TaskQueueUserData
+	if tqMgr != nil {
+		versionedData, _, err := tqMgr.GetUserData()
+		if err != nil {
+			return nil, err
+		}
+		userData = versionedData.GetData()
+	} else if userData, err = e.persistedUserData(ctx, taskQueue); err != nil {
+		return nil, err
+	}
+	stats, err := e.queueBacklogStats(ctx, taskQueue)
+	if err != nil {
+		return nil, err
+	}
+	resp := &matchingservice.DescribeTaskQueueBacklogResponse{Stats: stats}
+
+	versioningData := userData.GetVersioningData()
+	setStats := make(map[string]*taskqueuespb.BacklogStats)
+	for _, set := range versioningData.GetVersionSets() {
+		for _, setID := range set.GetSetIds() {
+			if setStats[setID], err = e.queueBacklogStats(ctx, newTaskQueueIDWithVersionSet(taskQueue, setID)); err != nil {
+				return nil, err
+			}
+			mergeBacklogStats(resp.Stats, setStats[setID])
+		}
+	}
+
+	if len(buildIDs) == 0 {
+		return resp, nil
+	}
+	resp.StatsByBuildId = make(map[string]*taskqueuespb.BacklogStats, len(buildIDs))
+	for _, buildID := range buildIDs {
+		stats := &taskqueuespb.BacklogStats{}
+		// Unknown build IDs have no queue and so no backlog.
+		if setIdx, _ := worker_versioning.FindBuildId(versioningData, buildID); setIdx >= 0 {
+			for _, setID := range versioningData.VersionSets[setIdx].GetSetIds() {
+				mergeBacklogStats(stats, setStats[setID])
+			}
+		}
+		resp.StatsByBuildId[buildID] = stats
+	}
+	return resp, nil
+}
+
+// queueBacklogStats returns the backlog statistics of a loaded queue, or reads them from
+// persistence if the queue is not loaded.
+func (e *matchingEngineImpl) queueBacklogStats(
+	ctx context.Context,
+	queue *taskQueueID,
+) (*taskqueuespb.BacklogStats, error) {
+	tqMgr, err := e.getTaskQueueManager(ctx, queue, normalStickyInfo, false)
+	if err != nil {
+		return nil, err
+	}
+	if tqMgr != nil {
+		return tqMgr.BacklogStats(), nil
+	}
+	return e.persistedBacklogStats(ctx, queue)
+}
+
+// persistedBacklogStats reads the backlog of a queue that is not loaded. The count covers at most
+// one page of GetTasksBatchSize tasks past the ack level, and there are no rates since nothing is
+// dispatched from the queue. A queue that was never persisted has an empty backlog.
+func (e *matchingEngineImpl) persistedBacklogStats(
+	ctx context.Context,
+	queue *taskQueueID,
+) (*taskqueuespb.BacklogStats, error) {
+	stats := &taskqueuespb.BacklogStats{}
+	tqResp, err := e.taskManager.GetTaskQueue(ctx, &persistence.GetTaskQueueRequest{
+		NamespaceID: queue.namespaceID.String(),
+		TaskQueue:   queue.FullName(),
+		TaskType:    queue.taskType,
+	})
+	if common.IsNotFoundError(err) {
+		return stats, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	ns, err := e.namespaceRegistry.GetNamespaceName(queue.namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	tasksResp, err := e.taskManager.GetTasks(ctx, &persistence.GetTasksRequest{
+		NamespaceID:        queue.namespaceID.String(),
+		TaskQueue:          queue.FullName(),
+		TaskType:           queue.taskType,
+		InclusiveMinTaskID: tqResp.TaskQueueInfo.GetAckLevel() + 1,
+		ExclusiveMaxTaskID: math.MaxInt64,
+		PageSize:           e.config.GetTasksBatchSize(ns.String(), queue.BaseNameString(), queue.taskType),
+	})
+	if err != nil {
+		return nil, err
+	}
+	stats.ApproximateBacklogCount = int64(len(tasksResp.Tasks))
+	if len(tasksResp.Tasks) > 0 {
+		// Tasks are returned in task ID order, which is the order they were created in.
+		if createTime := tasksResp.Tasks[0].GetData().GetCreateTime(); createTime != nil {
+			stats.OldestTaskAge = durationpb.New(time.Since(timestamp.TimeValue(createTime)))
+		}
+	}
+	return stats, nil
+}
+
+// persistedUserData reads the user data of the task queue from persistence, where it is stored on
+// the root workflow partition, without loading any partition.
+func (e *matchingEngineImpl) persistedUserData(
+	ctx context.Context,
+	taskQueue *taskQueueID,
+) (*persistencespb.TaskQueueUserData, error) {
+	resp, err := e.taskManager.GetTaskQueueUserData(ctx, &persistence.GetTaskQueueUserDataRequest{
+		NamespaceID: taskQueue.namespaceID.String(),
+		TaskQueue:   taskQueue.Root().FullName(),
+	})
+	if common.IsNotFoundError(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return resp.UserData.GetData(), nil
+}
+
 func (e *matchingEngineImpl) UpdateWorkerBuildIdCompatibility(
 	ctx context.Context,
 	req *matchingservice.UpdateWorkerBuildIdCompatibilityRequest,
@@ -993,6 +1195,55 @@ func (e *matchingEngineImpl) UpdateWorkerBuildIdCompatibility(
 	return &matchingservice.UpdateWorkerBuildIdCompatibilityResponse{}, nil
 }
 
+func (e *matchingEngineImpl) SetTaskQueuePaused(
+	ctx context.Context,
+	req *matchingservice.SetTaskQueuePausedRequest,
+) (*matchingservice.SetTaskQueuePausedResponse, error) {
+	namespaceID := namespace.ID(req.GetNamespaceId())
+	ns, err := e.namespaceRegistry.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	taskQueue, err := newTaskQueueID(namespaceID, req.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, true)
+	if err != nil {
+		return nil, err
+	}
+	var pauseState *persistencespb.TaskQueuePauseState
+	err = tqMgr.UpdateUserData(ctx, UserDataUpdateOptions{}, func(data *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+		clk := data.GetClock()
+		if clk == nil {
+			clk = hlc.Zero(e.clusterMeta.GetClusterID())
+		}
+		updatedClock := hlc.Next(clk, e.timeSource)
+		var err error
+		pauseState, err = UpdatePauseState(
+			updatedClock,
+			data.GetPauseState(),
+			req.GetPaused(),
+			req.GetBuildId(),
+			req.GetReason(),
+			req.GetIdentity(),
+			e.config.PausedBuildIdLimitPerQueue(ns.Name().String()),
+		)
+		if err != nil {
+			return nil, false, err
+		}
+		// Avoid mutation
+		ret := common.CloneProto(data)
+		ret.Clock = updatedClock
+		ret.PauseState = pauseState
+		return ret, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.SetTaskQueuePausedResponse{PauseState: pauseState}, nil
+}
+
 func (e *matchingEngineImpl) GetWorkerBuildIdCompatibility(
 	ctx context.Context,
 	req *matchingservice.GetWorkerBuildIdCompatibilityRequest,
@@ -1136,6 +1387,8 @@ func (e *matchingEngineImpl) ApplyTaskQueueUserDataReplicationEvent(
 
 		// No need to keep the tombstones around after replication.
 		mergedUserData.VersioningData = ClearTombstones(mergedData)
+		mergedUserData.PauseState, mergedUserData.Clock = MergePauseState(
+			mergedUserData.Clock, current.GetPauseState(), req.GetUserData().GetPauseState())
 		return mergedUserData, len(buildIdsToRevive) > 0, nil
 	})
 	return &matchingservice.ApplyTaskQueueUserDataReplicationEventResponse{}, err
@@ -1233,6 +1486,7 @@ func (e *matchingEngineImpl) getHostInfo(partitionKey string) (string, error) {
 }
 
 func (e *matchingEngineImpl) getAllPartitions(
+	ctx context.Context,
 	ns namespace.Name,
 	taskQueue *taskqueuepb.TaskQueue,
 	taskQueueType enumspb.TaskQueueType,
@@ -1247,7 +1501,13 @@ func (e *matchingEngineImpl) getAllPartitions(
 		return partitionKeys, err
 	}
 
-	n := e.config.NumTaskqueueWritePartitions(ns.String(), taskQueueID.BaseNameString(), taskQueueType)
+	userData, err := e.rootUserData(ctx, taskQueueID)
+	if err != nil {
+		return partitionKeys, err
+	}
+	typeID := newTaskQueueIDWithVersionSet(taskQueueID, "")
+	typeID.taskType = taskQueueType
+	_, n := e.partitionCounts(ns, typeID, userData)
 	for i := 0; i < n; i++ {
 		partitionKeys = append(partitionKeys, taskQueueID.WithPartition(i).FullName())
 	}
@@ -1255,6 +1515,71 @@ func (e *matchingEngineImpl) getAllPartitions(
 	return partitionKeys, nil
 }
 
+// rootUserData returns the user data owned by the root workflow partition of the task queue.
+func (e *matchingEngineImpl) rootUserData(ctx context.Context, taskQueue *taskQueueID) (*persistencespb.TaskQueueUserData, error) {
+	rootID := &taskQueueID{
+		Name:        taskQueue.Root(),
+		namespaceID: taskQueue.namespaceID,
+		taskType:    enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	}
+	rootMgr, err := e.getTaskQueueManager(ctx, rootID, normalStickyInfo, true)
+	if err != nil {
+		return nil, err
+	}
+	userData, _, err := rootMgr.GetUserData()
+	if err != nil {
+		return nil, err
+	}
+	return userData.GetData(), nil
+}
+
+// partitionCounts returns the numbers of read and write partitions of the task queue. Counts recorded in user data by
+// partition scaling take precedence over dynamic config.
+func (e *matchingEngineImpl) partitionCounts(
+	ns namespace.Name,
+	taskQueue *taskQueueID,
+	userData *persistencespb.TaskQueueUserData,
+) (int, int) {
+	nsName, taskQueueName := ns.String(), taskQueue.BaseNameString()
+	if e.config.PartitionScalingEnabled(nsName, taskQueueName, taskQueue.taskType) {
+		if count := userData.GetPartitionCounts()[int32(taskQueue.taskType)]; count != nil {
+			return max(1, int(count.GetReadPartitions())), max(1, int(count.GetWritePartitions()))
+		}
+	}
+	return max(1, e.config.NumTaskqueueReadPartitions(nsName, taskQueueName, taskQueue.taskType)),
+		max(1, e.config.NumTaskqueueWritePartitions(nsName, taskQueueName, taskQueue.taskType))
+}
+
+// GetPartitionCount returns the partition count recorded by partition scaling for a loaded task queue partition when
+// its version differs from knownVersion, and nil otherwise. Callers that know a count get an empty count once
+// partitions are no longer scaled.
+func (e *matchingEngineImpl) GetPartitionCount(
+	namespaceID namespace.ID,
+	taskQueue *taskqueuepb.TaskQueue,
+	taskQueueType enumspb.TaskQueueType,
+	knownVersion int64,
+) *persistencespb.TaskQueuePartitionCount {
+	if taskQueue.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		return nil
+	}
+	id, err := newTaskQueueID(namespaceID, taskQueue.GetName(), taskQueueType)
+	if err != nil {
+		return nil
+	}
+	tqMgr, err := e.getTaskQueueManagerNoWait(id, normalStickyInfo, false)
+	if err != nil || tqMgr == nil {
+		return nil
+	}
+	count := tqMgr.PartitionCount()
+	if count.GetVersion() == knownVersion {
+		return nil
+	}
+	if count == nil {
+		return &persistencespb.TaskQueuePartitionCount{}
+	}
+	return count
+}
+
 func (e *matchingEngineImpl) pollTask(
 	ctx context.Context,
 	origTaskQueue *taskQueueID,
@@ -1561,3 +1886,18 @@ func (e *matchingEngineImpl) reviveBuildId(ns *namespace.Namespace, taskQueue st
 func stickyWorkerAvailable(tqm taskQueueManager) bool {
 	return tqm != nil && tqm.HasPollerAfter(time.Now().Add(-stickyPollerUnavailableWindow))
 }
+
+// mergeBacklogStats adds the counts and rates of src to dst and keeps the oldest task age.
+func mergeBacklogStats(dst *taskqueuespb.BacklogStats, src *taskqueuespb.BacklogStats) {
+	if dst == nil || src == nil {
+		return
+	}
+	dst.ApproximateBacklogCount += src.GetApproximateBacklogCount()
+	dst.TasksAddRate += src.GetTasksAddRate()
+	dst.TasksDispatchRate += src.GetTasksDispatchRate()
+	dst.PollsRate += src.GetPollsRate()
+	dst.ForwardContentionRate += src.GetForwardContentionRate()
+	if src.GetOldestTaskAge().AsDuration() > dst.GetOldestTaskAge().AsDuration() {
+		dst.OldestTaskAge = src.GetOldestTaskAge()
+	}
+}
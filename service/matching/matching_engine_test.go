@@ -40,6 +40,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally/v4"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -1858,8 +1859,8 @@ func (s *matchingEngineSuite) TestTaskQueueManagerGetTaskBatch() {
 
 	// wait until all tasks are read by the task pump and enqueued into the in-memory buffer
 	// at the end of this step, ackManager readLevel will also be equal to the buffer size
-	expectedBufSize := min(cap(tlMgr.taskReader.taskBuffer), taskCount)
-	s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffer) == expectedBufSize }, time.Second))
+	expectedBufSize := min(tlMgr.taskReader.taskBuffer.capacity, taskCount)
+	s.True(s.awaitCondition(func() bool { return tlMgr.taskReader.taskBuffer.len() == expectedBufSize }, time.Second))
 
 	// stop all goroutines that read / write tasks in the background
 	// remainder of this test works with the in-memory buffer
@@ -2028,7 +2029,7 @@ func (s *matchingEngineSuite) TestTaskExpiryAndCompletion() {
 		// wait until all tasks are loaded by into in-memory buffers by task queue manager
 		// the buffer size should be one less than expected because dispatcher will dequeue the head
 		// 1/4 should be thrown out because they are expired before they hit the buffer
-		s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffer) >= (3*taskCount/4 - 1) }, time.Second))
+		s.True(s.awaitCondition(func() bool { return tlMgr.taskReader.taskBuffer.len() >= (3*taskCount/4 - 1) }, time.Second))
 
 		// ensure the 1/4 of tasks with small ScheduleToStartTimeout will be expired when they come out of the buffer
 		time.Sleep(300 * time.Millisecond)
@@ -3165,3 +3166,310 @@ func (d *dynamicRateBurstWrapper) Rate() float64 {
 func (d *dynamicRateBurstWrapper) Burst() int {
 	return d.RateLimiterImpl.Burst()
 }
+
+func (s *matchingEngineSuite) TestDescribeTaskQueueBacklog() {
+	s.matchingEngine.config.NumTaskqueueReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(3)
+	s.matchingEngine.config.NumTaskqueueWritePartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(2)
+
+	namespaceID := namespace.ID(uuid.New())
+	tl := "makeToast"
+	taskQueue := &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	const taskCount = 6
+	for i := int64(0); i < taskCount; i++ {
+		_, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            namespaceID.String(),
+			Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "wf"},
+			ScheduledEventId:       i,
+			TaskQueue:              taskQueue,
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		})
+		s.NoError(err)
+	}
+
+	partition1 := newTestTaskQueueID(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_ACTIVITY).WithPartition(1).FullName()
+	partition2 := newTestTaskQueueID(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_ACTIVITY).WithPartition(2).FullName()
+	s.mockMatchingClient.EXPECT().DescribeTaskQueueBacklog(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.DescribeTaskQueueBacklogRequest, _ ...grpc.CallOption) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+			s.True(request.PartitionOnly)
+			s.Equal([]string{"unknown"}, request.BuildIds)
+			switch request.TaskQueue {
+			case partition1:
+				return &matchingservice.DescribeTaskQueueBacklogResponse{
+					Stats: &taskqueue.BacklogStats{
+						ApproximateBacklogCount: 100,
+						OldestTaskAge:           durationpb.New(time.Hour),
+						TasksAddRate:            2,
+						TasksDispatchRate:       1,
+					},
+					StatsByBuildId: map[string]*taskqueue.BacklogStats{"unknown": {}},
+				}, nil
+			case partition2:
+				return nil, serviceerror.NewUnavailable("partition owner is down")
+			}
+			s.Fail("unexpected partition", request.TaskQueue)
+			return nil, nil
+		}).Times(2)
+
+	resp, err := s.matchingEngine.DescribeTaskQueueBacklog(context.Background(), &matchingservice.DescribeTaskQueueBacklogRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     tl,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		BuildIds:      []string{"unknown"},
+	})
+	s.NoError(err)
+	s.Equal([]string{partition2}, resp.FailedPartitions)
+	s.GreaterOrEqual(resp.Stats.ApproximateBacklogCount, int64(100))
+	s.Equal(time.Hour, resp.Stats.OldestTaskAge.AsDuration())
+	s.InDelta(2+float64(taskCount)/rateTrackerWindow.Seconds(), resp.Stats.TasksAddRate, 0.001)
+	s.InDelta(1, resp.Stats.TasksDispatchRate, 0.001)
+	s.Zero(resp.StatsByBuildId["unknown"].GetApproximateBacklogCount())
+
+	// A single partition does not fan out.
+	resp, err = s.matchingEngine.DescribeTaskQueueBacklog(context.Background(), &matchingservice.DescribeTaskQueueBacklogRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     tl,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		PartitionOnly: true,
+	})
+	s.NoError(err)
+	s.Empty(resp.FailedPartitions)
+	s.InDelta(float64(taskCount)/rateTrackerWindow.Seconds(), resp.Stats.TasksAddRate, 0.001)
+}
+
+func (s *matchingEngineSuite) TestDescribeTaskQueueBacklog_NotLoaded() {
+	s.matchingEngine.config.NumTaskqueueReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1)
+	s.matchingEngine.config.NumTaskqueueWritePartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1)
+
+	namespaceID := namespace.ID(uuid.New())
+	tl := "makeToast"
+	tlID := newTestTaskQueueID(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	describe := func() *matchingservice.DescribeTaskQueueBacklogResponse {
+		resp, err := s.matchingEngine.DescribeTaskQueueBacklog(context.Background(), &matchingservice.DescribeTaskQueueBacklogRequest{
+			NamespaceId:   namespaceID.String(),
+			TaskQueue:     tl,
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+			PartitionOnly: true,
+		})
+		s.NoError(err)
+		return resp
+	}
+	isLoaded := func() bool {
+		tqMgr, err := s.matchingEngine.getTaskQueueManager(context.Background(), tlID, normalStickyInfo, false)
+		s.NoError(err)
+		return tqMgr != nil
+	}
+
+	// A queue that was never persisted is reported empty and is not loaded.
+	resp := describe()
+	s.Zero(resp.Stats.ApproximateBacklogCount)
+	s.Nil(resp.Stats.OldestTaskAge)
+	s.False(isLoaded())
+
+	const taskCount = 3
+	for i := int64(0); i < taskCount; i++ {
+		_, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            namespaceID.String(),
+			Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "wf"},
+			ScheduledEventId:       i,
+			TaskQueue:              &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		})
+		s.NoError(err)
+	}
+	tqMgr, err := s.matchingEngine.getTaskQueueManager(context.Background(), tlID, normalStickyInfo, false)
+	s.NoError(err)
+	s.matchingEngine.unloadTaskQueue(tqMgr)
+
+	// The backlog of an unloaded queue is read from persistence without loading it.
+	resp = describe()
+	s.EqualValues(taskCount, resp.Stats.ApproximateBacklogCount)
+	s.Positive(resp.Stats.OldestTaskAge.AsDuration())
+	s.False(isLoaded())
+}
+
+func (s *matchingEngineSuite) TestSetTaskQueuePaused() {
+	s.matchingEngine.config.NumTaskqueueReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1)
+	s.matchingEngine.config.NumTaskqueueWritePartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1)
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(10 * time.Second)
+
+	namespaceID := namespace.ID(uuid.New())
+	tl := "makeToast"
+	tlID := newTestTaskQueueID(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	poll := func(buildId string) <-chan error {
+		errC := make(chan error, 1)
+		go func() {
+			task, err := s.matchingEngine.pollTask(context.Background(), tlID, normalStickyInfo, &pollMetadata{
+				workerVersionCapabilities: &commonpb.WorkerVersionCapabilities{BuildId: buildId},
+			})
+			if err == nil {
+				task.finish(nil)
+			}
+			errC <- err
+		}()
+		return errC
+	}
+	waitPoll := func(errC <-chan error) error {
+		select {
+		case err := <-errC:
+			return err
+		case <-time.After(5 * time.Second):
+			s.FailNow("poll did not return")
+			return nil
+		}
+	}
+	waitPolling := func() {
+		s.Eventually(func() bool {
+			return len(s.matchingEngine.getTaskQueues(10)) > 0 &&
+				s.matchingEngine.getTaskQueues(10)[0].(*taskQueueManagerImpl).currentPolls.Load() > 0
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+	setPaused := func(paused bool, buildId string) *persistencespb.TaskQueuePauseState {
+		resp, err := s.matchingEngine.SetTaskQueuePaused(context.Background(), &matchingservice.SetTaskQueuePausedRequest{
+			NamespaceId: namespaceID.String(),
+			TaskQueue:   tl,
+			Paused:      paused,
+			BuildId:     buildId,
+			Reason:      "bad deploy",
+			Identity:    "operator",
+		})
+		s.NoError(err)
+		return resp.PauseState
+	}
+	addTask := func() bool {
+		syncMatch, err := s.matchingEngine.AddWorkflowTask(context.Background(), &matchingservice.AddWorkflowTaskRequest{
+			NamespaceId:            namespaceID.String(),
+			Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "wf"},
+			ScheduledEventId:       1,
+			TaskQueue:              &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		})
+		s.NoError(err)
+		return syncMatch
+	}
+
+	pauseState := setPaused(true, "")
+	s.True(pauseState.Paused)
+	s.Equal("bad deploy", pauseState.Reason)
+
+	// Polls return empty right away while paused.
+	start := time.Now()
+	s.ErrorIs(waitPoll(poll("")), errNoTasks)
+	s.Less(time.Since(start), time.Second)
+
+	// Tasks are spooled to the backlog while paused.
+	s.False(addTask())
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+
+	// Resuming drains the backlog.
+	s.False(setPaused(false, "").Paused)
+	s.NoError(waitPoll(poll("")))
+	s.Eventually(func() bool {
+		return s.taskManager.getTaskCount(tlID) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// A poll that was already waiting when its build ID got paused hands a sync matched task back, so the task is
+	// spooled and the poll returns empty.
+	errC := poll("v1")
+	waitPolling()
+	s.Equal([]string{"v1"}, setPaused(true, "v1").PausedBuildIds)
+	s.False(addTask())
+	s.ErrorIs(waitPoll(errC), errNoTasks)
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+}
+
+func (s *matchingEngineSuite) TestScalePartitions() {
+	s.matchingEngine.config.NumTaskqueueReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1)
+	s.matchingEngine.config.NumTaskqueueWritePartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1)
+	s.matchingEngine.config.PartitionScalingEnabled = dynamicconfig.GetBoolPropertyFnFilteredByTaskQueueInfo(true)
+	s.matchingEngine.config.PartitionCountCacheTTL = dynamicconfig.GetDurationPropertyFn(time.Minute)
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	s.matchingEngine.timeSource = timeSource
+
+	namespaceID := namespace.ID(uuid.New())
+	tl := "makeToast"
+	taskQueue := &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	rootMgr, err := s.matchingEngine.getTaskQueueManager(context.Background(),
+		newTestTaskQueueID(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_WORKFLOW), normalStickyInfo, true)
+	s.NoError(err)
+
+	var stats *taskqueue.BacklogStats
+	var partitionBacklog int64
+	s.mockMatchingClient.EXPECT().DescribeTaskQueueBacklog(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.DescribeTaskQueueBacklogRequest, _ ...grpc.CallOption) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+			s.Equal(enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.TaskQueueType)
+			if request.PartitionOnly {
+				return &matchingservice.DescribeTaskQueueBacklogResponse{
+					Stats: &taskqueue.BacklogStats{ApproximateBacklogCount: partitionBacklog},
+				}, nil
+			}
+			return &matchingservice.DescribeTaskQueueBacklogResponse{Stats: stats}, nil
+		}).AnyTimes()
+	scale := func() {
+		s.NoError(rootMgr.(*taskQueueManagerImpl).scalePartitionsOfType(context.Background(), enumspb.TASK_QUEUE_TYPE_WORKFLOW))
+	}
+
+	getCount := func() *persistencespb.TaskQueuePartitionCount {
+		return s.matchingEngine.GetPartitionCount(namespaceID, taskQueue, enumspb.TASK_QUEUE_TYPE_WORKFLOW, 0)
+	}
+
+	// Nothing is recorded while the load fits the configured partitions.
+	stats = &taskqueue.BacklogStats{TasksAddRate: 1}
+	scale()
+	s.Nil(getCount())
+
+	// Overload grows read and write partitions together.
+	stats = &taskqueue.BacklogStats{TasksAddRate: 1000}
+	scale()
+	count := getCount()
+	s.EqualValues(2, count.GetReadPartitions())
+	s.EqualValues(2, count.GetWritePartitions())
+	s.EqualValues(1, count.GetVersion())
+	s.Equal(2, rootMgr.(*taskQueueManagerImpl).config.NumReadPartitions())
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(namespace.Name(matchingTestNamespace)).Return(namespaceID, nil)
+	partitions, err := s.matchingEngine.getAllPartitions(context.Background(), matchingTestNamespace, taskQueue, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	s.NoError(err)
+	s.Len(partitions, 2)
+	// Callers that know the current version do not get the count again.
+	s.Nil(s.matchingEngine.GetPartitionCount(namespaceID, taskQueue, enumspb.TASK_QUEUE_TYPE_WORKFLOW, 1))
+
+	// Underload shrinks write partitions first.
+	stats = &taskqueue.BacklogStats{}
+	partitionBacklog = 5
+	scale()
+	count = getCount()
+	s.EqualValues(2, count.GetReadPartitions())
+	s.EqualValues(1, count.GetWritePartitions())
+	s.EqualValues(2, count.GetVersion())
+	scale()
+	s.EqualValues(2, getCount().GetReadPartitions())
+	s.Nil(getCount().GetDrainedTimestamp())
+
+	// Read partitions are only removed once the removed write partitions stayed empty for twice the client cache TTL.
+	partitionBacklog = 0
+	scale()
+	count = getCount()
+	s.EqualValues(2, count.GetReadPartitions())
+	s.EqualValues(2, count.GetVersion())
+	s.NotNil(count.GetDrainedTimestamp())
+	timeSource.Advance(time.Minute)
+	scale()
+	s.EqualValues(2, getCount().GetReadPartitions())
+	// A client with a stale count wrote a task, so draining starts over.
+	partitionBacklog = 1
+	scale()
+	s.Nil(getCount().GetDrainedTimestamp())
+	partitionBacklog = 0
+	scale()
+	timeSource.Advance(2 * time.Minute)
+	scale()
+	count = getCount()
+	s.EqualValues(1, count.GetReadPartitions())
+	s.EqualValues(1, count.GetWritePartitions())
+	s.EqualValues(3, count.GetVersion())
+	s.Nil(count.GetDrainedTimestamp())
+
+	// Disabling scaling falls back to dynamic config, callers that know a count are told to drop it.
+	s.matchingEngine.config.PartitionScalingEnabled = dynamicconfig.GetBoolPropertyFnFilteredByTaskQueueInfo(false)
+	s.Nil(getCount())
+	s.Equal(&persistencespb.TaskQueuePartitionCount{}, s.matchingEngine.GetPartitionCount(namespaceID, taskQueue, enumspb.TASK_QUEUE_TYPE_WORKFLOW, 3))
+}
@@ -0,0 +1,93 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+)
+
+type (
+	// partitionScalingLimits are the thresholds partition scaling compares the load of a task queue with.
+	// Thresholds that are not positive are ignored.
+	partitionScalingLimits struct {
+		maxPartitions     int
+		addRateThreshold  float64 // tasks added per second per write partition
+		pollRateThreshold float64 // polls per second per read partition
+		fwdThreshold      float64 // tasks per second that found no forwarder token
+	}
+)
+
+// nextPartitionCount decides the partition count of a task queue type given its current count and the combined
+// statistics of all of its partitions. Partitions are doubled when the load exceeds any threshold, and halved when the
+// load is below a quarter of every threshold, so that the load after halving stays below half of them.
+// Read and write partitions grow together. When shrinking, only write partitions are removed at first, read
+// partitions follow once drained reports that the partitions that are no longer written to have stayed without
+// backlog for long enough that no client writes to them anymore.
+func nextPartitionCount(
+	current *persistencespb.TaskQueuePartitionCount,
+	stats *taskqueuespb.BacklogStats,
+	drained bool,
+	limits partitionScalingLimits,
+) (int32, int32) {
+	read := max(1, current.GetReadPartitions())
+	write := max(1, current.GetWritePartitions())
+	maxPartitions := int32(max(1, limits.maxPartitions))
+
+	addRate := stats.GetTasksAddRate() / float64(write)
+	pollRate := stats.GetPollsRate() / float64(read)
+	fwdRate := stats.GetForwardContentionRate()
+	overloaded := exceedsThreshold(addRate, limits.addRateThreshold) ||
+		exceedsThreshold(pollRate, limits.pollRateThreshold) ||
+		exceedsThreshold(fwdRate, limits.fwdThreshold)
+	underloaded := belowQuarterOfThreshold(addRate, limits.addRateThreshold) &&
+		belowQuarterOfThreshold(pollRate, limits.pollRateThreshold) &&
+		belowQuarterOfThreshold(fwdRate, limits.fwdThreshold)
+
+	switch {
+	case write > maxPartitions:
+		return read, maxPartitions
+	case overloaded && write < maxPartitions:
+		write = min(maxPartitions, 2*write)
+		return max(read, write), write
+	case read > write:
+		if drained {
+			return write, write
+		}
+		return read, write
+	case underloaded && write > 1:
+		return read, write / 2
+	}
+	return read, write
+}
+
+// exceedsThreshold returns whether value is above a positive threshold.
+func exceedsThreshold(value float64, threshold float64) bool {
+	return threshold > 0 && value > threshold
+}
+
+// belowQuarterOfThreshold returns whether value is below a quarter of a positive threshold, or the threshold is
+// not positive.
+func belowQuarterOfThreshold(value float64, threshold float64) bool {
+	return threshold <= 0 || 4*value < threshold
+}
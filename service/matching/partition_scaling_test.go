@@ -0,0 +1,158 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+)
+
+func TestNextPartitionCount(t *testing.T) {
+	limits := partitionScalingLimits{
+		maxPartitions:     8,
+		addRateThreshold:  100,
+		pollRateThreshold: 100,
+		fwdThreshold:      10,
+	}
+	count := func(read, write int32) *persistencespb.TaskQueuePartitionCount {
+		return &persistencespb.TaskQueuePartitionCount{ReadPartitions: read, WritePartitions: write}
+	}
+
+	testCases := []struct {
+		name          string
+		current       *persistencespb.TaskQueuePartitionCount
+		stats         *taskqueuespb.BacklogStats
+		drained       bool
+		expectedRead  int32
+		expectedWrite int32
+	}{
+		{
+			name:          "steady",
+			current:       count(4, 4),
+			stats:         &taskqueuespb.BacklogStats{TasksAddRate: 200, PollsRate: 200},
+			expectedRead:  4,
+			expectedWrite: 4,
+		},
+		{
+			name:          "grow on add rate",
+			current:       count(4, 4),
+			stats:         &taskqueuespb.BacklogStats{TasksAddRate: 401},
+			expectedRead:  8,
+			expectedWrite: 8,
+		},
+		{
+			name:          "grow on poll rate",
+			current:       count(1, 1),
+			stats:         &taskqueuespb.BacklogStats{PollsRate: 101},
+			expectedRead:  2,
+			expectedWrite: 2,
+		},
+		{
+			name:          "grow on forwarder contention",
+			current:       count(2, 2),
+			stats:         &taskqueuespb.BacklogStats{ForwardContentionRate: 11},
+			expectedRead:  4,
+			expectedWrite: 4,
+		},
+		{
+			name:          "grow up to max",
+			current:       count(6, 6),
+			stats:         &taskqueuespb.BacklogStats{TasksAddRate: 1000},
+			expectedRead:  8,
+			expectedWrite: 8,
+		},
+		{
+			name:          "do not grow past max",
+			current:       count(8, 8),
+			stats:         &taskqueuespb.BacklogStats{TasksAddRate: 1000},
+			expectedRead:  8,
+			expectedWrite: 8,
+		},
+		{
+			name:          "grow while draining",
+			current:       count(8, 4),
+			stats:         &taskqueuespb.BacklogStats{TasksAddRate: 1000},
+			expectedRead:  8,
+			expectedWrite: 8,
+		},
+		{
+			name:          "shrink write partitions first",
+			current:       count(4, 4),
+			stats:         &taskqueuespb.BacklogStats{TasksAddRate: 99, PollsRate: 99},
+			expectedRead:  4,
+			expectedWrite: 2,
+		},
+		{
+			name:          "wait for drain",
+			current:       count(4, 2),
+			stats:         &taskqueuespb.BacklogStats{},
+			expectedRead:  4,
+			expectedWrite: 2,
+		},
+		{
+			name:          "shrink read partitions once drained",
+			current:       count(4, 2),
+			stats:         &taskqueuespb.BacklogStats{},
+			drained:       true,
+			expectedRead:  2,
+			expectedWrite: 2,
+		},
+		{
+			name:          "do not shrink below one",
+			current:       count(1, 1),
+			stats:         &taskqueuespb.BacklogStats{},
+			expectedRead:  1,
+			expectedWrite: 1,
+		},
+		{
+			name:          "do not shrink with contention",
+			current:       count(4, 4),
+			stats:         &taskqueuespb.BacklogStats{ForwardContentionRate: 5},
+			expectedRead:  4,
+			expectedWrite: 4,
+		},
+		{
+			name:          "shrink to lowered max",
+			current:       count(16, 16),
+			stats:         &taskqueuespb.BacklogStats{TasksAddRate: 1000},
+			expectedRead:  16,
+			expectedWrite: 8,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			read, write := nextPartitionCount(tc.current, tc.stats, tc.drained, limits)
+			assert.Equal(t, tc.expectedRead, read)
+			assert.Equal(t, tc.expectedWrite, write)
+		})
+	}
+
+	// Thresholds that are not positive are ignored.
+	read, write := nextPartitionCount(count(4, 4), &taskqueuespb.BacklogStats{TasksAddRate: 1000}, false, partitionScalingLimits{maxPartitions: 8})
+	assert.Equal(t, int32(4), read)
+	assert.Equal(t, int32(2), write)
+}
@@ -0,0 +1,93 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	"golang.org/x/exp/slices"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
+)
+
+// UpdatePauseState pauses or resumes dispatch, for all pollers when buildId is empty or only for pollers with the
+// given build ID otherwise. Resuming without a build ID clears all pauses. The given state is not mutated.
+func UpdatePauseState(
+	clock *hlc.Clock,
+	state *persistencespb.TaskQueuePauseState,
+	paused bool,
+	buildId string,
+	reason string,
+	identity string,
+	maxBuildIds int,
+) (*persistencespb.TaskQueuePauseState, error) {
+	ret := &persistencespb.TaskQueuePauseState{
+		Paused:          state.GetPaused(),
+		PausedBuildIds:  slices.Clone(state.GetPausedBuildIds()),
+		Reason:          reason,
+		Identity:        identity,
+		UpdateTimestamp: clock,
+	}
+	switch {
+	case paused && buildId == "":
+		ret.Paused = true
+	case paused:
+		if slices.Contains(ret.PausedBuildIds, buildId) {
+			break
+		}
+		if maxBuildIds > 0 && len(ret.PausedBuildIds) >= maxBuildIds {
+			return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf("update would exceed number of paused build IDs permitted in namespace dynamic config (%v/%v)", len(ret.PausedBuildIds)+1, maxBuildIds))
+		}
+		ret.PausedBuildIds = append(ret.PausedBuildIds, buildId)
+	case buildId == "":
+		ret.Paused = false
+		ret.PausedBuildIds = nil
+	default:
+		ret.PausedBuildIds = slices.DeleteFunc(ret.PausedBuildIds, func(id string) bool { return id == buildId })
+	}
+	return ret, nil
+}
+
+// MergePauseState merges pause states of two clusters. The state that was updated last wins, and the given user data
+// clock is advanced to its update timestamp so that later local updates are ordered after it.
+func MergePauseState(
+	clock *hlc.Clock,
+	a *persistencespb.TaskQueuePauseState,
+	b *persistencespb.TaskQueuePauseState,
+) (*persistencespb.TaskQueuePauseState, *hlc.Clock) {
+	merged := a
+	if a == nil || (b != nil && hlc.Greater(b.GetUpdateTimestamp(), a.GetUpdateTimestamp())) {
+		merged = b
+	}
+	if ts := merged.GetUpdateTimestamp(); ts != nil && (clock == nil || hlc.Greater(ts, clock)) {
+		clock = ts
+	}
+	return merged, clock
+}
+
+// isDispatchPaused returns whether dispatch to pollers with the given build ID is paused.
+func isDispatchPaused(state *persistencespb.TaskQueuePauseState, buildId string) bool {
+	return state.GetPaused() || (buildId != "" && slices.Contains(state.GetPausedBuildIds(), buildId))
+}
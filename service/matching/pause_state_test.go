@@ -0,0 +1,110 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.temporal.io/api/serviceerror"
+
+	commonclock "go.temporal.io/server/common/clock"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
+)
+
+func TestUpdatePauseState(t *testing.T) {
+	clock := hlc.Zero(1)
+
+	state, err := UpdatePauseState(clock, nil, true, "", "bad deploy", "operator", 2)
+	assert.NoError(t, err)
+	assert.True(t, state.Paused)
+	assert.Equal(t, "bad deploy", state.Reason)
+	assert.Equal(t, "operator", state.Identity)
+	assert.Equal(t, clock, state.UpdateTimestamp)
+
+	state, err = UpdatePauseState(clock, state, true, "v1", "", "", 2)
+	assert.NoError(t, err)
+	state, err = UpdatePauseState(clock, state, true, "v1", "", "", 2)
+	assert.NoError(t, err)
+	state, err = UpdatePauseState(clock, state, true, "v2", "", "", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v1", "v2"}, state.PausedBuildIds)
+
+	_, err = UpdatePauseState(clock, state, true, "v3", "", "", 2)
+	var failedPrecondition *serviceerror.FailedPrecondition
+	assert.ErrorAs(t, err, &failedPrecondition)
+
+	resumed, err := UpdatePauseState(clock, state, false, "v1", "", "", 2)
+	assert.NoError(t, err)
+	assert.True(t, resumed.Paused)
+	assert.Equal(t, []string{"v2"}, resumed.PausedBuildIds)
+	// The original state is not mutated.
+	assert.Equal(t, []string{"v1", "v2"}, state.PausedBuildIds)
+
+	resumed, err = UpdatePauseState(clock, state, false, "", "", "", 2)
+	assert.NoError(t, err)
+	assert.False(t, resumed.Paused)
+	assert.Empty(t, resumed.PausedBuildIds)
+}
+
+func TestMergePauseState(t *testing.T) {
+	clock1 := hlc.Zero(1)
+	clock2 := hlc.Next(clock1, commonclock.NewRealTimeSource())
+	older, err := UpdatePauseState(clock1, nil, true, "", "", "", 0)
+	assert.NoError(t, err)
+	newer, err := UpdatePauseState(clock2, older, false, "", "", "", 0)
+	assert.NoError(t, err)
+
+	merged, clock := MergePauseState(clock1, older, newer)
+	assert.Equal(t, newer, merged)
+	assert.Equal(t, clock2, clock)
+	merged, clock = MergePauseState(clock1, newer, older)
+	assert.Equal(t, newer, merged)
+	assert.Equal(t, clock2, clock)
+	merged, clock = MergePauseState(nil, older, nil)
+	assert.Equal(t, older, merged)
+	assert.Equal(t, clock1, clock)
+	merged, clock = MergePauseState(clock2, nil, older)
+	assert.Equal(t, older, merged)
+	// The clock never moves backwards.
+	assert.Equal(t, clock2, clock)
+	merged, clock = MergePauseState(nil, nil, nil)
+	assert.Nil(t, merged)
+	assert.Nil(t, clock)
+}
+
+func TestIsDispatchPaused(t *testing.T) {
+	clock := hlc.Zero(1)
+	assert.False(t, isDispatchPaused(nil, "v1"))
+
+	state, err := UpdatePauseState(clock, nil, true, "v1", "", "", 0)
+	assert.NoError(t, err)
+	assert.True(t, isDispatchPaused(state, "v1"))
+	assert.False(t, isDispatchPaused(state, "v2"))
+	assert.False(t, isDispatchPaused(state, ""))
+
+	state, err = UpdatePauseState(clock, state, true, "", "", "", 0)
+	assert.NoError(t, err)
+	assert.True(t, isDispatchPaused(state, "v2"))
+	assert.True(t, isDispatchPaused(state, ""))
+}
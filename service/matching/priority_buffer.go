@@ -0,0 +1,270 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/taskpriority"
+)
+
+type (
+	// priorityBuffer holds backlog tasks loaded from persistence in one lane per priority.
+	// add blocks while the buffer is full and next blocks while it is empty.
+	//
+	// All priorities share one persisted queue per partition, which is read in task ID order, so
+	// priority only reorders the tasks in this buffer. A task can overtake at most capacity
+	// lower priority tasks that were persisted before it; the ones beyond that are dispatched
+	// first.
+	priorityBuffer struct {
+		capacity          int
+		weightRatio       func() int
+		fairnessKeyWeight func(string) int
+
+		lock    sync.Mutex
+		lanes   [taskpriority.NumLevels]*fairnessLane
+		credits [taskpriority.NumLevels]int
+		size    int
+		closed  bool
+
+		notEmpty chan struct{}
+		notFull  chan struct{}
+	}
+
+	// fairnessLane holds the tasks of one priority in one queue per fairness key and serves
+	// the keys in weighted round robin order. Like priorities, the round robin only covers the
+	// buffered tasks: a key with a large persisted backlog fills the buffer, and the tasks of
+	// other keys persisted after it only take turns once they are loaded.
+	fairnessLane struct {
+		queues map[string][]*persistencespb.AllocatedTaskInfo
+		keys   []string // keys with queued tasks, in round robin order
+		cursor int      // position in keys of the key being served
+		served int      // tasks taken from keys[cursor] in its current turn
+		size   int
+	}
+)
+
+func newPriorityBuffer(capacity int, weightRatio func() int, fairnessKeyWeight func(string) int) *priorityBuffer {
+	b := &priorityBuffer{
+		capacity:          max(capacity, 1),
+		weightRatio:       weightRatio,
+		fairnessKeyWeight: fairnessKeyWeight,
+		notEmpty:          make(chan struct{}, 1),
+		notFull:           make(chan struct{}, 1),
+	}
+	for idx := range b.lanes {
+		b.lanes[idx] = &fairnessLane{queues: make(map[string][]*persistencespb.AllocatedTaskInfo)}
+	}
+	return b
+}
+
+// add appends task to the lane of its priority, waiting for space if the buffer is full.
+func (b *priorityBuffer) add(ctx context.Context, task *persistencespb.AllocatedTaskInfo) error {
+	for {
+		b.lock.Lock()
+		if b.size < b.capacity {
+			b.lanes[taskpriority.Index(task.GetData().GetPriority())].push(task)
+			b.size++
+			b.lock.Unlock()
+			nonBlockingSignal(b.notEmpty)
+			return nil
+		}
+		b.lock.Unlock()
+
+		select {
+		case <-b.notFull:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// next removes the task that should be dispatched next, waiting for one if the buffer is
+// empty. It returns false once the buffer is closed and drained.
+func (b *priorityBuffer) next(ctx context.Context) (*persistencespb.AllocatedTaskInfo, bool, error) {
+	for {
+		b.lock.Lock()
+		if b.size > 0 {
+			task := b.lanes[b.pickLaneLocked()].pop(b.fairnessKeyWeight)
+			b.size--
+			remaining := b.size
+			b.lock.Unlock()
+			nonBlockingSignal(b.notFull)
+			if remaining > 0 {
+				nonBlockingSignal(b.notEmpty)
+			}
+			return task, true, nil
+		}
+		closed := b.closed
+		b.lock.Unlock()
+		if closed {
+			return nil, false, nil
+		}
+
+		select {
+		case <-b.notEmpty:
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+	}
+}
+
+// close wakes up next once the buffered tasks are drained.
+func (b *priorityBuffer) close() {
+	b.lock.Lock()
+	b.closed = true
+	b.lock.Unlock()
+	nonBlockingSignal(b.notEmpty)
+}
+
+func (b *priorityBuffer) len() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.size
+}
+
+// hasTasksAbove returns whether the buffer holds tasks of a higher priority than priority.
+func (b *priorityBuffer) hasTasksAbove(priority int32) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for idx := 0; idx < taskpriority.Index(priority); idx++ {
+		if b.lanes[idx].size > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// backlogs returns the number of buffered tasks of each priority that has any, highest first.
+func (b *priorityBuffer) backlogs() []*taskqueuespb.PriorityBacklog {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	var result []*taskqueuespb.PriorityBacklog
+	for idx, lane := range b.lanes {
+		if lane.size > 0 {
+			result = append(result, &taskqueuespb.PriorityBacklog{
+				Priority: taskpriority.Highest + int32(idx),
+				Count:    int64(lane.size),
+			})
+		}
+	}
+	return result
+}
+
+// fairnessBacklogs returns the number of buffered tasks of each fairness key that has any,
+// across all priorities, ordered by key.
+func (b *priorityBuffer) fairnessBacklogs() []*taskqueuespb.FairnessBacklog {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	counts := make(map[string]int64)
+	for _, lane := range b.lanes {
+		for key, queue := range lane.queues {
+			counts[key] += int64(len(queue))
+		}
+	}
+	result := make([]*taskqueuespb.FairnessBacklog, 0, len(counts))
+	for key, count := range counts {
+		result = append(result, &taskqueuespb.FairnessBacklog{FairnessKey: key, Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].FairnessKey < result[j].FairnessKey })
+	return result
+}
+
+// pickLaneLocked returns the first non-empty lane when weighting is off. Otherwise it runs a
+// smooth weighted round robin over the non-empty lanes, where each lane weighs ratio times
+// the next lower one.
+func (b *priorityBuffer) pickLaneLocked() int {
+	ratio := b.weightRatio()
+	if ratio <= 0 {
+		for idx, lane := range b.lanes {
+			if lane.size > 0 {
+				return idx
+			}
+		}
+		return -1
+	}
+
+	picked, total, weight := -1, 0, 1
+	for idx := len(b.lanes) - 1; idx >= 0; idx-- {
+		if b.lanes[idx].size > 0 {
+			b.credits[idx] += weight
+			total += weight
+			if picked == -1 || b.credits[idx] >= b.credits[picked] {
+				picked = idx
+			}
+		} else {
+			b.credits[idx] = 0
+		}
+		weight *= ratio
+	}
+	b.credits[picked] -= total
+	return picked
+}
+
+func (l *fairnessLane) push(task *persistencespb.AllocatedTaskInfo) {
+	key := task.GetData().GetFairnessKey()
+	queue, ok := l.queues[key]
+	if !ok {
+		l.keys = append(l.keys, key)
+	}
+	l.queues[key] = append(queue, task)
+	l.size++
+}
+
+// pop takes the head task of the key being served. The key keeps its turn until it has
+// been served weight(key) tasks or runs out of tasks.
+func (l *fairnessLane) pop(weight func(string) int) *persistencespb.AllocatedTaskInfo {
+	key := l.keys[l.cursor]
+	queue := l.queues[key]
+	task := queue[0]
+	queue[0] = nil
+	l.size--
+	l.served++
+
+	if len(queue) == 1 {
+		delete(l.queues, key)
+		l.keys = append(l.keys[:l.cursor], l.keys[l.cursor+1:]...)
+		l.served = 0
+	} else {
+		l.queues[key] = queue[1:]
+		if l.served >= weight(key) {
+			l.cursor++
+			l.served = 0
+		}
+	}
+	if l.cursor >= len(l.keys) {
+		l.cursor = 0
+	}
+	return task
+}
+
+func nonBlockingSignal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
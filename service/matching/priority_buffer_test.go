@@ -0,0 +1,234 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/taskpriority"
+)
+
+func unitFairnessKeyWeight(string) int { return 1 }
+
+func newPriorityTask(taskID int64, priority int32) *persistencespb.AllocatedTaskInfo {
+	return newFairnessTask(taskID, priority, "")
+}
+
+func newFairnessTask(taskID int64, priority int32, fairnessKey string) *persistencespb.AllocatedTaskInfo {
+	return &persistencespb.AllocatedTaskInfo{
+		TaskId: taskID,
+		Data:   &persistencespb.TaskInfo{Priority: priority, FairnessKey: fairnessKey},
+	}
+}
+
+func drainPriorityBuffer(t *testing.T, b *priorityBuffer, n int) []int64 {
+	var ids []int64
+	for i := 0; i < n; i++ {
+		task, ok, err := b.next(context.Background())
+		require.NoError(t, err)
+		require.True(t, ok)
+		ids = append(ids, task.GetTaskId())
+	}
+	return ids
+}
+
+// loadAndDrainPriorityBuffer dispatches backlog through b the way the task reader does: it loads
+// the tasks in order whenever the buffer has space.
+func loadAndDrainPriorityBuffer(t *testing.T, b *priorityBuffer, capacity int, backlog []*persistencespb.AllocatedTaskInfo) []int64 {
+	ctx := context.Background()
+	for _, task := range backlog[:capacity] {
+		require.NoError(t, b.add(ctx, task))
+	}
+	backlog = backlog[capacity:]
+	var ids []int64
+	for b.len() > 0 {
+		ids = append(ids, drainPriorityBuffer(t, b, 1)...)
+		if len(backlog) > 0 {
+			require.NoError(t, b.add(ctx, backlog[0]))
+			backlog = backlog[1:]
+		}
+	}
+	return ids
+}
+
+func TestPriorityBuffer_Strict(t *testing.T) {
+	t.Parallel()
+
+	b := newPriorityBuffer(10, func() int { return 0 }, unitFairnessKeyWeight)
+	ctx := context.Background()
+	require.NoError(t, b.add(ctx, newPriorityTask(1, taskpriority.Lowest)))
+	require.NoError(t, b.add(ctx, newPriorityTask(2, 0)))
+	require.NoError(t, b.add(ctx, newPriorityTask(3, taskpriority.Highest)))
+	require.NoError(t, b.add(ctx, newPriorityTask(4, taskpriority.Default)))
+	require.NoError(t, b.add(ctx, newPriorityTask(5, taskpriority.Highest)))
+
+	require.Equal(t, []*taskqueuespb.PriorityBacklog{
+		{Priority: taskpriority.Highest, Count: 2},
+		{Priority: taskpriority.Default, Count: 2},
+		{Priority: taskpriority.Lowest, Count: 1},
+	}, b.backlogs())
+	require.Equal(t, []int64{3, 5, 2, 4, 1}, drainPriorityBuffer(t, b, 5))
+	require.Equal(t, 0, b.len())
+}
+
+func TestPriorityBuffer_Weighted(t *testing.T) {
+	t.Parallel()
+
+	b := newPriorityBuffer(100, func() int { return 2 }, unitFairnessKeyWeight)
+	ctx := context.Background()
+	for i := int64(0); i < 20; i++ {
+		require.NoError(t, b.add(ctx, newPriorityTask(100+i, taskpriority.Highest)))
+		require.NoError(t, b.add(ctx, newPriorityTask(200+i, taskpriority.Highest+1)))
+	}
+
+	counts := make(map[int64]int)
+	for _, id := range drainPriorityBuffer(t, b, 9) {
+		counts[id/100]++
+	}
+	// The highest priority gets twice the dispatches of the next one.
+	require.Equal(t, map[int64]int{1: 6, 2: 3}, counts)
+}
+
+func TestPriorityBuffer_FairnessKeys(t *testing.T) {
+	t.Parallel()
+
+	b := newPriorityBuffer(100, func() int { return 0 }, unitFairnessKeyWeight)
+	ctx := context.Background()
+	// A noisy tenant enqueues its whole backlog before the others.
+	for i := int64(1); i <= 5; i++ {
+		require.NoError(t, b.add(ctx, newFairnessTask(10+i, 0, "noisy")))
+	}
+	require.NoError(t, b.add(ctx, newFairnessTask(21, 0, "a")))
+	require.NoError(t, b.add(ctx, newFairnessTask(22, 0, "a")))
+	require.NoError(t, b.add(ctx, newFairnessTask(31, 0, "b")))
+	require.NoError(t, b.add(ctx, newFairnessTask(1, taskpriority.Highest, "noisy")))
+
+	require.Equal(t, []*taskqueuespb.FairnessBacklog{
+		{FairnessKey: "a", Count: 2},
+		{FairnessKey: "b", Count: 1},
+		{FairnessKey: "noisy", Count: 6},
+	}, b.fairnessBacklogs())
+	// Priority comes first, then keys take turns.
+	require.Equal(t, []int64{1, 11, 21, 31, 12, 22, 13, 14, 15}, drainPriorityBuffer(t, b, 9))
+	require.Empty(t, b.fairnessBacklogs())
+}
+
+func TestPriorityBuffer_FairnessKeyWeights(t *testing.T) {
+	t.Parallel()
+
+	weights := map[string]int{"big": 3}
+	b := newPriorityBuffer(100, func() int { return 0 }, func(key string) int { return max(weights[key], 1) })
+	ctx := context.Background()
+	for i := int64(1); i <= 6; i++ {
+		require.NoError(t, b.add(ctx, newFairnessTask(10+i, 0, "big")))
+		require.NoError(t, b.add(ctx, newFairnessTask(20+i, 0, "small")))
+	}
+
+	require.Equal(t, []int64{11, 12, 13, 21, 14, 15, 16, 22, 23, 24}, drainPriorityBuffer(t, b, 10))
+}
+
+func TestPriorityBuffer_BlocksWhenFull(t *testing.T) {
+	t.Parallel()
+
+	b := newPriorityBuffer(1, func() int { return 0 }, unitFairnessKeyWeight)
+	require.NoError(t, b.add(context.Background(), newPriorityTask(1, 0)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, b.add(ctx, newPriorityTask(2, 0)), context.DeadlineExceeded)
+
+	added := make(chan error, 1)
+	go func() { added <- b.add(context.Background(), newPriorityTask(3, 0)) }()
+	require.Equal(t, []int64{1}, drainPriorityBuffer(t, b, 1))
+	require.NoError(t, <-added)
+	require.Equal(t, []int64{3}, drainPriorityBuffer(t, b, 1))
+}
+
+func TestPriorityBuffer_Close(t *testing.T) {
+	t.Parallel()
+
+	b := newPriorityBuffer(10, func() int { return 0 }, unitFairnessKeyWeight)
+	require.NoError(t, b.add(context.Background(), newPriorityTask(1, 0)))
+	b.close()
+
+	require.Equal(t, []int64{1}, drainPriorityBuffer(t, b, 1))
+	_, ok, err := b.next(context.Background())
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestPriorityBuffer_BacklogLargerThanBuffer(t *testing.T) {
+	t.Parallel()
+
+	// The persisted backlog has eight low priority tasks followed by two high priority ones,
+	// and is loaded into the buffer in task ID order as space frees up.
+	const capacity = 3
+	var backlog []*persistencespb.AllocatedTaskInfo
+	for id := int64(1); id <= 8; id++ {
+		backlog = append(backlog, newPriorityTask(id, taskpriority.Lowest))
+	}
+	backlog = append(backlog, newPriorityTask(9, taskpriority.Highest), newPriorityTask(10, taskpriority.Highest))
+
+	b := newPriorityBuffer(capacity, func() int { return 0 }, unitFairnessKeyWeight)
+	ids := loadAndDrainPriorityBuffer(t, b, capacity, backlog)
+
+	// The high priority tasks only overtake the low priority tasks that were in the buffer with them.
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6, 9, 10, 7, 8}, ids)
+}
+
+func TestPriorityBuffer_HasTasksAbove(t *testing.T) {
+	t.Parallel()
+
+	b := newPriorityBuffer(10, func() int { return 0 }, unitFairnessKeyWeight)
+	require.False(t, b.hasTasksAbove(taskpriority.Lowest))
+
+	require.NoError(t, b.add(context.Background(), newPriorityTask(1, 0)))
+	require.True(t, b.hasTasksAbove(taskpriority.Lowest))
+	require.False(t, b.hasTasksAbove(taskpriority.Default))
+	require.False(t, b.hasTasksAbove(0))
+	require.False(t, b.hasTasksAbove(taskpriority.Highest))
+}
+
+func TestPriorityBuffer_FairnessKeysBacklogLargerThanBuffer(t *testing.T) {
+	t.Parallel()
+
+	// The persisted backlog has eight tasks of key "a" followed by two of key "b".
+	const capacity = 3
+	var backlog []*persistencespb.AllocatedTaskInfo
+	for id := int64(1); id <= 8; id++ {
+		backlog = append(backlog, newFairnessTask(id, 0, "a"))
+	}
+	backlog = append(backlog, newFairnessTask(9, 0, "b"), newFairnessTask(10, 0, "b"))
+
+	b := newPriorityBuffer(capacity, func() int { return 0 }, unitFairnessKeyWeight)
+	ids := loadAndDrainPriorityBuffer(t, b, capacity, backlog)
+
+	// "b" only takes turns with "a" once its tasks are loaded.
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 9, 8, 10}, ids)
+}
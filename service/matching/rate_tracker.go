@@ -0,0 +1,86 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+)
+
+const (
+	rateTrackerWindow = time.Minute
+)
+
+type (
+	// rateTracker counts events in one-second buckets to report their rate over a sliding
+	// window of rateTrackerWindow.
+	rateTracker struct {
+		timeSource clock.TimeSource
+
+		lock       sync.Mutex
+		buckets    []int64
+		lastSecond int64 // unix second of the newest bucket
+	}
+)
+
+func newRateTracker(timeSource clock.TimeSource) *rateTracker {
+	return &rateTracker{
+		timeSource: timeSource,
+		buckets:    make([]int64, int(rateTrackerWindow/time.Second)),
+		lastSecond: timeSource.Now().Unix(),
+	}
+}
+
+func (r *rateTracker) record(count int64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	now := r.advanceLocked()
+	r.buckets[now%int64(len(r.buckets))] += count
+}
+
+// rate returns the events per second over the window.
+func (r *rateTracker) rate() float64 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.advanceLocked()
+	var sum int64
+	for _, count := range r.buckets {
+		sum += count
+	}
+	return float64(sum) / rateTrackerWindow.Seconds()
+}
+
+// advanceLocked clears the buckets of the seconds that passed since the last call.
+func (r *rateTracker) advanceLocked() int64 {
+	now := r.timeSource.Now().Unix()
+	size := int64(len(r.buckets))
+	for s := max(r.lastSecond+1, now-size+1); s <= now; s++ {
+		r.buckets[s%size] = 0
+	}
+	if now > r.lastSecond {
+		r.lastSecond = now
+	}
+	return r.lastSecond
+}
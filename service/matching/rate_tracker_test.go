@@ -0,0 +1,54 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.temporal.io/server/common/clock"
+)
+
+func TestRateTracker(t *testing.T) {
+	t.Parallel()
+
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(1000, 0))
+	r := newRateTracker(timeSource)
+	assert.Zero(t, r.rate())
+
+	r.record(30)
+	timeSource.Advance(10 * time.Second)
+	r.record(30)
+	assert.InDelta(t, 1.0, r.rate(), 0.001)
+
+	// The first 30 leave the window after a minute.
+	timeSource.Advance(rateTrackerWindow - 5*time.Second)
+	assert.InDelta(t, 0.5, r.rate(), 0.001)
+
+	timeSource.Advance(time.Hour)
+	assert.Zero(t, r.rate())
+	r.record(6)
+	assert.InDelta(t, 0.1, r.rate(), 0.001)
+}
@@ -45,6 +45,7 @@ import (
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/future"
@@ -57,6 +58,7 @@ import (
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/tqname"
 	"go.temporal.io/server/internal/goro"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 const (
@@ -145,6 +147,11 @@ type (
 		HasPollerAfter(accessTime time.Time) bool
 		// DescribeTaskQueue returns information about the target task queue
 		DescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse
+		// BacklogStats returns approximate backlog statistics of this task queue
+		BacklogStats() *taskqueuespb.BacklogStats
+		// PartitionCount returns the partition count recorded in user data by partition scaling, or nil if
+		// partitions are not scaled
+		PartitionCount() *persistencespb.TaskQueuePartitionCount
 		String() string
 		QueueID() *taskQueueID
 		TaskQueueKind() enumspb.TaskQueueKind
@@ -178,6 +185,9 @@ type (
 		// pollerHistory stores poller which poll from this taskqueue in last few minutes
 		pollerHistory    *pollerHistory
 		currentPolls     atomic.Int64
+		addRate          *rateTracker // tasks added by history, excluding forwarded ones
+		dispatchRate     *rateTracker // tasks dispatched to pollers of this partition
+		pollRate         *rateTracker // polls received, excluding forwarded ones
 		goroGroup        goro.Group
 		initializedError *future.FutureImpl[struct{}]
 		// userDataReady is fulfilled once versioning data is fetched from the root partition. If this TQ is
@@ -194,6 +204,8 @@ var _ taskQueueManager = (*taskQueueManagerImpl)(nil)
 var (
 	errRemoteSyncMatchFailed  = serviceerror.NewCanceled("remote sync match failed")
 	errMissingNormalQueueName = errors.New("missing normal queue name")
+	// errDispatchPaused is returned by a poller that received a task after dispatch to it was paused.
+	errDispatchPaused = errors.New("dispatch paused")
 
 	normalStickyInfo = stickyInfo{kind: enumspb.TASK_QUEUE_KIND_NORMAL}
 )
@@ -261,6 +273,24 @@ func newTaskQueueManager(
 		initializedError:     future.NewFuture[struct{}](),
 		userDataReady:        future.NewFuture[struct{}](),
 	}
+	tlMgr.addRate = newRateTracker(e.timeSource)
+	tlMgr.dispatchRate = newRateTracker(e.timeSource)
+	tlMgr.pollRate = newRateTracker(e.timeSource)
+
+	// Partition counts recorded in user data by partition scaling take precedence over dynamic config.
+	numWritePartitions, numReadPartitions := taskQueueConfig.NumWritePartitions, taskQueueConfig.NumReadPartitions
+	taskQueueConfig.NumWritePartitions = func() int {
+		if count := tlMgr.PartitionCount(); count != nil {
+			return max(1, int(count.GetWritePartitions()))
+		}
+		return numWritePartitions()
+	}
+	taskQueueConfig.NumReadPartitions = func() int {
+		if count := tlMgr.PartitionCount(); count != nil {
+			return max(1, int(count.GetReadPartitions()))
+		}
+		return numReadPartitions()
+	}
 	// poller history is only kept for the base task queue manager
 	if !tlMgr.managesSpecificVersionSet() {
 		tlMgr.pollerHistory = newPollerHistory()
@@ -281,7 +311,8 @@ func newTaskQueueManager(
 		forwardTaskQueue := newTaskQueueIDWithVersionSet(taskQueue, "")
 		fwdr = newForwarder(&taskQueueConfig.forwarderConfig, forwardTaskQueue, stickyInfo.kind, e.matchingRawClient)
 	}
-	tlMgr.matcher = newTaskMatcher(taskQueueConfig, fwdr, tlMgr.taggedMetricsHandler)
+	tlMgr.matcher = newTaskMatcher(taskQueueConfig, fwdr, tlMgr.taggedMetricsHandler, e.timeSource)
+
 	for _, opt := range opts {
 		opt(tlMgr)
 	}
@@ -324,6 +355,7 @@ func (c *taskQueueManagerImpl) Start() {
 	c.taskReader.Start()
 	if c.db.DbStoresUserData() {
 		c.goroGroup.Go(c.loadUserData)
+		c.goroGroup.Go(c.scalePartitions)
 	} else {
 		c.goroGroup.Go(c.fetchUserData)
 	}
@@ -441,9 +473,17 @@ func (c *taskQueueManagerImpl) AddTask(
 
 	// If this is the versioned task dlq, skip sync match since we know we have no pollers.
 	isDlq := c.taskQueueID.VersionSet() == dlqVersionSet
-	if namespaceEntry.ActiveInCluster(c.clusterMeta.GetCurrentClusterName()) && !isDlq {
+	// While dispatch is paused for all pollers, skip sync match so that the task gets persisted to the backlog. Pollers
+	// of paused build IDs hand sync matched tasks back, see trySyncMatch.
+	pauseState, _ := c.dispatchPauseState()
+	// Skip sync match too if loaded backlog tasks of a higher priority are still waiting for pollers.
+	higherPriorityBacklog := c.taskReader.taskBuffer.hasTasksAbove(taskInfo.GetPriority())
+	if namespaceEntry.ActiveInCluster(c.clusterMeta.GetCurrentClusterName()) && !isDlq && !isDispatchPaused(pauseState, "") && !higherPriorityBacklog {
 		syncMatch, err := c.trySyncMatch(ctx, params)
 		if syncMatch {
+			if err == nil && params.forwardedFrom == "" {
+				c.addRate.record(1)
+			}
 			return syncMatch, err
 		}
 	}
@@ -466,6 +506,9 @@ func (c *taskQueueManagerImpl) AddTask(
 	} else {
 		err = c.SpoolTask(params)
 	}
+	if err == nil {
+		c.addRate.record(1)
+	}
 	return false, err
 }
 
@@ -490,6 +533,9 @@ func (c *taskQueueManagerImpl) PollTask(
 
 	c.currentPolls.Add(1)
 	defer c.currentPolls.Add(-1)
+	if pollMetadata.forwardedFrom == "" {
+		c.pollRate.record(1)
+	}
 
 	namespaceEntry, err := c.namespaceRegistry.GetNamespaceByID(c.taskQueueID.namespaceID)
 	if err != nil {
@@ -507,13 +553,30 @@ func (c *taskQueueManagerImpl) PollTask(
 		return c.matcher.PollForQuery(ctx, pollMetadata)
 	}
 
+	// While dispatch to this poller is paused, return an empty response right away.
+	buildId := pollMetadata.workerVersionCapabilities.GetBuildId()
+	if pauseState, _ := c.dispatchPauseState(); isDispatchPaused(pauseState, buildId) {
+		return nil, errNoTasks
+	}
+
 	task, err := c.matcher.Poll(ctx, pollMetadata)
 	if err != nil {
 		return nil, err
 	}
+	// Dispatch may have been paused while the poll was waiting. Hand the task back instead: a sync match task gets
+	// spooled by AddTask and a backlog task is written back to the backlog.
+	if !task.isQuery() && !task.isStarted() {
+		if pauseState, _ := c.dispatchPauseState(); isDispatchPaused(pauseState, buildId) {
+			task.finish(errDispatchPaused)
+			return nil, errNoTasks
+		}
+	}
 
 	task.namespace = c.namespace
 	task.backlogCountHint = c.taskAckManager.getBacklogCountHint
+	if pollMetadata.forwardedFrom == "" && !task.isQuery() {
+		c.dispatchRate.record(1)
+	}
 	return task, nil
 }
 
@@ -549,6 +612,193 @@ func (c *taskQueueManagerImpl) GetUserData() (*persistencespb.VersionedTaskQueue
 	return c.db.GetUserData()
 }
 
+// baseUserData returns the user data of the unversioned queue, or nil if it is unavailable, and a channel that is
+// closed when it may have changed.
+func (c *taskQueueManagerImpl) baseUserData() (*persistencespb.TaskQueueUserData, chan struct{}) {
+	var baseTqm taskQueueManager = c
+	if c.taskQueueID.VersionSet() != "" {
+		unversionedId := newTaskQueueIDWithVersionSet(c.taskQueueID, "")
+		baseTqm, _ = c.engine.getTaskQueueManagerNoWait(unversionedId, c.stickyInfo, false)
+		if baseTqm == nil {
+			return nil, nil
+		}
+	}
+	userData, userDataChanged, err := baseTqm.GetUserData()
+	if err != nil {
+		return nil, userDataChanged
+	}
+	return userData.GetData(), userDataChanged
+}
+
+// dispatchPauseState returns the pause state from the user data of the unversioned queue and a channel that is
+// closed when it may have changed. Dispatch is not paused when the user data is unavailable.
+func (c *taskQueueManagerImpl) dispatchPauseState() (*persistencespb.TaskQueuePauseState, chan struct{}) {
+	userData, userDataChanged := c.baseUserData()
+	return userData.GetPauseState(), userDataChanged
+}
+
+func (c *taskQueueManagerImpl) PartitionCount() *persistencespb.TaskQueuePartitionCount {
+	if c.kind == enumspb.TASK_QUEUE_KIND_STICKY || !c.config.PartitionScalingEnabled() {
+		return nil
+	}
+	userData, _ := c.baseUserData()
+	return userData.GetPartitionCounts()[int32(c.taskQueueID.taskType)]
+}
+
+// scalePartitions periodically adjusts the partition counts of the task queue types to their load while partition
+// scaling is enabled. It runs on the root workflow partition, which owns the user data the counts are recorded in.
+func (c *taskQueueManagerImpl) scalePartitions(ctx context.Context) error {
+	ctx = c.callerInfoContext(ctx)
+
+	for ctx.Err() == nil {
+		common.InterruptibleSleep(ctx, c.config.PartitionScalingInterval())
+		for _, taskType := range []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY} {
+			if err := c.scalePartitionsOfType(ctx, taskType); err != nil && ctx.Err() == nil {
+				c.engine.throttledLogger.Warn("Failed to scale task queue partitions",
+					tag.WorkflowNamespace(c.namespace.String()),
+					tag.WorkflowTaskQueueName(c.taskQueueID.FullName()),
+					tag.WorkflowTaskQueueType(taskType),
+					tag.Error(err))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *taskQueueManagerImpl) scalePartitionsOfType(ctx context.Context, taskType enumspb.TaskQueueType) error {
+	config := c.engine.config
+	nsName, taskQueueName := c.namespace.String(), c.taskQueueID.BaseNameString()
+	if !config.PartitionScalingEnabled(nsName, taskQueueName, taskType) {
+		return nil
+	}
+	userData, _, err := c.GetUserData()
+	if err != nil {
+		return err
+	}
+	typeID := newTaskQueueIDWithVersionSet(c.taskQueueID, "")
+	typeID.taskType = taskType
+	read, write := c.engine.partitionCounts(c.namespace, typeID, userData.GetData())
+	current := &persistencespb.TaskQueuePartitionCount{ReadPartitions: int32(read), WritePartitions: int32(write)}
+	recordedDrainedTimestamp := userData.GetData().GetPartitionCounts()[int32(taskType)].GetDrainedTimestamp()
+
+	resp, err := c.matchingClient.DescribeTaskQueueBacklog(ctx, &matchingservice.DescribeTaskQueueBacklogRequest{
+		NamespaceId:   c.taskQueueID.namespaceID.String(),
+		TaskQueue:     c.taskQueueID.FullName(),
+		TaskQueueType: taskType,
+	})
+	if err != nil {
+		return err
+	}
+	if len(resp.GetFailedPartitions()) > 0 {
+		// The load of the missing partitions is unknown.
+		return serviceerror.NewUnavailable(fmt.Sprintf("failed to describe partitions %v", resp.GetFailedPartitions()))
+	}
+	// Clients keep writing to partitions that were removed from the write partitions until their cached count expires,
+	// so read partitions are only removed once they have stayed empty for twice that long.
+	drained, markDrained, clearDrained := false, false, false
+	if read > write {
+		partitionsDrained, err := c.partitionsDrained(ctx, taskType, write, read)
+		if err != nil {
+			return err
+		}
+		switch {
+		case !partitionsDrained:
+			clearDrained = recordedDrainedTimestamp != nil
+		case recordedDrainedTimestamp == nil:
+			markDrained = true
+		default:
+			drained = c.engine.timeSource.Now().Sub(hlc.UTC(recordedDrainedTimestamp)) >= 2*config.PartitionCountCacheTTL()
+		}
+	}
+
+	newRead, newWrite := nextPartitionCount(current, resp.GetStats(), drained, partitionScalingLimits{
+		maxPartitions:     config.PartitionScalingMaxPartitions(nsName, taskQueueName, taskType),
+		addRateThreshold:  config.PartitionScalingAddRateThreshold(nsName, taskQueueName, taskType),
+		pollRateThreshold: config.PartitionScalingPollRateThreshold(nsName, taskQueueName, taskType),
+		fwdThreshold:      config.PartitionScalingFwdThreshold(nsName, taskQueueName, taskType),
+	})
+	if newRead == current.ReadPartitions && newWrite == current.WritePartitions {
+		if !markDrained && !clearDrained {
+			return nil
+		}
+		// Only the drained timestamp changes. The counts are recorded too in case they came from dynamic config.
+		return c.updatePartitionCount(ctx, taskType, func(count *persistencespb.TaskQueuePartitionCount, clock *hlc.Clock) {
+			if count.Version == 0 {
+				count.ReadPartitions = current.ReadPartitions
+				count.WritePartitions = current.WritePartitions
+				count.Version++
+			}
+			count.DrainedTimestamp = nil
+			if markDrained {
+				count.DrainedTimestamp = clock
+			}
+		})
+	}
+	c.engine.logger.Info("Scaling task queue partitions",
+		tag.WorkflowNamespace(nsName),
+		tag.WorkflowTaskQueueName(c.taskQueueID.FullName()),
+		tag.WorkflowTaskQueueType(taskType),
+		tag.NewInt("read-partitions", int(newRead)),
+		tag.NewInt("write-partitions", int(newWrite)))
+	return c.updatePartitionCount(ctx, taskType, func(count *persistencespb.TaskQueuePartitionCount, _ *hlc.Clock) {
+		count.ReadPartitions = newRead
+		count.WritePartitions = newWrite
+		count.Version++
+		count.DrainedTimestamp = nil
+	})
+}
+
+// updatePartitionCount applies updateFn to a copy of the recorded partition count of a task queue type, given the
+// updated user data clock, and records the result.
+func (c *taskQueueManagerImpl) updatePartitionCount(
+	ctx context.Context,
+	taskType enumspb.TaskQueueType,
+	updateFn func(count *persistencespb.TaskQueuePartitionCount, clock *hlc.Clock),
+) error {
+	return c.UpdateUserData(ctx, UserDataUpdateOptions{}, func(data *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+		clk := data.GetClock()
+		if clk == nil {
+			clk = hlc.Zero(c.clusterMeta.GetClusterID())
+		}
+		updatedClock := hlc.Next(clk, c.engine.timeSource)
+		// Avoid mutation
+		ret := common.CloneProto(data)
+		ret.Clock = updatedClock
+		if ret.PartitionCounts == nil {
+			ret.PartitionCounts = make(map[int32]*persistencespb.TaskQueuePartitionCount)
+		}
+		count := ret.PartitionCounts[int32(taskType)]
+		if count == nil {
+			count = &persistencespb.TaskQueuePartitionCount{}
+			ret.PartitionCounts[int32(taskType)] = count
+		}
+		updateFn(count, updatedClock)
+		count.UpdateTimestamp = updatedClock
+		// Partition counts reflect the load on this cluster, do not replicate them.
+		return ret, false, nil
+	})
+}
+
+// partitionsDrained returns whether the partitions from first up to last, excluding last, have no backlog left.
+func (c *taskQueueManagerImpl) partitionsDrained(ctx context.Context, taskType enumspb.TaskQueueType, first int, last int) (bool, error) {
+	for i := first; i < last; i++ {
+		resp, err := c.matchingClient.DescribeTaskQueueBacklog(ctx, &matchingservice.DescribeTaskQueueBacklogRequest{
+			NamespaceId:   c.taskQueueID.namespaceID.String(),
+			TaskQueue:     c.taskQueueID.WithPartition(i).FullName(),
+			TaskQueueType: taskType,
+			PartitionOnly: true,
+		})
+		if err != nil {
+			return false, err
+		}
+		if resp.GetStats().GetApproximateBacklogCount() > 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 // UpdateUserData updates user data for this task queue and replicates across clusters if necessary.
 func (c *taskQueueManagerImpl) UpdateUserData(ctx context.Context, options UserDataUpdateOptions, updateFn UserDataUpdateFunc) error {
 	newData, shouldReplicate, err := c.db.UpdateUserData(ctx, updateFn, options.KnownVersion, options.TaskQueueLimitPerBuildId)
@@ -607,7 +857,7 @@ func (c *taskQueueManagerImpl) HasPollerAfter(accessTime time.Time) bool {
 
 // DescribeTaskQueue returns information about the target taskqueue, right now this API returns the
 // pollers which polled this taskqueue in last few minutes and status of taskqueue's ackManager
-// (readLevel, ackLevel, backlogCountHint and taskIDBlock).
+// (readLevel, ackLevel, backlogCountHint and taskIDBlock), along with the buffered backlog by priority and fairness key.
 func (c *taskQueueManagerImpl) DescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse {
 	response := &matchingservice.DescribeTaskQueueResponse{Pollers: c.GetAllPollerInfo()}
 	if !includeTaskQueueStatus {
@@ -625,10 +875,29 @@ func (c *taskQueueManagerImpl) DescribeTaskQueue(includeTaskQueueStatus bool) *m
 			EndId:   taskIDBlock.end,
 		},
 	}
+	response.PriorityBacklogs = c.taskReader.taskBuffer.backlogs()
+	response.FairnessBacklogs = c.taskReader.taskBuffer.fairnessBacklogs()
 
 	return response
 }
 
+// BacklogStats returns the approximate backlog count, the age of the oldest backlog task being
+// offered to pollers, and the rates of tasks added and dispatched over the last minute. Buffered
+// and unread tasks are not considered, so the age is a lower bound of the oldest backlog task's.
+func (c *taskQueueManagerImpl) BacklogStats() *taskqueuespb.BacklogStats {
+	stats := &taskqueuespb.BacklogStats{
+		ApproximateBacklogCount: c.taskAckManager.getBacklogCountHint(),
+		TasksAddRate:            c.addRate.rate(),
+		TasksDispatchRate:       c.dispatchRate.rate(),
+		PollsRate:               c.pollRate.rate(),
+		ForwardContentionRate:   c.matcher.forwardContention.rate(),
+	}
+	if age := c.matcher.getBacklogAge(); age != emptyBacklogAge {
+		stats.OldestTaskAge = durationpb.New(age)
+	}
+	return stats
+}
+
 func (c *taskQueueManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	if c.taskQueueID.taskType == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
@@ -727,7 +996,12 @@ func (c *taskQueueManagerImpl) trySyncMatch(ctx context.Context, params addTaskP
 	}
 
 	task := newInternalTask(fakeTaskIdWrapper, nil, params.source, params.forwardedFrom, true)
-	return c.matcher.Offer(childCtx, task)
+	syncMatch, err := c.matcher.Offer(childCtx, task)
+	if errors.Is(err, errDispatchPaused) {
+		// The matched poller's build ID is paused, so the task goes to the backlog instead.
+		return false, nil
+	}
+	return syncMatch, err
 }
 
 // newChildContext creates a child context with desired timeout.
@@ -89,14 +89,14 @@ func TestDeliverBufferTasks(t *testing.T) {
 	defer controller.Finish()
 
 	tests := []func(tlm *taskQueueManagerImpl){
-		func(tlm *taskQueueManagerImpl) { close(tlm.taskReader.taskBuffer) },
+		func(tlm *taskQueueManagerImpl) { tlm.taskReader.taskBuffer.close() },
 		func(tlm *taskQueueManagerImpl) { tlm.taskReader.gorogrp.Cancel() },
 		func(tlm *taskQueueManagerImpl) {
 			rps := 0.1
 			tlm.matcher.UpdateRatelimit(&rps)
-			tlm.taskReader.taskBuffer <- &persistencespb.AllocatedTaskInfo{
+			require.NoError(t, tlm.taskReader.taskBuffer.add(context.Background(), &persistencespb.AllocatedTaskInfo{
 				Data: &persistencespb.TaskInfo{},
-			}
+			}))
 			err := tlm.matcher.rateLimiter.Wait(context.Background()) // consume the token
 			assert.NoError(t, err)
 			tlm.taskReader.gorogrp.Cancel()
@@ -116,9 +116,9 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	defer controller.Finish()
 
 	tlm := mustCreateTestTaskQueueManager(t, controller)
-	tlm.taskReader.taskBuffer <- &persistencespb.AllocatedTaskInfo{
+	require.NoError(t, tlm.taskReader.taskBuffer.add(context.Background(), &persistencespb.AllocatedTaskInfo{
 		Data: &persistencespb.TaskInfo{},
-	}
+	}))
 	tlm.taskReader.gorogrp.Go(tlm.taskReader.dispatchBufferedTasks)
 	time.Sleep(100 * time.Millisecond) // let go routine run first and block on tasksForPoll
 	tlm.taskReader.gorogrp.Cancel()
@@ -137,13 +137,13 @@ func TestDeliverBufferTasks_DisableUserData_SendsVersionedToDlq(t *testing.T) {
 	scope := tally.NewTestScope("test", nil)
 	tlm.metricsHandler = metrics.NewTallyMetricsHandler(metrics.ClientConfig{}, scope)
 
-	tlm.taskReader.taskBuffer <- &persistencespb.AllocatedTaskInfo{
+	require.NoError(t, tlm.taskReader.taskBuffer.add(context.Background(), &persistencespb.AllocatedTaskInfo{
 		Data: &persistencespb.TaskInfo{
 			VersionDirective: &taskqueue.TaskVersionDirective{
 				Value: &taskqueue.TaskVersionDirective_BuildId{BuildId: "asdf"},
 			},
 		},
-	}
+	}))
 
 	tlm.SetInitializedError(nil)
 	tlm.SetUserDataState(userDataDisabled, nil)
@@ -173,14 +173,14 @@ func TestDeliverBufferTasks_DisableUserData_SendsDefaultToUnversioned(t *testing
 	scope := tally.NewTestScope("test", nil)
 	tlm.metricsHandler = metrics.NewTallyMetricsHandler(metrics.ClientConfig{}, scope)
 
-	tlm.taskReader.taskBuffer <- &persistencespb.AllocatedTaskInfo{
+	require.NoError(t, tlm.taskReader.taskBuffer.add(context.Background(), &persistencespb.AllocatedTaskInfo{
 		Data: &persistencespb.TaskInfo{
 			CreateTime: timestamp.TimePtr(time.Now().UTC()),
 			VersionDirective: &taskqueue.TaskVersionDirective{
 				Value: &taskqueue.TaskVersionDirective_UseDefault{UseDefault: &emptypb.Empty{}},
 			},
 		},
-	}
+	}))
 
 	tlm.SetInitializedError(nil)
 	tlm.SetUserDataState(userDataDisabled, nil)
@@ -49,8 +49,8 @@ const (
 type (
 	taskReader struct {
 		status        int32
-		taskBuffer    chan *persistencespb.AllocatedTaskInfo // tasks loaded from persistence
-		notifyC       chan struct{}                          // Used as signal to notify pump of new tasks
+		taskBuffer    *priorityBuffer // tasks loaded from persistence
+		notifyC       chan struct{}   // Used as signal to notify pump of new tasks
 		tlMgr         *taskQueueManagerImpl
 		taskValidator taskValidator
 		gorogrp       goro.Group
@@ -69,7 +69,7 @@ func newTaskReader(tlMgr *taskQueueManagerImpl) *taskReader {
 		notifyC:       make(chan struct{}, 1),
 		// we always dequeue the head of the buffer and try to dispatch it to a poller
 		// so allocate one less than desired target buffer size
-		taskBuffer: make(chan *persistencespb.AllocatedTaskInfo, tlMgr.config.GetTasksBatchSize()-1),
+		taskBuffer: newPriorityBuffer(tlMgr.config.GetTasksBatchSize()-1, tlMgr.config.PriorityWeightRatio, tlMgr.config.FairnessKeyWeight),
 		retrier: backoff.NewRetrier(
 			common.CreateReadTaskRetryPolicy(),
 			backoff.SystemClock,
@@ -118,39 +118,38 @@ func (tr *taskReader) dispatchBufferedTasks(ctx context.Context) error {
 
 dispatchLoop:
 	for ctx.Err() == nil {
-		select {
-		case taskInfo, ok := <-tr.taskBuffer:
-			if !ok { // Task queue getTasks pump is shutdown
-				break dispatchLoop
+		taskInfo, ok, err := tr.taskBuffer.next(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok { // Task queue getTasks pump is shutdown
+			break dispatchLoop
+		}
+		task := newInternalTask(taskInfo, tr.tlMgr.completeTask, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
+		for ctx.Err() == nil {
+			if !tr.taskValidator.maybeValidate(taskInfo, tr.tlMgr.taskQueueID.taskType) {
+				task.finish(nil)
+				tr.taggedMetricsHandler().Counter(metrics.ExpiredTasksPerTaskQueueCounter.Name()).Record(1)
+				// Don't try to set read level here because it may have been advanced already.
+				continue dispatchLoop
 			}
-			task := newInternalTask(taskInfo, tr.tlMgr.completeTask, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
-			for ctx.Err() == nil {
-				if !tr.taskValidator.maybeValidate(taskInfo, tr.tlMgr.taskQueueID.taskType) {
-					task.finish(nil)
-					tr.taggedMetricsHandler().Counter(metrics.ExpiredTasksPerTaskQueueCounter.Name()).Record(1)
-					// Don't try to set read level here because it may have been advanced already.
-					continue dispatchLoop
-				}
 
-				taskCtx, cancel := context.WithTimeout(ctx, taskReaderOfferTimeout)
-				err := tr.tlMgr.engine.DispatchSpooledTask(taskCtx, task, tr.tlMgr.taskQueueID, tr.tlMgr.stickyInfo)
-				cancel()
-				if err == nil {
-					continue dispatchLoop
-				}
+			taskCtx, cancel := context.WithTimeout(ctx, taskReaderOfferTimeout)
+			err := tr.tlMgr.engine.DispatchSpooledTask(taskCtx, task, tr.tlMgr.taskQueueID, tr.tlMgr.stickyInfo)
+			cancel()
+			if err == nil {
+				continue dispatchLoop
+			}
 
-				// if task is still valid (truly valid or unable to verify if task is valid)
-				tr.taggedMetricsHandler().Counter(metrics.BufferThrottlePerTaskQueueCounter.Name()).Record(1)
-				if !errors.Is(err, errUserDataDisabled) && !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled) {
-					// Don't log here if encounters missing user data error when dispatch a versioned task.
-					tr.throttledLogger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
-				}
-				common.InterruptibleSleep(ctx, taskReaderOfferThrottleWait)
+			// if task is still valid (truly valid or unable to verify if task is valid)
+			tr.taggedMetricsHandler().Counter(metrics.BufferThrottlePerTaskQueueCounter.Name()).Record(1)
+			if !errors.Is(err, errUserDataDisabled) && !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled) {
+				// Don't log here if encounters missing user data error when dispatch a versioned task.
+				tr.throttledLogger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
 			}
-			return ctx.Err()
-		case <-ctx.Done():
-			return ctx.Err()
+			common.InterruptibleSleep(ctx, taskReaderOfferThrottleWait)
 		}
+		return ctx.Err()
 	}
 	return ctx.Err()
 }
@@ -299,12 +298,7 @@ func (tr *taskReader) addSingleTaskToBuffer(
 	task *persistencespb.AllocatedTaskInfo,
 ) error {
 	tr.tlMgr.taskAckManager.addTask(task.GetTaskId())
-	select {
-	case tr.taskBuffer <- task:
-		return nil
-	case <-ctx.Done():
-		return ctx.Err()
-	}
+	return tr.taskBuffer.add(ctx, task)
 }
 
 func (tr *taskReader) persistAckLevel(ctx context.Context) error {
@@ -29,17 +29,22 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	updatepb "go.temporal.io/api/update/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
 	sdkclient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
 	"golang.org/x/time/rate"
 
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -52,12 +57,52 @@ import (
 
 const (
 	pageSize = 1000
+	// maxRecordedFailures limits the size of HeartBeatDetails.Failures.
+	maxRecordedFailures = 1000
+	// errTypeFailuresTruncated is the type of the error returned when retrying the failures of a
+	// batch operation that didn't record all of them.
+	errTypeFailuresTruncated = "BatchFailuresTruncated"
 )
 
 var (
 	errNamespaceMismatch = errors.New("namespace mismatch")
+
+	// how often a running batch activity picks up changes to its BatchControl
+	controlPollInterval = 5 * time.Second
 )
 
+// pauseGate blocks task processors while the batch operation is paused.
+type pauseGate struct {
+	sync.Mutex
+	resumed chan struct{} // nil if not paused
+}
+
+func (g *pauseGate) set(paused bool) {
+	g.Lock()
+	defer g.Unlock()
+	if paused && g.resumed == nil {
+		g.resumed = make(chan struct{})
+	} else if !paused && g.resumed != nil {
+		close(g.resumed)
+		g.resumed = nil
+	}
+}
+
+func (g *pauseGate) wait(ctx context.Context) error {
+	g.Lock()
+	resumed := g.resumed
+	g.Unlock()
+	if resumed == nil {
+		return nil
+	}
+	select {
+	case <-resumed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 type activities struct {
 	activityDeps
 	namespace   namespace.Name
@@ -66,6 +111,13 @@ type activities struct {
 	concurrency dynamicconfig.IntPropertyFnWithNamespaceFilter
 }
 
+func (a *activities) getTargetNamespaceID(namespaceName string) (namespace.ID, error) {
+	if namespaceName == a.namespace.String() {
+		return a.namespaceID, nil
+	}
+	return a.NamespaceRegistry.GetNamespaceID(namespace.Name(namespaceName))
+}
+
 func (a *activities) checkNamespace(namespace string) error {
 	// Ignore system namespace for backward compatibility.
 	// TODO: Remove the system namespace special handling after 1.19+
@@ -96,6 +148,17 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 		}
 	}
 
+	namespaceID := a.namespaceID
+	if batchParams.BatchType == BatchTypeUpsertMemo {
+		// this goes to the history service, which needs the ID of the target namespace
+		var err error
+		if namespaceID, err = a.getTargetNamespaceID(batchParams.Namespace); err != nil {
+			metricsHandler.Counter(metrics.BatcherOperationFailures.Name()).Record(1)
+			logger.Error("Failed to get namespace ID", tag.Error(err))
+			return hbd, err
+		}
+	}
+
 	sdkClient := a.ClientFactory.NewClient(sdkclient.Options{
 		Namespace:     batchParams.Namespace,
 		DataConverter: sdk.PreferProtoDataConverter,
@@ -104,11 +167,24 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 	if activity.HasHeartbeatDetails(ctx) {
 		if err := activity.GetHeartbeatDetails(ctx, &hbd); err == nil {
 			startOver = false
+			// the current page is redone from the beginning
+			hbd.PageSuccessCount = 0
+			hbd.PageErrorCount = 0
 		} else {
 			logger.Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
 		}
 	}
 
+	if len(batchParams.RetryFailedOf) > 0 {
+		executions, err := getFailedExecutions(ctx, sdkClient, batchParams.RetryFailedOf)
+		if err != nil {
+			metricsHandler.Counter(metrics.BatcherOperationFailures.Name()).Record(1)
+			logger.Error("Failed to get failed items of previous batch operation", tag.Error(err))
+			return HeartBeatDetails{}, err
+		}
+		batchParams.Executions = executions
+	}
+
 	if startOver {
 		estimateCount := int64(len(batchParams.Executions))
 		if len(batchParams.Query) > 0 {
@@ -128,12 +204,21 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 	rateLimit := rate.Limit(rps)
 	burstLimit := int(math.Ceil(rps)) // should never be zero because everything would be rejected
 	rateLimiter := rate.NewLimiter(rateLimit, burstLimit)
+	gate := &pauseGate{}
+	var applied atomic.Pointer[BatchControl]
+	applied.Store(&BatchControl{RPS: rps})
+	go a.watchControl(ctx, sdkClient, rateLimiter, gate, &applied, logger)
+
 	taskCh := make(chan taskDetail, pageSize)
-	respCh := make(chan error, pageSize)
+	respCh := make(chan taskResult, pageSize)
 	for i := 0; i < a.getOperationConcurrency(batchParams.Concurrency); i++ {
-		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, sdkClient, a.FrontendClient, metricsHandler, logger)
+		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, gate, sdkClient, a.FrontendClient, a.HistoryClient, namespaceID, metricsHandler, logger)
 	}
 
+	// heartbeat regularly since no progress may be made while paused
+	heartbeatTicker := time.NewTicker(batchParams.ActivityHeartBeatTimeout / 2)
+	defer heartbeatTicker.Stop()
+
 	for {
 		executions := batchParams.Executions
 		pageToken := hbd.PageToken
@@ -163,25 +248,31 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 			taskCh <- taskDetail{
 				execution: wf,
 				attempts:  1,
-				hbd:       hbd,
 			}
 		}
 
-		succCount := 0
-		errCount := 0
+		var pageFailures []BatchItemFailure
 		// wait for counters indicate this batch is done
 	Loop:
 		for {
 			select {
-			case err := <-respCh:
-				if err == nil {
-					succCount++
+			case res := <-respCh:
+				if res.err == nil {
+					hbd.PageSuccessCount++
 				} else {
-					errCount++
+					hbd.PageErrorCount++
+					pageFailures = append(pageFailures, BatchItemFailure{
+						WorkflowID: res.execution.GetWorkflowId(),
+						RunID:      res.execution.GetRunId(),
+						Error:      res.err.Error(),
+					})
 				}
-				if succCount+errCount == batchCount {
+				if hbd.PageSuccessCount+hbd.PageErrorCount == batchCount {
 					break Loop
 				}
+			case <-heartbeatTicker.C:
+				hbd.setControl(applied.Load())
+				activity.RecordHeartbeat(ctx, hbd)
 			case <-ctx.Done():
 				metricsHandler.Counter(metrics.BatcherOperationFailures.Name()).Record(1)
 				logger.Error("Failed to complete batch operation", tag.Error(ctx.Err()))
@@ -191,8 +282,12 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 
 		hbd.CurrentPage++
 		hbd.PageToken = pageToken
-		hbd.SuccessCount += succCount
-		hbd.ErrorCount += errCount
+		hbd.SuccessCount += hbd.PageSuccessCount
+		hbd.ErrorCount += hbd.PageErrorCount
+		hbd.PageSuccessCount = 0
+		hbd.PageErrorCount = 0
+		hbd.recordFailures(pageFailures)
+		hbd.setControl(applied.Load())
 		activity.RecordHeartbeat(ctx, hbd)
 
 		if len(hbd.PageToken) == 0 {
@@ -203,6 +298,76 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 	return hbd, nil
 }
 
+func (hbd *HeartBeatDetails) recordFailures(failures []BatchItemFailure) {
+	if room := maxRecordedFailures - len(hbd.Failures); len(failures) > room {
+		failures = failures[:max(room, 0)]
+		hbd.FailuresTruncated = true
+	}
+	hbd.Failures = append(hbd.Failures, failures...)
+}
+
+func (hbd *HeartBeatDetails) setControl(control *BatchControl) {
+	hbd.Paused = control.Paused
+	hbd.RPS = control.RPS
+}
+
+// getFailedExecutions returns the failed items of a completed batch operation.
+func getFailedExecutions(ctx context.Context, sdkClient sdkclient.Client, jobID string) ([]*commonpb.WorkflowExecution, error) {
+	var result HeartBeatDetails
+	if err := sdkClient.GetWorkflow(ctx, jobID, "").Get(ctx, &result); err != nil {
+		return nil, err
+	}
+	if result.FailuresTruncated {
+		// retrying only the recorded failures would silently skip the rest
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("batch operation %s recorded only %d of its %d failures", jobID, len(result.Failures), result.ErrorCount),
+			errTypeFailuresTruncated,
+			nil,
+		)
+	}
+	executions := make([]*commonpb.WorkflowExecution, len(result.Failures))
+	for i, failure := range result.Failures {
+		executions[i] = &commonpb.WorkflowExecution{WorkflowId: failure.WorkflowID, RunId: failure.RunID}
+	}
+	return executions, nil
+}
+
+// watchControl applies the BatchControl of the batch operation workflow until ctx is done.
+func (a *activities) watchControl(
+	ctx context.Context,
+	sdkClient sdkclient.Client,
+	limiter *rate.Limiter,
+	gate *pauseGate,
+	applied *atomic.Pointer[BatchControl],
+	logger log.Logger,
+) {
+	info := activity.GetInfo(ctx)
+	ticker := time.NewTicker(controlPollInterval)
+	defer ticker.Stop()
+	for {
+		var control BatchControl
+		value, err := sdkClient.QueryWorkflow(ctx, info.WorkflowExecution.ID, info.WorkflowExecution.RunID, QueryTypeControl)
+		if err == nil {
+			err = value.Get(&control)
+		}
+		if err == nil {
+			gate.set(control.Paused)
+			rps := a.getOperationRPS(control.RPS)
+			limiter.SetLimit(rate.Limit(rps))
+			limiter.SetBurst(int(math.Ceil(rps)))
+			applied.Store(&BatchControl{Paused: control.Paused, RPS: rps})
+		} else if ctx.Err() == nil {
+			logger.Warn("Failed to query batch operation control", tag.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func (a *activities) getActivityLogger(ctx context.Context) log.Logger {
 	wfInfo := activity.GetInfo(ctx)
 	return log.With(
@@ -232,10 +397,13 @@ func startTaskProcessor(
 	ctx context.Context,
 	batchParams BatchParams,
 	taskCh chan taskDetail,
-	respCh chan error,
+	respCh chan taskResult,
 	limiter *rate.Limiter,
+	gate *pauseGate,
 	sdkClient sdkclient.Client,
 	frontendClient workflowservice.WorkflowServiceClient,
+	historyClient historyservice.HistoryServiceClient,
+	namespaceID namespace.ID,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) {
@@ -251,17 +419,17 @@ func startTaskProcessor(
 
 			switch batchParams.BatchType {
 			case BatchTypeTerminate:
-				err = processTask(ctx, limiter, task,
+				err = processTask(ctx, limiter, gate, task,
 					func(workflowID, runID string) error {
 						return sdkClient.TerminateWorkflow(ctx, workflowID, runID, batchParams.Reason)
 					})
 			case BatchTypeCancel:
-				err = processTask(ctx, limiter, task,
+				err = processTask(ctx, limiter, gate, task,
 					func(workflowID, runID string) error {
 						return sdkClient.CancelWorkflow(ctx, workflowID, runID)
 					})
 			case BatchTypeSignal:
-				err = processTask(ctx, limiter, task,
+				err = processTask(ctx, limiter, gate, task,
 					func(workflowID, runID string) error {
 						_, err := frontendClient.SignalWorkflowExecution(ctx, &workflowservice.SignalWorkflowExecutionRequest{
 							Namespace: batchParams.Namespace,
@@ -275,7 +443,7 @@ func startTaskProcessor(
 						return err
 					})
 			case BatchTypeDelete:
-				err = processTask(ctx, limiter, task,
+				err = processTask(ctx, limiter, gate, task,
 					func(workflowID, runID string) error {
 						_, err := frontendClient.DeleteWorkflowExecution(ctx, &workflowservice.DeleteWorkflowExecutionRequest{
 							Namespace: batchParams.Namespace,
@@ -287,7 +455,7 @@ func startTaskProcessor(
 						return err
 					})
 			case BatchTypeReset:
-				err = processTask(ctx, limiter, task,
+				err = processTask(ctx, limiter, gate, task,
 					func(workflowID, runID string) error {
 						workflowExecution := &commonpb.WorkflowExecution{
 							WorkflowId: workflowID,
@@ -319,6 +487,24 @@ func startTaskProcessor(
 						})
 						return err
 					})
+			case BatchTypeUpsertMemo:
+				err = processTask(ctx, limiter, gate, task,
+					func(workflowID, runID string) error {
+						_, err := historyClient.UpsertWorkflowProperties(ctx, &historyservice.UpsertWorkflowPropertiesRequest{
+							NamespaceId: namespaceID.String(),
+							WorkflowExecution: &commonpb.WorkflowExecution{
+								WorkflowId: workflowID,
+								RunId:      runID,
+							},
+							Memo: batchParams.UpsertMemoParams.Memo,
+						})
+						return err
+					})
+			case BatchTypeUpdate:
+				err = processTask(ctx, limiter, gate, task,
+					func(workflowID, runID string) error {
+						return updateWorkflow(ctx, batchParams, activity.GetInfo(ctx).WorkflowExecution.ID, workflowID, runID, frontendClient)
+					})
 			}
 			if err != nil {
 				metricsHandler.Counter(metrics.BatcherProcessorFailures.Name()).Record(1)
@@ -326,7 +512,7 @@ func startTaskProcessor(
 
 				_, ok := batchParams._nonRetryableErrors[err.Error()]
 				if ok || task.attempts > batchParams.AttemptsOnRetryableError {
-					respCh <- err
+					respCh <- taskResult{execution: task.execution, err: err}
 				} else {
 					// put back to the channel if less than attemptsOnError
 					task.attempts++
@@ -334,24 +520,70 @@ func startTaskProcessor(
 				}
 			} else {
 				metricsHandler.Counter(metrics.BatcherProcessorSuccess.Name()).Record(1)
-				respCh <- nil
+				respCh <- taskResult{execution: task.execution}
 			}
 		}
 	}
 }
 
+// updateWorkflow sends the update of batchParams to a workflow and waits for it to complete.
+// The update ID is derived from the batch job ID so that retries don't deliver the update twice.
+func updateWorkflow(
+	ctx context.Context,
+	batchParams BatchParams,
+	jobID string,
+	workflowID string,
+	runID string,
+	frontendClient workflowservice.WorkflowServiceClient,
+) error {
+	resp, err := frontendClient.UpdateWorkflowExecution(ctx, &workflowservice.UpdateWorkflowExecutionRequest{
+		Namespace: batchParams.Namespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		WaitPolicy: &updatepb.WaitPolicy{
+			LifecycleStage: enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED,
+		},
+		Request: &updatepb.Request{
+			Meta: &updatepb.Meta{
+				UpdateId: fmt.Sprintf("%s/%s/%s", jobID, workflowID, runID),
+				Identity: jobID,
+			},
+			Input: &updatepb.Input{
+				Name: batchParams.UpdateParams.UpdateName,
+				Args: batchParams.UpdateParams.Input,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if failure := resp.GetOutcome().GetFailure(); failure != nil {
+		return fmt.Errorf("update failed: %s", failure.GetMessage())
+	}
+	if resp.GetOutcome() == nil {
+		return errors.New("update did not complete")
+	}
+	return nil
+}
+
 func processTask(
 	ctx context.Context,
 	limiter *rate.Limiter,
+	gate *pauseGate,
 	task taskDetail,
 	procFn func(string, string) error,
 ) error {
 
-	err := limiter.Wait(ctx)
+	err := gate.wait(ctx)
+	if err != nil {
+		return err
+	}
+	err = limiter.Wait(ctx)
 	if err != nil {
 		return err
 	}
-	activity.RecordHeartbeat(ctx, task.hbd)
 
 	err = procFn(task.execution.GetWorkflowId(), task.execution.GetRunId())
 	if err != nil {
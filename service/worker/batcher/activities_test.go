@@ -34,15 +34,20 @@ import (
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
 	history "go.temporal.io/api/history/v1"
+	updatepb "go.temporal.io/api/update/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/api/workflowservicemock/v1"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
 	"golang.org/x/exp/slices"
+	"google.golang.org/grpc"
 
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/testing/mocksdk"
 )
 
 type activitiesSuite struct {
@@ -312,3 +317,93 @@ func (s *activitiesSuite) TestGetResetPoint() {
 		})
 	}
 }
+
+func (s *activitiesSuite) TestPauseGate() {
+	gate := &pauseGate{}
+	s.NoError(gate.wait(context.Background()))
+
+	gate.set(true)
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	s.ErrorIs(gate.wait(timeoutCtx), context.DeadlineExceeded)
+
+	done := make(chan error)
+	go func() { done <- gate.wait(context.Background()) }()
+	gate.set(false)
+	s.NoError(<-done)
+}
+
+func (s *activitiesSuite) TestRecordFailures() {
+	var hbd HeartBeatDetails
+	failures := make([]BatchItemFailure, maxRecordedFailures-1)
+	hbd.recordFailures(failures)
+	s.Len(hbd.Failures, maxRecordedFailures-1)
+	s.False(hbd.FailuresTruncated)
+
+	hbd.recordFailures(make([]BatchItemFailure, 2))
+	s.Len(hbd.Failures, maxRecordedFailures)
+	s.True(hbd.FailuresTruncated)
+
+	hbd.recordFailures(make([]BatchItemFailure, 1))
+	s.Len(hbd.Failures, maxRecordedFailures)
+}
+
+func (s *activitiesSuite) TestUpdateWorkflow() {
+	ctx := context.Background()
+	batchParams := BatchParams{
+		Namespace:    "test-namespace",
+		BatchType:    BatchTypeUpdate,
+		UpdateParams: UpdateParams{UpdateName: "tag"},
+	}
+
+	s.mockFrontendClient.EXPECT().UpdateWorkflowExecution(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *workflowservice.UpdateWorkflowExecutionRequest, _ ...grpc.CallOption) (*workflowservice.UpdateWorkflowExecutionResponse, error) {
+			s.Equal("test-namespace", request.Namespace)
+			s.Equal("tag", request.Request.Input.Name)
+			s.Equal("job-id/wf-id/run-id", request.Request.Meta.UpdateId)
+			s.Equal(enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED, request.WaitPolicy.LifecycleStage)
+			return &workflowservice.UpdateWorkflowExecutionResponse{
+				Outcome: &updatepb.Outcome{Value: &updatepb.Outcome_Success{}},
+			}, nil
+		})
+	s.NoError(updateWorkflow(ctx, batchParams, "job-id", "wf-id", "run-id", s.mockFrontendClient))
+
+	s.mockFrontendClient.EXPECT().UpdateWorkflowExecution(ctx, gomock.Any()).Return(
+		&workflowservice.UpdateWorkflowExecutionResponse{
+			Outcome: &updatepb.Outcome{Value: &updatepb.Outcome_Failure{Failure: &failurepb.Failure{Message: "rejected"}}},
+		}, nil)
+	err := updateWorkflow(ctx, batchParams, "job-id", "wf-id", "run-id", s.mockFrontendClient)
+	s.ErrorContains(err, "rejected")
+}
+
+func (s *activitiesSuite) TestGetFailedExecutions() {
+	ctx := context.Background()
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	mockWfRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockSdkClient.EXPECT().GetWorkflow(ctx, "job-id", "").Return(mockWfRun).Times(2)
+
+	mockWfRun.EXPECT().Get(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, valuePtr interface{}) error {
+		*valuePtr.(*HeartBeatDetails) = HeartBeatDetails{
+			ErrorCount: 1,
+			Failures:   []BatchItemFailure{{WorkflowID: "wf-id", RunID: "run-id", Error: "failed"}},
+		}
+		return nil
+	})
+	executions, err := getFailedExecutions(ctx, mockSdkClient, "job-id")
+	s.NoError(err)
+	s.Equal([]*commonpb.WorkflowExecution{{WorkflowId: "wf-id", RunId: "run-id"}}, executions)
+
+	mockWfRun.EXPECT().Get(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, valuePtr interface{}) error {
+		*valuePtr.(*HeartBeatDetails) = HeartBeatDetails{
+			ErrorCount:        maxRecordedFailures + 1,
+			Failures:          make([]BatchItemFailure, maxRecordedFailures),
+			FailuresTruncated: true,
+		}
+		return nil
+	})
+	_, err = getFailedExecutions(ctx, mockSdkClient, "job-id")
+	var appErr *temporal.ApplicationError
+	s.ErrorAs(err, &appErr)
+	s.True(appErr.NonRetryable())
+	s.Equal(errTypeFailuresTruncated, appErr.Type())
+}
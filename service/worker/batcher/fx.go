@@ -34,6 +34,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/sdk"
 	workercommon "go.temporal.io/server/service/worker/common"
 )
@@ -57,10 +58,12 @@ type (
 
 	activityDeps struct {
 		fx.In
-		MetricsHandler metrics.Handler
-		Logger         log.Logger
-		ClientFactory  sdk.ClientFactory
-		FrontendClient workflowservice.WorkflowServiceClient
+		MetricsHandler    metrics.Handler
+		Logger            log.Logger
+		ClientFactory     sdk.ClientFactory
+		FrontendClient    workflowservice.WorkflowServiceClient
+		HistoryClient     resource.HistoryClient
+		NamespaceRegistry namespace.Registry
 	}
 
 	fxResult struct {
@@ -61,6 +61,25 @@ const (
 	BatchTypeDelete = "delete"
 	// BatchTypeReset is batch type for resetting workflows
 	BatchTypeReset = "reset"
+	// BatchTypeUpsertMemo is batch type for upserting memo of workflows.
+	// StartBatchOperationRequest has no operation for this, so it's only available by starting
+	// BatchWorkflow directly.
+	BatchTypeUpsertMemo = "upsert_memo"
+	// BatchTypeUpdate is batch type for sending an update to workflows.
+	// StartBatchOperationRequest has no operation for this, so it's only available by starting
+	// BatchWorkflow directly.
+	BatchTypeUpdate = "update"
+
+	// SignalNamePause pauses a running batch operation. Items that are already being processed
+	// are finished.
+	SignalNamePause = "pause"
+	// SignalNameResume resumes a paused batch operation.
+	SignalNameResume = "resume"
+	// SignalNameUpdateRPS changes the requests-per-second limit of a running batch operation.
+	// The signal input is the new limit as a float64, or 0 to use the default.
+	SignalNameUpdateRPS = "update-rps"
+	// QueryTypeControl returns the current BatchControl of a batch operation.
+	QueryTypeControl = "control"
 )
 
 var (
@@ -103,6 +122,19 @@ type (
 		ResetReapplyType enumspb.ResetReapplyType
 	}
 
+	// UpsertMemoParams is the parameters for upserting memo of workflows.
+	// Each workflow gets a WorkflowPropertiesModifiedExternally event and a workflow task.
+	UpsertMemoParams struct {
+		Memo *commonpb.Memo
+	}
+
+	// UpdateParams is the parameters for sending an update to workflows.
+	// An item succeeds if the update completes successfully.
+	UpdateParams struct {
+		UpdateName string
+		Input      *commonpb.Payloads
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target namespace to execute batch operation
@@ -111,9 +143,15 @@ type (
 		Query string
 		// Target workflows for processing
 		Executions []*commonpb.WorkflowExecution
+		// Job ID of a completed batch operation. If set, only the failed items recorded by
+		// that operation are processed. The operation fails if that operation recorded only
+		// part of its failures, see HeartBeatDetails.FailuresTruncated.
+		// StartBatchOperationRequest has no field for this, so it's only available by starting
+		// BatchWorkflow directly.
+		RetryFailedOf string
 		// Reason for the operation
 		Reason string
-		// Supporting: signal,cancel,terminate,delete,reset
+		// Supporting: signal,cancel,terminate,delete,reset,upsert_memo,update
 		BatchType string
 
 		// Below are all optional
@@ -127,6 +165,10 @@ type (
 		DeleteParams DeleteParams
 		// ResetParams is params only for BatchTypeReset
 		ResetParams ResetParams
+		// UpsertMemoParams is params only for BatchTypeUpsertMemo
+		UpsertMemoParams UpsertMemoParams
+		// UpdateParams is params only for BatchTypeUpdate
+		UpdateParams UpdateParams
 		// RPS sets the requests-per-second limit for the batch.
 		// The default (and max) is defined by `worker.BatcherRPS` in the dynamic config.
 		RPS float64
@@ -154,13 +196,43 @@ type (
 		SuccessCount int
 		// Number of workflows that give up due to errors.
 		ErrorCount int
+		// Workflows that give up due to errors, up to maxRecordedFailures.
+		Failures []BatchItemFailure
+		// Whether more workflows gave up than are recorded in Failures.
+		FailuresTruncated bool
+		// Progress within the current page. The counts above and the page token are only
+		// updated when a page is done, so that if the activity is restarted, it can redo the
+		// current page from the beginning.
+		PageSuccessCount int
+		PageErrorCount   int
+		// The BatchControl last applied by the activity. It can lag the signals sent to the
+		// workflow by up to controlPollInterval.
+		Paused bool
+		RPS    float64
+	}
+
+	// BatchItemFailure is a workflow that a batch operation gave up on.
+	BatchItemFailure struct {
+		WorkflowID string
+		RunID      string
+		Error      string
+	}
+
+	// BatchControl is the state of a batch operation that can be changed while it's running.
+	BatchControl struct {
+		Paused bool
+		// RPS overrides BatchParams.RPS. It's still limited by `worker.BatcherRPS`.
+		RPS float64
 	}
 
 	taskDetail struct {
 		execution *commonpb.WorkflowExecution
 		attempts  int
-		// passing along the current heartbeat details to make heartbeat within a task so that it won't timeout
-		hbd HeartBeatDetails
+	}
+
+	taskResult struct {
+		execution *commonpb.WorkflowExecution
+		err       error
 	}
 )
 
@@ -186,6 +258,16 @@ func BatchWorkflow(ctx workflow.Context, batchParams BatchParams) (HeartBeatDeta
 		return HeartBeatDetails{}, err
 	}
 
+	control := BatchControl{RPS: batchParams.RPS}
+	if err := workflow.SetQueryHandler(ctx, QueryTypeControl, func() (BatchControl, error) {
+		return control, nil
+	}); err != nil {
+		return HeartBeatDetails{}, err
+	}
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		handleControlSignals(ctx, &control)
+	})
+
 	batchActivityOptions.HeartbeatTimeout = batchParams.ActivityHeartBeatTimeout
 	opt := workflow.WithActivityOptions(ctx, batchActivityOptions)
 	var result HeartBeatDetails
@@ -202,6 +284,32 @@ func BatchWorkflow(ctx workflow.Context, batchParams BatchParams) (HeartBeatDeta
 	return result, err
 }
 
+// handleControlSignals updates control from signals. The batch activity picks up the changes
+// by querying the workflow.
+func handleControlSignals(ctx workflow.Context, control *BatchControl) {
+	logger := workflow.GetLogger(ctx)
+	sel := workflow.NewSelector(ctx)
+	sel.AddReceive(workflow.GetSignalChannel(ctx, SignalNamePause), func(ch workflow.ReceiveChannel, _ bool) {
+		ch.Receive(ctx, nil)
+		control.Paused = true
+		logger.Info("Batch operation paused")
+	})
+	sel.AddReceive(workflow.GetSignalChannel(ctx, SignalNameResume), func(ch workflow.ReceiveChannel, _ bool) {
+		ch.Receive(ctx, nil)
+		control.Paused = false
+		logger.Info("Batch operation resumed")
+	})
+	sel.AddReceive(workflow.GetSignalChannel(ctx, SignalNameUpdateRPS), func(ch workflow.ReceiveChannel, _ bool) {
+		var rps float64
+		ch.Receive(ctx, &rps)
+		control.RPS = rps
+		logger.Info("Batch operation RPS updated", "rps", rps)
+	})
+	for {
+		sel.Select(ctx)
+	}
+}
+
 type BatchOperationStats struct {
 	NumSuccess int
 	NumFailure int
@@ -223,7 +331,7 @@ func validateParams(params BatchParams) error {
 	if params.BatchType == "" ||
 		params.Reason == "" ||
 		params.Namespace == "" ||
-		(params.Query == "" && len(params.Executions) == 0) {
+		(params.Query == "" && len(params.Executions) == 0 && params.RetryFailedOf == "") {
 		return fmt.Errorf("must provide required parameters: BatchType/Reason/Namespace/Query/Executions")
 	}
 
@@ -231,12 +339,26 @@ func validateParams(params BatchParams) error {
 		return fmt.Errorf("batch query and executions are mutually exclusive")
 	}
 
+	if len(params.RetryFailedOf) > 0 && (len(params.Query) > 0 || len(params.Executions) > 0) {
+		return fmt.Errorf("batch retry of failed items can't be combined with query or executions")
+	}
+
 	switch params.BatchType {
 	case BatchTypeSignal:
 		if params.SignalParams.SignalName == "" {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
+	case BatchTypeUpsertMemo:
+		if len(params.UpsertMemoParams.Memo.GetFields()) == 0 {
+			return fmt.Errorf("must provide memo")
+		}
+		return nil
+	case BatchTypeUpdate:
+		if params.UpdateParams.UpdateName == "" {
+			return fmt.Errorf("must provide update name")
+		}
+		return nil
 	case BatchTypeCancel, BatchTypeTerminate, BatchTypeDelete, BatchTypeReset:
 		return nil
 	default:
@@ -26,6 +26,7 @@ package batcher
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
@@ -120,3 +121,63 @@ func (s *batcherSuite) TestBatchWorkflow_ValidParams_Executions() {
 	err := s.env.GetWorkflowError()
 	s.Require().NoError(err)
 }
+
+func (s *batcherSuite) TestBatchWorkflow_Control() {
+	var ac *activities
+	s.env.OnActivity(ac.BatchActivity, mock.Anything, mock.Anything).After(time.Hour).Return(HeartBeatDetails{}, nil)
+	s.env.OnUpsertMemo(mock.Anything).Return(nil).Once()
+
+	queryControl := func() BatchControl {
+		value, err := s.env.QueryWorkflow(QueryTypeControl)
+		s.Require().NoError(err)
+		var control BatchControl
+		s.Require().NoError(value.Get(&control))
+		return control
+	}
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal(BatchControl{RPS: 10}, queryControl())
+		s.env.SignalWorkflow(SignalNamePause, nil)
+	}, time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal(BatchControl{Paused: true, RPS: 10}, queryControl())
+		s.env.SignalWorkflow(SignalNameUpdateRPS, 2.5)
+		s.env.SignalWorkflow(SignalNameResume, nil)
+	}, 2*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal(BatchControl{RPS: 2.5}, queryControl())
+	}, 3*time.Minute)
+
+	s.env.ExecuteWorkflow(BatchWorkflow, BatchParams{
+		BatchType: BatchTypeTerminate,
+		Reason:    "test-reason",
+		Namespace: "test-namespace",
+		Query:     "test-query",
+		RPS:       10,
+	})
+	s.Require().NoError(s.env.GetWorkflowError())
+}
+
+func (s *batcherSuite) TestBatchWorkflow_RetryFailedOf() {
+	s.env.ExecuteWorkflow(BatchWorkflow, BatchParams{
+		BatchType:     BatchTypeTerminate,
+		Reason:        "test-reason",
+		Namespace:     "test-namespace",
+		Query:         "test-query",
+		RetryFailedOf: "previous-job",
+	})
+	err := s.env.GetWorkflowError()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "can't be combined with query or executions")
+}
+
+func (s *batcherSuite) TestBatchWorkflow_UpsertMemoRequiresMemo() {
+	s.env.ExecuteWorkflow(BatchWorkflow, BatchParams{
+		BatchType: BatchTypeUpsertMemo,
+		Reason:    "test-reason",
+		Namespace: "test-namespace",
+		Query:     "test-query",
+	})
+	err := s.env.GetWorkflowError()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "must provide memo")
+}
@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resolver"
@@ -54,6 +55,7 @@ import (
 	"go.temporal.io/server/service/worker/dlq"
 	"go.temporal.io/server/service/worker/migration"
 	"go.temporal.io/server/service/worker/scheduler"
+	"go.temporal.io/server/service/worker/visibilitymigration"
 )
 
 var Module = fx.Options(
@@ -64,6 +66,7 @@ var Module = fx.Options(
 	scheduler.Module,
 	batcher.Module,
 	dlq.Module,
+	visibilitymigration.Module,
 	fx.Provide(
 		func(c resource.HistoryClient) dlq.HistoryClient {
 			return c
@@ -159,22 +162,31 @@ func VisibilityManagerProvider(
 	persistenceServiceResolver resolver.ServiceResolver,
 	searchAttributesMapperProvider searchattribute.MapperProvider,
 	saProvider searchattribute.Provider,
+	namespaceRegistry namespace.Registry,
 ) (manager.VisibilityManager, error) {
 	return visibility.NewManager(
 		*persistenceConfig,
 		persistenceServiceResolver,
 		customVisibilityStoreFactory,
 		esClient,
-		nil, // worker visibility never write
+		&elasticsearch.ProcessorConfig{
+			IndexerConcurrency:       serviceConfig.IndexerConcurrency,
+			ESProcessorNumOfWorkers:  serviceConfig.ESProcessorNumOfWorkers,
+			ESProcessorBulkActions:   serviceConfig.ESProcessorBulkActions,
+			ESProcessorBulkSize:      serviceConfig.ESProcessorBulkSize,
+			ESProcessorFlushInterval: serviceConfig.ESProcessorFlushInterval,
+			ESProcessorAckTimeout:    serviceConfig.ESProcessorAckTimeout,
+		},
 		saProvider,
 		searchAttributesMapperProvider,
 		serviceConfig.VisibilityPersistenceMaxReadQPS,
 		serviceConfig.VisibilityPersistenceMaxWriteQPS,
 		serviceConfig.OperatorRPSRatio,
-		serviceConfig.EnableReadFromSecondaryVisibility,
-		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff), // worker visibility never write
+		visibility.WithSecondaryVisibilityReadCutover(serviceConfig.EnableReadFromSecondaryVisibility, namespaceRegistry),
+		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff), // worker only writes to secondary visibility explicitly
 		serviceConfig.VisibilityDisableOrderByClause,
 		serviceConfig.VisibilityEnableManualPagination,
+		serviceConfig.VisibilityMaxCountGroups,
 		metricsHandler,
 		logger,
 	)
@@ -34,6 +34,7 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	failurepb "go.temporal.io/api/failure/v1"
+	querypb "go.temporal.io/api/query/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
@@ -45,7 +46,9 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/sdk"
 )
 
 type (
@@ -65,6 +68,10 @@ type (
 	}
 )
 
+const (
+	upstreamRunHistoryPageSize = 100
+)
+
 var (
 	errTryAgain   = errors.New("try again")
 	errWrongChain = errors.New("found running workflow with wrong FirstExecutionRunId")
@@ -154,8 +161,9 @@ func (a *activities) tryWatchWorkflow(ctx context.Context, req *schedspb.WatchWo
 		return nil, errWrongChain
 	}
 
+	var closeTime *timestamppb.Timestamp
 	makeResponse := func(result *commonpb.Payloads, failure *failurepb.Failure) *schedspb.WatchWorkflowResponse {
-		res := &schedspb.WatchWorkflowResponse{Status: pollRes.WorkflowStatus}
+		res := &schedspb.WatchWorkflowResponse{Status: pollRes.WorkflowStatus, CloseTime: closeTime}
 		if result != nil {
 			res.ResultFailure = &schedspb.WatchWorkflowResponse_Result{Result: result}
 		} else if failure != nil {
@@ -192,6 +200,7 @@ func (a *activities) tryWatchWorkflow(ctx context.Context, req *schedspb.WatchWo
 		return nil, errNoEvents
 	}
 	lastEvent := events[0]
+	closeTime = lastEvent.EventTime
 
 	switch pollRes.WorkflowStatus {
 	case enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED:
@@ -259,6 +268,150 @@ func (a *activities) WatchWorkflow(ctx context.Context, req *schedspb.WatchWorkf
 	return nil, translateError(ctx.Err(), "WatchWorkflow")
 }
 
+// WatchUpstreamSchedule waits for the upstream schedule to take an action after req.AfterActionId
+// (or req.After if the id is unknown), and then waits for the workflow it started to close. Errors
+// from the upstream schedule or workflow are logged and retried after the poll interval, since they
+// are usually resolved by the upstream schedule being created or updated.
+func (a *activities) WatchUpstreamSchedule(ctx context.Context, req *schedspb.WatchUpstreamScheduleRequest) (*schedspb.WatchUpstreamScheduleResponse, error) {
+	for ctx.Err() == nil {
+		activity.RecordHeartbeat(ctx)
+		res, err := a.tryWatchUpstreamSchedule(ctx, req)
+		if err == nil && res != nil {
+			return res, nil
+		} else if err != nil && ctx.Err() == nil {
+			a.Logger.Warn("error watching upstream schedule", tag.ScheduleID(req.ScheduleId), tag.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(req.PollInterval.AsDuration()):
+		}
+	}
+	return nil, translateError(ctx.Err(), "WatchUpstreamSchedule")
+}
+
+// tryWatchUpstreamSchedule returns nil if the upstream schedule hasn't taken an action yet.
+func (a *activities) tryWatchUpstreamSchedule(ctx context.Context, req *schedspb.WatchUpstreamScheduleRequest) (*schedspb.WatchUpstreamScheduleResponse, error) {
+	action, err := a.findUpstreamAction(ctx, req)
+	if err != nil || action == nil {
+		return nil, err
+	}
+
+	watchReq := &schedspb.WatchWorkflowRequest{
+		// Note: do not send runid here so that we always get the latest one
+		Execution:           &commonpb.WorkflowExecution{WorkflowId: action.Execution.WorkflowId},
+		FirstExecutionRunId: action.Execution.RunId,
+		LongPoll:            true,
+	}
+	watchRes, err := a.WatchWorkflow(ctx, watchReq)
+	if err != nil {
+		return nil, err
+	}
+	var missed int64
+	if req.AfterActionId > 0 {
+		missed = max(0, action.Id-req.AfterActionId-1)
+	}
+	return &schedspb.WatchUpstreamScheduleResponse{
+		ActionTime:    action.ActualTime,
+		Execution:     action.Execution,
+		Result:        watchRes,
+		ActionId:      action.Id,
+		MissedActions: missed,
+	}, nil
+}
+
+// findUpstreamAction returns the first action of the upstream schedule which wasn't handled yet,
+// or nil if there is none. Recent actions of the upstream schedule are used when they go back
+// far enough, and its run history otherwise, which is usually longer. If neither goes back far
+// enough, the oldest listed action is returned.
+func (a *activities) findUpstreamAction(ctx context.Context, req *schedspb.WatchUpstreamScheduleRequest) (*schedspb.RunRecord, error) {
+	descRes, err := a.FrontendClient.DescribeSchedule(ctx, &workflowservice.DescribeScheduleRequest{
+		Namespace:  a.namespace.String(),
+		ScheduleId: req.ScheduleId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if req.AfterActionId > descRes.GetInfo().GetActionCount() {
+		// the upstream schedule was recreated, fall back to the time of the last handled action
+		req.AfterActionId = 0
+	}
+
+	// recent actions are the last actions in order, so their ids end at the action count
+	recent := descRes.GetInfo().GetRecentActions()
+	firstID := descRes.GetInfo().GetActionCount() - int64(len(recent)) + 1
+	for i, action := range recent {
+		run := &schedspb.RunRecord{Id: firstID + int64(i), ActualTime: action.ActualTime, Execution: action.StartWorkflowResult}
+		if run.Execution == nil || !upstreamActionPending(req, run) {
+			continue
+		}
+		if i > 0 || run.Id == 1 || run.Id == req.AfterActionId+1 {
+			// the previous action was handled
+			return run, nil
+		}
+		// older actions may not have been handled, look further back
+		if older, err := a.findUpstreamRun(ctx, req); err != nil {
+			return nil, err
+		} else if older != nil && older.Id < run.Id {
+			return older, nil
+		}
+		return run, nil
+	}
+	return nil, nil
+}
+
+// findUpstreamRun returns the oldest run in the run history of the upstream schedule which wasn't
+// handled yet, or nil if there is none.
+func (a *activities) findUpstreamRun(ctx context.Context, req *schedspb.WatchUpstreamScheduleRequest) (*schedspb.RunRecord, error) {
+	var oldest *schedspb.RunRecord
+	var nextPageToken []byte
+	for {
+		queryPayload, err := sdk.PreferProtoDataConverter.ToPayloads(&schedspb.RunHistoryRequest{
+			PageSize:      upstreamRunHistoryPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		res, err := a.HistoryClient.QueryWorkflow(ctx, &historyservice.QueryWorkflowRequest{
+			NamespaceId: a.namespaceID.String(),
+			Request: &workflowservice.QueryWorkflowRequest{
+				Namespace: a.namespace.String(),
+				Execution: &commonpb.WorkflowExecution{WorkflowId: WorkflowIDPrefix + req.ScheduleId},
+				Query: &querypb.WorkflowQuery{
+					QueryType: QueryNameRunHistory,
+					QueryArgs: queryPayload,
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		var history schedspb.RunHistoryResponse
+		if err := payloads.Decode(res.GetResponse().GetQueryResult(), &history); err != nil {
+			return nil, err
+		}
+		// runs are newest first
+		for _, run := range history.Runs {
+			if !upstreamActionPending(req, run) {
+				return oldest, nil
+			}
+			oldest = run
+		}
+		if len(history.NextPageToken) == 0 {
+			return oldest, nil
+		}
+		nextPageToken = history.NextPageToken
+	}
+}
+
+func upstreamActionPending(req *schedspb.WatchUpstreamScheduleRequest, run *schedspb.RunRecord) bool {
+	if req.AfterActionId > 0 {
+		return run.Id > req.AfterActionId
+	}
+	return run.ActualTime.AsTime().After(req.After.AsTime())
+}
+
 func (a *activities) CancelWorkflow(ctx context.Context, req *schedspb.CancelWorkflowRequest) error {
 	// TODO: remove after https://github.com/temporalio/sdk-go/issues/1066
 	var cancel context.CancelFunc
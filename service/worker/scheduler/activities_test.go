@@ -0,0 +1,123 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	schedpb "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/api/workflowservicemock/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	schedspb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/payloads"
+)
+
+func TestFindUpstreamAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	frontendClient := workflowservicemock.NewMockWorkflowServiceClient(ctrl)
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+	a := &activities{
+		activityDeps: activityDeps{FrontendClient: frontendClient, HistoryClient: historyClient},
+		namespace:    "ns",
+		namespaceID:  "ns-id",
+	}
+
+	baseTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	run := func(id int64) *schedspb.RunRecord {
+		return &schedspb.RunRecord{
+			Id:         id,
+			ActualTime: timestamppb.New(baseTime.Add(time.Duration(id) * time.Minute)),
+			Execution:  &commonpb.WorkflowExecution{WorkflowId: "wf", RunId: "run"},
+		}
+	}
+	// the upstream schedule took 20 actions, of which the last 10 are recent
+	var recent []*schedpb.ScheduleActionResult
+	for id := int64(11); id <= 20; id++ {
+		r := run(id)
+		recent = append(recent, &schedpb.ScheduleActionResult{ActualTime: r.ActualTime, StartWorkflowResult: r.Execution})
+	}
+	frontendClient.EXPECT().DescribeSchedule(gomock.Any(), gomock.Any()).Return(&workflowservice.DescribeScheduleResponse{
+		Info: &schedpb.ScheduleInfo{ActionCount: 20, RecentActions: recent},
+	}, nil).AnyTimes()
+	// and it keeps a run history of 15, served in pages of 10
+	expectRunHistory := func() {
+		for _, page := range [][]int64{{20, 19, 18, 17, 16, 15, 14, 13, 12, 11}, {10, 9, 8, 7, 6}} {
+			res := &schedspb.RunHistoryResponse{}
+			for _, id := range page {
+				res.Runs = append(res.Runs, run(id))
+			}
+			if page[0] == 20 {
+				res.NextPageToken = []byte("10")
+			}
+			result, err := payloads.Encode(res)
+			require.NoError(t, err)
+			historyClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(&historyservice.QueryWorkflowResponse{
+				Response: &workflowservice.QueryWorkflowResponse{QueryResult: result},
+			}, nil)
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		req           *schedspb.WatchUpstreamScheduleRequest
+		queryHistory  bool
+		expectedID    int64
+		expectedFound bool
+	}{
+		{"recent action", &schedspb.WatchUpstreamScheduleRequest{AfterActionId: 14}, false, 15, true},
+		{"next of the recent actions", &schedspb.WatchUpstreamScheduleRequest{AfterActionId: 10}, false, 11, true},
+		{"no new action", &schedspb.WatchUpstreamScheduleRequest{AfterActionId: 20}, false, 0, false},
+		{"from run history", &schedspb.WatchUpstreamScheduleRequest{AfterActionId: 7}, true, 8, true},
+		{"missed actions", &schedspb.WatchUpstreamScheduleRequest{AfterActionId: 2}, true, 6, true},
+		{"by time", &schedspb.WatchUpstreamScheduleRequest{After: timestamppb.New(baseTime.Add(16 * time.Minute))}, false, 17, true},
+		{"by time from run history", &schedspb.WatchUpstreamScheduleRequest{After: timestamppb.New(baseTime.Add(8 * time.Minute))}, true, 9, true},
+		{"recreated upstream", &schedspb.WatchUpstreamScheduleRequest{AfterActionId: 30, After: timestamppb.New(baseTime.Add(18 * time.Minute))}, false, 19, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.queryHistory {
+				expectRunHistory()
+			}
+			tc.req.ScheduleId = "upstream"
+			if tc.req.After == nil {
+				tc.req.After = timestamppb.New(baseTime)
+			}
+			action, err := a.findUpstreamAction(context.Background(), tc.req)
+			require.NoError(t, err)
+			if !tc.expectedFound {
+				require.Nil(t, action)
+				return
+			}
+			require.Equal(t, tc.expectedID, action.GetId())
+		})
+	}
+}
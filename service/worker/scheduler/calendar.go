@@ -49,6 +49,34 @@ type (
 		// when all fields match.
 		year, month, dayOfMonth, dayOfWeek, hour, minute, second func(int) bool
 	}
+
+	holidayShift int
+
+	// businessCronSpec is a cron string using business day directives
+	businessCronSpec struct {
+		calendar *schedpb.StructuredCalendarSpec
+		tzName   string
+		// 0 for none, n for the nth business day of the month, -1 for the last business day
+		nthBusinessDay int
+		holidays       []string
+		shift          holidayShift
+	}
+
+	// compiledBusinessCalendar matches times of a calendar on business days, i.e. weekdays
+	// which are not holidays in any of the referenced holiday calendars.
+	compiledBusinessCalendar struct {
+		tz *time.Location
+		// date fields of the spec
+		date *compiledCalendar
+		// time of day fields of the spec
+		timeOfDay      *compiledCalendar
+		nthBusinessDay int
+		holidayNames   []string
+		shift          holidayShift
+		holidays       HolidayCalendars
+	}
+
+	resolvedHolidays []*HolidayCalendar
 )
 
 const (
@@ -73,6 +101,24 @@ const (
 	parseModeDow
 )
 
+const (
+	// holidayShiftSkip skips matching days which are holidays
+	holidayShiftSkip holidayShift = iota
+	// holidayShiftNext moves matching days which aren't business days to the next business day
+	holidayShiftNext
+	// holidayShiftPrevious moves matching days which aren't business days to the previous business day
+	holidayShiftPrevious
+)
+
+const (
+	cronHolidaysPrefix   = "HOLIDAYS="
+	cronShiftPrefix      = "SHIFT="
+	lastBusinessDay      = "LBD"
+	businessDaySuffix    = "BD"
+	maxNthBusinessDay    = 23
+	maxNonBusinessDayRun = 31
+)
+
 var (
 	errOutOfRange               = errors.New("out of range")
 	errConflictingTimezoneNames = errors.New("conflicting timezone names")
@@ -237,6 +283,262 @@ Outer:
 	return time.Time{}
 }
 
+// Returns true if the date of the given time matches this calendar spec. The time is
+// interpreted as a civil date, regardless of its location.
+func (cc *compiledCalendar) matchesDate(day time.Time) bool {
+	y, mo, d := day.Date()
+	return cc.year(y) &&
+		cc.month(int(mo)) &&
+		cc.dayOfMonth(d) &&
+		cc.dayOfWeek(int(day.Weekday()))
+}
+
+func newCompiledBusinessCalendar(spec *businessCronSpec, tz *time.Location, holidays HolidayCalendars) *compiledBusinessCalendar {
+	timeOfDay := &schedpb.StructuredCalendarSpec{
+		Second:     spec.calendar.Second,
+		Minute:     spec.calendar.Minute,
+		Hour:       spec.calendar.Hour,
+		DayOfMonth: []*schedpb.Range{{Start: 1, End: 31}},
+		Month:      []*schedpb.Range{{Start: 1, End: 12}},
+		DayOfWeek:  []*schedpb.Range{{Start: 0, End: 6}},
+	}
+	return &compiledBusinessCalendar{
+		tz:             tz,
+		date:           newCompiledCalendar(spec.calendar, tz),
+		timeOfDay:      newCompiledCalendar(timeOfDay, tz),
+		nthBusinessDay: spec.nthBusinessDay,
+		holidayNames:   spec.holidays,
+		shift:          spec.shift,
+		holidays:       holidays,
+	}
+}
+
+// Returns the earliest time that matches this calendar spec that is after the given time.
+// Holiday calendars are resolved on every call, unknown calendars have no holidays.
+func (bc *compiledBusinessCalendar) next(ts time.Time) time.Time {
+	holidays := bc.resolveHolidays()
+
+	y, mo, d := ts.In(bc.tz).Date()
+	// iterate over civil dates in UTC so that days are unaffected by dst transitions
+	day := time.Date(y, mo, d, 0, 0, 0, 0, time.UTC)
+	nthMonth, nthDay := -1, time.Time{}
+	for day.Year() <= maxCalendarYear {
+		if !bc.date.year(day.Year()) {
+			day = time.Date(day.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if bc.nthBusinessDay != 0 {
+			if month := day.Year()*12 + int(day.Month()); month != nthMonth {
+				nthMonth, nthDay = month, holidays.nthBusinessDay(day.Year(), day.Month(), bc.nthBusinessDay)
+			}
+			if !day.Equal(nthDay) {
+				day = day.AddDate(0, 0, 1)
+				continue
+			}
+		}
+		if bc.dateSelected(day, holidays) {
+			// find the first matching time of day on this date after ts
+			dayStart := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, bc.tz)
+			after := dayStart.Add(-time.Second)
+			if ts.After(after) {
+				after = ts
+			}
+			next := bc.timeOfDay.next(after)
+			if next.IsZero() {
+				return next
+			}
+			if ny, nmo, nd := next.In(bc.tz).Date(); ny == day.Year() && nmo == day.Month() && nd == day.Day() {
+				return next
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return time.Time{}
+}
+
+func (bc *compiledBusinessCalendar) dateSelected(day time.Time, holidays resolvedHolidays) bool {
+	if bc.nthBusinessDay != 0 {
+		// dayOfMonth is a wildcard, this checks the remaining fields
+		return bc.date.matchesDate(day)
+	}
+	switch bc.shift {
+	case holidayShiftNext, holidayShiftPrevious:
+		if !holidays.isBusinessDay(day) {
+			return false
+		}
+		if bc.date.matchesDate(day) {
+			return true
+		}
+		// look for a matching day in the run of non-business days before (or after) this one
+		step := -1
+		if bc.shift == holidayShiftPrevious {
+			step = 1
+		}
+		for i, other := 0, day.AddDate(0, 0, step); i < maxNonBusinessDayRun && !holidays.isBusinessDay(other); i, other = i+1, other.AddDate(0, 0, step) {
+			if bc.date.matchesDate(other) {
+				return true
+			}
+		}
+		return false
+	default:
+		return bc.date.matchesDate(day) && !holidays.isHoliday(day)
+	}
+}
+
+func (bc *compiledBusinessCalendar) resolveHolidays() resolvedHolidays {
+	if bc.holidays == nil {
+		return nil
+	}
+	var resolved resolvedHolidays
+	for _, name := range bc.holidayNames {
+		if calendar, ok := bc.holidays.Holidays(name); ok {
+			resolved = append(resolved, calendar)
+		}
+	}
+	return resolved
+}
+
+func (h resolvedHolidays) isHoliday(day time.Time) bool {
+	y, mo, d := day.Date()
+	for _, calendar := range h {
+		if calendar.Contains(y, mo, d) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h resolvedHolidays) isBusinessDay(day time.Time) bool {
+	if wd := day.Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return false
+	}
+	return !h.isHoliday(day)
+}
+
+// Returns the nth business day of the month (the last one for n == -1), or the zero time.
+func (h resolvedHolidays) nthBusinessDay(year int, month time.Month, n int) time.Time {
+	var found time.Time
+	count := 0
+	for day := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC); day.Month() == month; day = day.AddDate(0, 0, 1) {
+		if !h.isBusinessDay(day) {
+			continue
+		}
+		count++
+		found = day
+		if count == n {
+			return day
+		}
+	}
+	if n == -1 {
+		return found
+	}
+	return time.Time{}
+}
+
+// Parses a cron string with business day directives. Returns nil if the cron string has none.
+// In addition to regular cron strings, these accept:
+//
+//   - HOLIDAYS=us,uk      prefix: skip matching days which are holidays in the named holiday calendars.
+//     This only skips holidays: matching weekend days are kept, unless the day of week field
+//     excludes them, e.g. "HOLIDAYS=us 0 9 * * MON-FRI".
+//   - SHIFT=next          prefix: move matching days which aren't business days to the next
+//     (or with SHIFT=previous, the previous) business day instead of skipping holidays
+//   - LBD                 day of month: the last business day of the month
+//   - 3BD                 day of month: the third business day of the month
+//
+// Business days, used by SHIFT, LBD and nBD, are weekdays which aren't holidays.
+//
+// Holiday calendars only apply to the cron string which references them. Structured calendars,
+// intervals and exclusions of a spec can't reference holiday calendars, so a schedule which must
+// skip holidays has to express its times as cron strings with the HOLIDAYS prefix.
+func parseBusinessCronString(c string) (*businessCronSpec, error) {
+	spec := &businessCronSpec{}
+	hasDirectives := false
+
+	c = strings.TrimSpace(c)
+	var prefixes []string
+	for {
+		first, rest, found := strings.Cut(c, " ")
+		upper := strings.ToUpper(first)
+		if !found || !strings.Contains(first, "=") {
+			break
+		}
+		switch {
+		case strings.HasPrefix(upper, cronHolidaysPrefix):
+			for _, name := range strings.Split(first[len(cronHolidaysPrefix):], ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					spec.holidays = append(spec.holidays, name)
+				}
+			}
+			if len(spec.holidays) == 0 {
+				return nil, errors.New("CronString has HOLIDAYS without calendar names")
+			}
+			hasDirectives = true
+		case strings.HasPrefix(upper, cronShiftPrefix):
+			switch strings.ToLower(first[len(cronShiftPrefix):]) {
+			case "next":
+				spec.shift = holidayShiftNext
+			case "previous", "prev":
+				spec.shift = holidayShiftPrevious
+			default:
+				return nil, errors.New("CronString has invalid SHIFT, expected next or previous")
+			}
+			hasDirectives = true
+		default:
+			// leave other prefixes, e.g. the time zone, to parseCronString
+			prefixes = append(prefixes, first)
+		}
+		c = rest
+	}
+
+	fields, comment, hasComment := strings.Cut(c, "#")
+	split := strings.Fields(handlePredefinedCronStrings(strings.TrimSpace(fields)))
+	domIndex := -1
+	switch len(split) {
+	case 5, 6:
+		domIndex = 2
+	case 7:
+		domIndex = 3
+	}
+	if domIndex >= 0 {
+		dom := strings.ToUpper(split[domIndex])
+		if dom == lastBusinessDay {
+			spec.nthBusinessDay = -1
+		} else if n, found := strings.CutSuffix(dom, businessDaySuffix); found {
+			nth, err := strconv.Atoi(n)
+			if err != nil || nth < 1 || nth > maxNthBusinessDay {
+				return nil, fmt.Errorf("DayOfMonth business day is not in range [1-%d]", maxNthBusinessDay)
+			}
+			spec.nthBusinessDay = nth
+		}
+		if spec.nthBusinessDay != 0 {
+			split[domIndex] = "*"
+			hasDirectives = true
+		}
+	}
+	if !hasDirectives {
+		return nil, nil
+	}
+	if spec.nthBusinessDay != 0 && spec.shift != holidayShiftSkip {
+		return nil, errors.New("CronString cannot use SHIFT with a business day of month")
+	}
+	if strings.HasPrefix(strings.TrimSpace(fields), "@every") {
+		return nil, errors.New("CronString cannot use business day directives with @every")
+	}
+
+	rebuilt := strings.Join(append(prefixes, split...), " ")
+	if hasComment {
+		rebuilt += " #" + comment
+	}
+	structured, _, tzName, err := parseCronString(rebuilt)
+	if err != nil {
+		return nil, err
+	}
+	spec.calendar = structured
+	spec.tzName = tzName
+	return spec, nil
+}
+
 func parseCalendarToStructured(cal *schedpb.CalendarSpec) (*schedpb.StructuredCalendarSpec, error) {
 	var errs []string
 	makeRangeOrNil := func(s, field, def string, min, max int, parseMode parseMode) []*schedpb.Range {
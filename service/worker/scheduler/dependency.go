@@ -0,0 +1,89 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package scheduler
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/payload"
+)
+
+type (
+	// scheduleDependency makes a schedule take an action every time a run started by another
+	// schedule in the same namespace completes successfully. It's written as a cron string:
+	//
+	//	@after <schedule id> [<expected result as json>]
+	//
+	// If an expected result is given, the upstream run must have returned a result that's
+	// equal to it when both are decoded as json.
+	scheduleDependency struct {
+		scheduleID     string
+		expectedResult any
+		hasResult      bool
+	}
+)
+
+const dependencyCronPrefix = "@after"
+
+var errMultipleDependencies = errors.New("CronString can have at most one @after dependency")
+
+// parseDependencyCronString returns nil if the cron string isn't a dependency.
+func parseDependencyCronString(c string) (*scheduleDependency, error) {
+	c = strings.TrimSpace(c)
+	first, rest, _ := strings.Cut(c, " ")
+	if strings.ToLower(first) != dependencyCronPrefix {
+		return nil, nil
+	}
+	scheduleID, expected, _ := strings.Cut(strings.TrimSpace(rest), " ")
+	if scheduleID == "" {
+		return nil, errors.New("CronString @after requires a schedule id")
+	}
+	dep := &scheduleDependency{scheduleID: scheduleID}
+	if expected = strings.TrimSpace(expected); expected != "" {
+		if err := json.Unmarshal([]byte(expected), &dep.expectedResult); err != nil {
+			return nil, errors.New("CronString @after expected result is not valid json")
+		}
+		dep.hasResult = true
+	}
+	return dep, nil
+}
+
+// matchesResult returns true if the result of a successful upstream run satisfies the
+// dependency.
+func (d *scheduleDependency) matchesResult(result *commonpb.Payloads) bool {
+	if !d.hasResult {
+		return true
+	}
+	if len(result.GetPayloads()) != 1 {
+		return false
+	}
+	var actual any
+	if err := payload.Decode(result.Payloads[0], &actual); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(actual, d.expectedResult)
+}
@@ -31,6 +31,7 @@ import (
 	sdkworker "go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
 
+	schedspb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -50,6 +51,8 @@ type (
 		activityDeps             activityDeps
 		enabledForNs             dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		globalNSStartWorkflowRPS dynamicconfig.FloatPropertyFnWithNamespaceFilter
+		holidayCalendars         dynamicconfig.MapPropertyFnWithNamespaceFilter
+		runHistorySize           dynamicconfig.IntPropertyFnWithNamespaceFilter
 	}
 
 	activityDeps struct {
@@ -81,6 +84,10 @@ func NewResult(
 				dynamicconfig.WorkerEnableScheduler, true),
 			globalNSStartWorkflowRPS: dcCollection.GetFloatPropertyFilteredByNamespace(
 				dynamicconfig.SchedulerNamespaceStartWorkflowRPS, 30.0),
+			holidayCalendars: dcCollection.GetMapPropertyFnWithNamespaceFilter(
+				dynamicconfig.WorkerSchedulerHolidayCalendars, map[string]any{}),
+			runHistorySize: dcCollection.GetIntPropertyFilteredByNamespace(
+				dynamicconfig.WorkerSchedulerRunHistorySize, defaultRunHistorySize),
 		},
 	}
 }
@@ -92,10 +99,24 @@ func (s *workerComponent) DedicatedWorkerOptions(ns *namespace.Namespace) *worke
 }
 
 func (s *workerComponent) Register(registry sdkworker.Registry, ns *namespace.Namespace, details workercommon.RegistrationDetails) {
-	registry.RegisterWorkflowWithOptions(SchedulerWorkflow, workflow.RegisterOptions{Name: WorkflowType})
+	registry.RegisterWorkflowWithOptions(s.workflow(ns.Name()), workflow.RegisterOptions{Name: WorkflowType})
 	registry.RegisterActivity(s.activities(ns.Name(), ns.ID(), details))
 }
 
+func (s *workerComponent) workflow(name namespace.Name) func(workflow.Context, *schedspb.StartScheduleArgs) error {
+	deps := workflowDeps{
+		holidays: newDCHolidayCalendars(func() map[string]any {
+			return s.holidayCalendars(name.String())
+		}, s.activityDeps.Logger),
+		runHistorySize: func() int {
+			return s.runHistorySize(name.String())
+		},
+	}
+	return func(ctx workflow.Context, args *schedspb.StartScheduleArgs) error {
+		return schedulerWorkflowWithDeps(ctx, args, deps)
+	}
+}
+
 func (s *workerComponent) activities(name namespace.Name, id namespace.ID, details workercommon.RegistrationDetails) *activities {
 	localRPS := func() float64 {
 		return float64(details.Multiplicity) * s.globalNSStartWorkflowRPS(name.String()) / float64(details.TotalWorkers)
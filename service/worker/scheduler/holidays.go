@@ -0,0 +1,288 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// HolidayCalendars resolves the named holiday calendars referenced by schedules.
+	HolidayCalendars interface {
+		// Holidays returns the named calendar, or false if there is no such calendar.
+		Holidays(name string) (*HolidayCalendar, bool)
+	}
+
+	// HolidayCalendar is a set of dates.
+	HolidayCalendar struct {
+		dates map[civilDate]struct{}
+	}
+
+	civilDate struct {
+		year  int
+		month time.Month
+		day   int
+	}
+
+	// dcHolidayCalendars resolves holiday calendars from dynamic config. Each calendar is
+	// either a list of dates in the "2006-01-02" format or the path of an iCalendar file.
+	dcHolidayCalendars struct {
+		calendars func() map[string]any
+		logger    log.Logger
+
+		sync.Mutex
+		cache map[string]*cachedHolidayCalendar
+	}
+
+	cachedHolidayCalendar struct {
+		value    any
+		modTime  time.Time
+		calendar *HolidayCalendar
+	}
+)
+
+const (
+	holidayDateLayout = "2006-01-02"
+	icsDateLayout     = "20060102"
+)
+
+var _ HolidayCalendars = (*dcHolidayCalendars)(nil)
+
+// NewHolidayCalendar creates a holiday calendar from dates in the "2006-01-02" format.
+func NewHolidayCalendar(dates ...string) (*HolidayCalendar, error) {
+	h := &HolidayCalendar{dates: make(map[civilDate]struct{}, len(dates))}
+	for _, date := range dates {
+		t, err := time.Parse(holidayDateLayout, strings.TrimSpace(date))
+		if err != nil {
+			return nil, fmt.Errorf("invalid holiday date %q: %w", date, err)
+		}
+		h.add(t)
+	}
+	return h, nil
+}
+
+// ParseICS creates a holiday calendar from the all-day events of an iCalendar file.
+// Every day from DTSTART up to, but excluding, DTEND is a holiday. Yearly recurring
+// events (RRULE:FREQ=YEARLY, optionally with COUNT or UNTIL) are supported.
+func ParseICS(r io.Reader) (*HolidayCalendar, error) {
+	h := &HolidayCalendar{dates: make(map[civilDate]struct{})}
+
+	lines, err := unfoldICSLines(r)
+	if err != nil {
+		return nil, err
+	}
+	var event map[string]string
+	for _, line := range lines {
+		name, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		// strip parameters, e.g. DTSTART;VALUE=DATE
+		name, _, _ = strings.Cut(strings.ToUpper(name), ";")
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			event = make(map[string]string)
+		case name == "END" && value == "VEVENT":
+			if event == nil {
+				return nil, fmt.Errorf("unexpected END:VEVENT")
+			}
+			if err := h.addICSEvent(event); err != nil {
+				return nil, err
+			}
+			event = nil
+		case event != nil:
+			event[name] = value
+		}
+	}
+	return h, nil
+}
+
+func unfoldICSLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+func (h *HolidayCalendar) addICSEvent(event map[string]string) error {
+	start, err := parseICSDate(event["DTSTART"])
+	if err != nil {
+		return fmt.Errorf("invalid DTSTART: %w", err)
+	}
+	end := start.AddDate(0, 0, 1)
+	if dtend, ok := event["DTEND"]; ok {
+		if end, err = parseICSDate(dtend); err != nil {
+			return fmt.Errorf("invalid DTEND: %w", err)
+		}
+	}
+
+	count, until := 1, start
+	if rrule, ok := event["RRULE"]; ok {
+		if count, until, err = parseICSYearlyRule(rrule, start); err != nil {
+			return err
+		}
+	}
+	for i := 0; count == 0 || i < count; i++ {
+		occurrenceStart := start.AddDate(i, 0, 0)
+		if occurrenceStart.After(until) || occurrenceStart.Year() > maxCalendarYear {
+			break
+		}
+		occurrenceEnd := end.AddDate(i, 0, 0)
+		for day := occurrenceStart; day.Before(occurrenceEnd); day = day.AddDate(0, 0, 1) {
+			h.add(day)
+		}
+	}
+	return nil
+}
+
+// parseICSYearlyRule returns the number of occurrences (0 for unlimited) and the last possible start.
+func parseICSYearlyRule(rrule string, start time.Time) (int, time.Time, error) {
+	count := 0
+	until := time.Date(maxCalendarYear, time.December, 31, 0, 0, 0, 0, time.UTC)
+	for _, part := range strings.Split(rrule, ";") {
+		key, value, _ := strings.Cut(part, "=")
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			if strings.ToUpper(value) != "YEARLY" {
+				return 0, start, fmt.Errorf("unsupported RRULE frequency %q", value)
+			}
+		case "COUNT":
+			c, err := strconv.Atoi(value)
+			if err != nil || c < 1 {
+				return 0, start, fmt.Errorf("invalid RRULE COUNT %q", value)
+			}
+			count = c
+		case "UNTIL":
+			u, err := parseICSDate(value)
+			if err != nil {
+				return 0, start, fmt.Errorf("invalid RRULE UNTIL: %w", err)
+			}
+			until = u
+		case "INTERVAL":
+			if value != "1" {
+				return 0, start, fmt.Errorf("unsupported RRULE INTERVAL %q", value)
+			}
+		default:
+			return 0, start, fmt.Errorf("unsupported RRULE part %q", part)
+		}
+	}
+	return count, until, nil
+}
+
+// parseICSDate parses the date part of a DATE or DATE-TIME value.
+func parseICSDate(value string) (time.Time, error) {
+	if len(value) < len(icsDateLayout) {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+	return time.Parse(icsDateLayout, value[:len(icsDateLayout)])
+}
+
+func (h *HolidayCalendar) add(t time.Time) {
+	y, m, d := t.Date()
+	h.dates[civilDate{year: y, month: m, day: d}] = struct{}{}
+}
+
+// Contains returns true if the date is a holiday.
+func (h *HolidayCalendar) Contains(year int, month time.Month, day int) bool {
+	_, ok := h.dates[civilDate{year: year, month: month, day: day}]
+	return ok
+}
+
+func newDCHolidayCalendars(calendars func() map[string]any, logger log.Logger) *dcHolidayCalendars {
+	return &dcHolidayCalendars{
+		calendars: calendars,
+		logger:    logger,
+		cache:     make(map[string]*cachedHolidayCalendar),
+	}
+}
+
+func (c *dcHolidayCalendars) Holidays(name string) (*HolidayCalendar, bool) {
+	value, ok := c.calendars()[name]
+	if !ok {
+		return nil, false
+	}
+
+	var modTime time.Time
+	if path, ok := value.(string); ok {
+		info, err := os.Stat(path)
+		if err != nil {
+			c.logger.Error("Unable to read holiday calendar", tag.NewStringTag("holiday-calendar", name), tag.Error(err))
+			return nil, false
+		}
+		modTime = info.ModTime()
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	if cached, ok := c.cache[name]; ok && reflect.DeepEqual(cached.value, value) && cached.modTime.Equal(modTime) {
+		return cached.calendar, true
+	}
+	calendar, err := loadHolidayCalendar(value)
+	if err != nil {
+		c.logger.Error("Invalid holiday calendar", tag.NewStringTag("holiday-calendar", name), tag.Error(err))
+		return nil, false
+	}
+	c.cache[name] = &cachedHolidayCalendar{value: value, modTime: modTime, calendar: calendar}
+	return calendar, true
+}
+
+func loadHolidayCalendar(value any) (*HolidayCalendar, error) {
+	switch v := value.(type) {
+	case string:
+		f, err := os.Open(v)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = f.Close() }()
+		return ParseICS(f)
+	case []any:
+		dates := make([]string, len(v))
+		for i, date := range v {
+			s, ok := date.(string)
+			if !ok {
+				return nil, fmt.Errorf("holiday date is not a string: %v", date)
+			}
+			dates[i] = s
+		}
+		return NewHolidayCalendar(dates...)
+	}
+	return nil, fmt.Errorf("holiday calendar must be a list of dates or the path of an iCalendar file, got %T", value)
+}
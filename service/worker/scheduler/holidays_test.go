@@ -0,0 +1,162 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	holidaysSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+
+	staticHolidayCalendars map[string]*HolidayCalendar
+)
+
+const testICS = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"SUMMARY:New Year's Day\r\n" +
+	"DTSTART;VALUE=DATE:20260101\r\n" +
+	"RRULE:FREQ=YEARLY;COUNT=3\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"SUMMARY:Winter\r\n" +
+	" break\r\n" +
+	"DTSTART;VALUE=DATE:20261224\r\n" +
+	"DTEND;VALUE=DATE:20261227\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestHolidays(t *testing.T) {
+	suite.Run(t, new(holidaysSuite))
+}
+
+func (s *holidaysSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *holidaysSuite) TestNewHolidayCalendar() {
+	h, err := NewHolidayCalendar("2026-12-25", " 2027-01-01 ")
+	s.NoError(err)
+	s.True(h.Contains(2026, time.December, 25))
+	s.True(h.Contains(2027, time.January, 1))
+	s.False(h.Contains(2026, time.December, 26))
+
+	_, err = NewHolidayCalendar("12/25/2026")
+	s.Error(err)
+}
+
+func (s *holidaysSuite) TestParseICS() {
+	h, err := ParseICS(strings.NewReader(testICS))
+	s.NoError(err)
+	s.True(h.Contains(2026, time.January, 1))
+	s.True(h.Contains(2027, time.January, 1))
+	s.True(h.Contains(2028, time.January, 1))
+	s.False(h.Contains(2029, time.January, 1))
+	s.True(h.Contains(2026, time.December, 24))
+	s.True(h.Contains(2026, time.December, 26))
+	s.False(h.Contains(2026, time.December, 27))
+
+	h, err = ParseICS(strings.NewReader("BEGIN:VEVENT\nDTSTART:20260704T000000Z\nRRULE:FREQ=YEARLY;UNTIL=20280101\nEND:VEVENT\n"))
+	s.NoError(err)
+	s.True(h.Contains(2026, time.July, 4))
+	s.True(h.Contains(2027, time.July, 4))
+	s.False(h.Contains(2028, time.July, 4))
+
+	for _, ics := range []string{
+		"BEGIN:VEVENT\nDTSTART:2026\nEND:VEVENT\n",
+		"BEGIN:VEVENT\nDTSTART:20260101\nRRULE:FREQ=MONTHLY\nEND:VEVENT\n",
+		"BEGIN:VEVENT\nDTSTART:20260101\nRRULE:FREQ=YEARLY;INTERVAL=2\nEND:VEVENT\n",
+		"END:VEVENT\n",
+	} {
+		_, err := ParseICS(strings.NewReader(ics))
+		s.Error(err, ics)
+	}
+}
+
+func (s *holidaysSuite) TestDynamicConfigCalendars() {
+	path := filepath.Join(s.T().TempDir(), "holidays.ics")
+	s.NoError(os.WriteFile(path, []byte(testICS), 0644))
+
+	values := map[string]any{
+		"dates": []any{"2026-12-25"},
+		"file":  path,
+		"bad":   []any{"tomorrow"},
+	}
+	calendars := newDCHolidayCalendars(func() map[string]any { return values }, log.NewNoopLogger())
+
+	h, ok := calendars.Holidays("dates")
+	s.True(ok)
+	s.True(h.Contains(2026, time.December, 25))
+	cached, ok := calendars.Holidays("dates")
+	s.True(ok)
+	s.Same(h, cached)
+
+	h, ok = calendars.Holidays("file")
+	s.True(ok)
+	s.True(h.Contains(2026, time.December, 24))
+
+	_, ok = calendars.Holidays("bad")
+	s.False(ok)
+	_, ok = calendars.Holidays("missing")
+	s.False(ok)
+
+	// changes are picked up
+	values["dates"] = []any{"2026-12-26"}
+	h, ok = calendars.Holidays("dates")
+	s.True(ok)
+	s.False(h.Contains(2026, time.December, 25))
+	s.True(h.Contains(2026, time.December, 26))
+
+	s.NoError(os.WriteFile(path, []byte("BEGIN:VEVENT\nDTSTART:20270704\nEND:VEVENT\n"), 0644))
+	s.NoError(os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+	h, ok = calendars.Holidays("file")
+	s.True(ok)
+	s.False(h.Contains(2026, time.December, 24))
+	s.True(h.Contains(2027, time.July, 4))
+}
+
+func (c staticHolidayCalendars) Holidays(name string) (*HolidayCalendar, bool) {
+	h, ok := c[name]
+	return h, ok
+}
+
+func mustHolidayCalendar(dates ...string) *HolidayCalendar {
+	h, err := NewHolidayCalendar(dates...)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
@@ -43,7 +43,10 @@ type (
 		spec     *schedpb.ScheduleSpec
 		tz       *time.Location
 		calendar []*compiledCalendar
+		business []*compiledBusinessCalendar
 		excludes []*compiledCalendar
+		// dependency is set if the schedule takes actions after runs of another schedule
+		dependency *scheduleDependency
 	}
 
 	getNextTimeResult struct {
@@ -53,6 +56,15 @@ type (
 )
 
 func NewCompiledSpec(spec *schedpb.ScheduleSpec) (*CompiledSpec, error) {
+	return NewCompiledSpecWithHolidays(spec, nil)
+}
+
+// NewCompiledSpecWithHolidays compiles a spec which may refer to holiday calendars by name
+// in business day cron strings. Calendars are looked up in holidays every time the next
+// time is computed. If holidays is nil, or doesn't have a referenced calendar, that calendar
+// is treated as having no holidays. Holidays only affect the cron strings which reference them,
+// not the structured calendars, intervals or exclusions of the spec.
+func NewCompiledSpecWithHolidays(spec *schedpb.ScheduleSpec, holidays HolidayCalendars) (*CompiledSpec, error) {
 	spec, err := canonicalizeSpec(spec)
 	if err != nil {
 		return nil, err
@@ -70,6 +82,23 @@ func NewCompiledSpec(spec *schedpb.ScheduleSpec) (*CompiledSpec, error) {
 		ccs[i] = newCompiledCalendar(structured, tz)
 	}
 
+	// compile business day and dependency cron strings (these are left in canonical form)
+	var business []*compiledBusinessCalendar
+	var dependency *scheduleDependency
+	for _, cs := range spec.CronString {
+		if dep, err := parseDependencyCronString(cs); err != nil {
+			return nil, err
+		} else if dep != nil {
+			dependency = dep
+			continue
+		}
+		bspec, err := parseBusinessCronString(cs)
+		if err != nil {
+			return nil, err
+		}
+		business = append(business, newCompiledBusinessCalendar(bspec, tz, holidays))
+	}
+
 	// compile excludes
 	excludes := make([]*compiledCalendar, len(spec.ExcludeStructuredCalendar))
 	for i, excal := range spec.ExcludeStructuredCalendar {
@@ -77,10 +106,12 @@ func NewCompiledSpec(spec *schedpb.ScheduleSpec) (*CompiledSpec, error) {
 	}
 
 	cspec := &CompiledSpec{
-		spec:     spec,
-		tz:       tz,
-		calendar: ccs,
-		excludes: excludes,
+		spec:       spec,
+		tz:         tz,
+		calendar:   ccs,
+		business:   business,
+		excludes:   excludes,
+		dependency: dependency,
 	}
 
 	return cspec, nil
@@ -142,7 +173,36 @@ func canonicalizeSpec(spec *schedpb.ScheduleSpec) (*schedpb.ScheduleSpec, error)
 	// parse CronStrings
 	const unset = "__unset__"
 	cronTZ := unset
+	var canonicalCronStrings []string
+	hasDependency := false
 	for _, cs := range spec.CronString {
+		if dep, err := parseDependencyCronString(cs); err != nil {
+			return nil, err
+		} else if dep != nil {
+			// dependencies aren't time-based, keep them as they are
+			if hasDependency {
+				return nil, errMultipleDependencies
+			}
+			hasDependency = true
+			canonicalCronStrings = append(canonicalCronStrings, cs)
+			continue
+		}
+		bspec, err := parseBusinessCronString(cs)
+		if err != nil {
+			return nil, err
+		}
+		if bspec != nil {
+			// business day cron strings can't be represented as structured calendars
+			if cronTZ != unset && bspec.tzName != cronTZ {
+				return nil, errConflictingTimezoneNames
+			}
+			cronTZ = bspec.tzName
+			if err := validateStructuredCalendar(bspec.calendar); err != nil {
+				return nil, err
+			}
+			canonicalCronStrings = append(canonicalCronStrings, cs)
+			continue
+		}
 		structured, interval, tz, err := parseCronString(cs)
 		if err != nil {
 			return nil, err
@@ -159,7 +219,7 @@ func canonicalizeSpec(spec *schedpb.ScheduleSpec) (*schedpb.ScheduleSpec, error)
 			spec.Interval = append(spec.Interval, interval)
 		}
 	}
-	spec.CronString = nil
+	spec.CronString = canonicalCronStrings
 
 	// if we have cron string(s), copy the timezone to spec, checking for conflict first.
 	// if cron string timezone is empty string, don't copy, let the one in spec be used.
@@ -302,6 +362,15 @@ func (cs *CompiledSpec) rawNextTime(after time.Time) (nominal time.Time) {
 		}
 	}
 
+	for _, cal := range cs.business {
+		if next := cal.next(after); !next.IsZero() {
+			nextTs := next.Unix()
+			if nextTs < minTimestamp {
+				minTimestamp = nextTs
+			}
+		}
+	}
+
 	ts := after.Unix()
 	for _, iv := range cs.spec.Interval {
 		next := cs.nextIntervalTime(iv, ts)
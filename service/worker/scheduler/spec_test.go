@@ -32,7 +32,10 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	commonpb "go.temporal.io/api/common/v1"
 	schedpb "go.temporal.io/api/schedule/v1"
+
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/testing/protorequire"
 )
 
@@ -60,6 +63,17 @@ func (s *specSuite) checkSequenceRaw(spec *schedpb.ScheduleSpec, start time.Time
 	}
 }
 
+func (s *specSuite) checkSequenceWithHolidays(holidays HolidayCalendars, spec *schedpb.ScheduleSpec, start time.Time, seq ...time.Time) {
+	s.T().Helper()
+	cs, err := NewCompiledSpecWithHolidays(spec, holidays)
+	s.NoError(err)
+	for _, exp := range seq {
+		next := cs.rawNextTime(start)
+		s.Equal(exp, next)
+		start = next
+	}
+}
+
 func (s *specSuite) checkSequenceFull(jitterSeed string, spec *schedpb.ScheduleSpec, start time.Time, seq ...time.Time) {
 	s.T().Helper()
 	cs, err := NewCompiledSpec(spec)
@@ -485,3 +499,193 @@ func (s *specSuite) TestSpecJitterSeed() {
 		time.Date(2022, 3, 24, 0, 39, 16, 922000000, time.UTC),
 	)
 }
+
+func (s *specSuite) TestCanonicalizeBusinessCron() {
+	canonical, err := canonicalizeSpec(&schedpb.ScheduleSpec{
+		CronString: []string{
+			"TZ=America/New_York HOLIDAYS=us 0 9 LBD * *",
+			"TZ=America/New_York 0 12 * * *",
+		},
+	})
+	s.NoError(err)
+	s.Equal([]string{"TZ=America/New_York HOLIDAYS=us 0 9 LBD * *"}, canonical.CronString)
+	s.Equal("America/New_York", canonical.TimezoneName)
+	s.Len(canonical.StructuredCalendar, 1)
+
+	// canonical form is stable
+	again, err := canonicalizeSpec(canonical)
+	s.NoError(err)
+	s.ProtoEqual(canonical, again)
+
+	_, err = canonicalizeSpec(&schedpb.ScheduleSpec{
+		CronString: []string{
+			"TZ=America/New_York HOLIDAYS=us 0 9 LBD * *",
+			"TZ=Europe/London 0 12 * * *",
+		},
+	})
+	s.Error(err)
+
+	for _, cs := range []string{
+		"SHIFT=next 0 9 LBD * *",
+		"SHIFT=sideways 0 9 * * *",
+		"HOLIDAYS= 0 9 * * *",
+		"0 9 24BD * *",
+		"0 9 xBD * *",
+		"HOLIDAYS=us @every 5m",
+		"HOLIDAYS=us 0 25 * * *",
+	} {
+		_, err := canonicalizeSpec(&schedpb.ScheduleSpec{CronString: []string{cs}})
+		s.Error(err, cs)
+	}
+}
+
+func (s *specSuite) TestCanonicalizeDependency() {
+	canonical, err := canonicalizeSpec(&schedpb.ScheduleSpec{
+		CronString: []string{
+			`@after upstream {"ok": true}`,
+			"0 12 * * *",
+		},
+	})
+	s.NoError(err)
+	s.Equal([]string{`@after upstream {"ok": true}`}, canonical.CronString)
+	s.Len(canonical.StructuredCalendar, 1)
+
+	cspec, err := NewCompiledSpec(canonical)
+	s.NoError(err)
+	s.Equal("upstream", cspec.dependency.scheduleID)
+	s.Empty(cspec.business)
+
+	for _, cs := range [][]string{
+		{"@after"},
+		{"@after upstream {not json"},
+		{"@after upstream", "@after other"},
+	} {
+		_, err := canonicalizeSpec(&schedpb.ScheduleSpec{CronString: cs})
+		s.Error(err, cs)
+	}
+}
+
+func (s *specSuite) TestDependencyMatchesResult() {
+	encode := func(v any) *commonpb.Payloads {
+		p, err := payloads.Encode(v)
+		s.NoError(err)
+		return p
+	}
+
+	dep, err := parseDependencyCronString("@after upstream")
+	s.NoError(err)
+	s.True(dep.matchesResult(nil))
+	s.True(dep.matchesResult(encode("anything")))
+
+	dep, err = parseDependencyCronString(`@after upstream {"ok": true, "n": 3}`)
+	s.NoError(err)
+	s.True(dep.matchesResult(encode(map[string]any{"n": 3, "ok": true})))
+	s.False(dep.matchesResult(encode(map[string]any{"ok": true})))
+	s.False(dep.matchesResult(nil))
+	s.False(dep.matchesResult(payloads.EncodeBytes([]byte("raw"))))
+
+	dep, err = parseDependencyCronString("0 12 * * *")
+	s.NoError(err)
+	s.Nil(dep)
+}
+
+func (s *specSuite) TestSpecBusinessDays() {
+	holidays := staticHolidayCalendars{
+		"us": mustHolidayCalendar("2026-12-01", "2026-12-25", "2026-12-31", "2027-01-01"),
+	}
+
+	// last business day of the month
+	s.checkSequenceWithHolidays(
+		holidays,
+		&schedpb.ScheduleSpec{CronString: []string{"HOLIDAYS=us 0 9 LBD * *"}},
+		time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 30, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 11, 30, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 30, 9, 0, 0, 0, time.UTC),
+	)
+
+	// second business day of the month
+	s.checkSequenceWithHolidays(
+		holidays,
+		&schedpb.ScheduleSpec{CronString: []string{"HOLIDAYS=us 0 9 2BD * *"}},
+		time.Date(2026, 11, 2, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, 11, 3, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 3, 9, 0, 0, 0, time.UTC),
+	)
+
+	// skip holidays
+	s.checkSequenceWithHolidays(
+		holidays,
+		&schedpb.ScheduleSpec{CronString: []string{"HOLIDAYS=us 0 9,17 * * 1-5"}},
+		time.Date(2026, 12, 24, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 24, 17, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 28, 9, 0, 0, 0, time.UTC),
+	)
+
+	// only holidays are skipped, not weekends
+	s.checkSequenceWithHolidays(
+		holidays,
+		&schedpb.ScheduleSpec{CronString: []string{"HOLIDAYS=us 0 9 * * *"}},
+		time.Date(2026, 12, 24, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 26, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 27, 9, 0, 0, 0, time.UTC),
+	)
+
+	// holidays don't apply to structured calendars and intervals
+	s.checkSequenceWithHolidays(
+		holidays,
+		&schedpb.ScheduleSpec{
+			CronString: []string{"HOLIDAYS=us 0 9 * * *"},
+			StructuredCalendar: []*schedpb.StructuredCalendarSpec{{
+				Second:     []*schedpb.Range{{Start: 0}},
+				Minute:     []*schedpb.Range{{Start: 0}},
+				Hour:       []*schedpb.Range{{Start: 17}},
+				DayOfMonth: []*schedpb.Range{{Start: 1, End: 31}},
+				Month:      []*schedpb.Range{{Start: 1, End: 12}},
+				DayOfWeek:  []*schedpb.Range{{Start: 0, End: 6}},
+			}},
+		},
+		time.Date(2026, 12, 24, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 24, 17, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 25, 17, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 26, 9, 0, 0, 0, time.UTC),
+	)
+
+	// shift to the next business day
+	s.checkSequenceWithHolidays(
+		holidays,
+		&schedpb.ScheduleSpec{CronString: []string{"HOLIDAYS=us SHIFT=next 0 9 1 * *"}},
+		time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 2, 9, 0, 0, 0, time.UTC),
+		time.Date(2027, 1, 4, 9, 0, 0, 0, time.UTC),
+	)
+
+	// shift to the previous business day, weekends only
+	s.checkSequenceWithHolidays(
+		holidays,
+		&schedpb.ScheduleSpec{CronString: []string{"SHIFT=previous 0 9 1 * *"}},
+		time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 30, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 1, 9, 0, 0, 0, time.UTC),
+	)
+
+	// time zone
+	newYork, err := time.LoadLocation("America/New_York")
+	s.NoError(err)
+	s.checkSequenceWithHolidays(
+		holidays,
+		&schedpb.ScheduleSpec{CronString: []string{"TZ=America/New_York HOLIDAYS=us 30 23 LBD * *"}},
+		time.Date(2026, 11, 30, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, 11, 30, 23, 30, 0, 0, newYork).UTC(),
+		time.Date(2026, 12, 30, 23, 30, 0, 0, newYork).UTC(),
+	)
+
+	// unknown calendars have no holidays
+	s.checkSequenceWithHolidays(
+		nil,
+		&schedpb.ScheduleSpec{CronString: []string{"HOLIDAYS=us 0 9 LBD * *"}},
+		time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 31, 9, 0, 0, 0, time.UTC),
+	)
+}
@@ -27,6 +27,9 @@ package scheduler
 import (
 	"errors"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -46,6 +49,7 @@ import (
 	"go.temporal.io/sdk/workflow"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	schedspb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/metrics"
@@ -89,6 +93,8 @@ const (
 
 	QueryNameDescribe          = "describe"
 	QueryNameListMatchingTimes = "listMatchingTimes"
+	QueryNameBackfillPreview   = "backfillPreview"
+	QueryNameRunHistory        = "runHistory"
 
 	MemoFieldInfo = "ScheduleInfo"
 
@@ -102,6 +108,10 @@ const (
 
 	nextTimeCacheV1Size = 10
 
+	defaultRunHistorySize = 50
+	// Failure messages in the run history are truncated to this many bytes.
+	maxRunHistoryFailureMessageLength = 1000
+
 	impossibleHistorySize = 1e6 // just for testing, no real history can be this long
 )
 
@@ -115,6 +125,7 @@ type (
 		metrics sdkclient.MetricsHandler
 
 		cspec *CompiledSpec
+		deps  workflowDeps
 
 		tweakables tweakablePolicies
 
@@ -125,6 +136,10 @@ type (
 		watchingWorkflowId string
 		watchingFuture     workflow.Future
 
+		// If the spec depends on another schedule, we watch it with this activity:
+		upstreamFuture workflow.Future
+		upstreamCancel workflow.CancelFunc
+
 		// Signal requests
 		pendingPatch  *schedpb.SchedulePatch
 		pendingUpdate *schedspb.FullUpdateRequest
@@ -138,6 +153,13 @@ type (
 		nextTimeCacheV2 nextTimeCacheV2
 	}
 
+	// workflowDeps are per-namespace settings supplied by the worker. They may be empty, e.g.
+	// in tests.
+	workflowDeps struct {
+		holidays       HolidayCalendars
+		runHistorySize func() int // only read through tweakables
+	}
+
 	tweakablePolicies struct {
 		DefaultCatchupWindow              time.Duration // Default for catchup window
 		MinCatchupWindow                  time.Duration // Minimum for catchup window
@@ -152,11 +174,13 @@ type (
 		SleepWhilePaused                  bool          // If true, don't set timers while paused/out of actions
 		// MaxBufferSize limits the number of buffered starts. This also limits the number of
 		// workflows that can be backfilled at once (since they all have to fit in the buffer).
-		MaxBufferSize       int
-		AllowZeroSleep      bool                     // Whether to allow a zero-length timer. Used for workflow compatibility.
-		ReuseTimer          bool                     // Whether to reuse timer. Used for workflow compatibility.
-		NextTimeCacheV2Size int                      // Size of next time cache (v2)
-		Version             SchedulerWorkflowVersion // Used to keep track of schedules version to release new features and for backward compatibility
+		MaxBufferSize        int
+		AllowZeroSleep       bool                     // Whether to allow a zero-length timer. Used for workflow compatibility.
+		ReuseTimer           bool                     // Whether to reuse timer. Used for workflow compatibility.
+		NextTimeCacheV2Size  int                      // Size of next time cache (v2)
+		UpstreamPollInterval time.Duration            // How often to check for new actions of an upstream schedule
+		RunHistorySize       int                      // Number of recent runs to keep with their final status
+		Version              SchedulerWorkflowVersion // Used to keep track of schedules version to release new features and for backward compatibility
 		// version 0 corresponds to the schedule version that comes before introducing the Version parameter
 
 		// When introducing a new field with new workflow logic, consider generating a new
@@ -202,7 +226,9 @@ var (
 		MaxBufferSize:                     1000,
 		AllowZeroSleep:                    true,
 		ReuseTimer:                        true,
-		NextTimeCacheV2Size:               14,                   // see note below
+		NextTimeCacheV2Size:               14, // see note below
+		UpstreamPollInterval:              30 * time.Second,
+		RunHistorySize:                    defaultRunHistorySize,
 		Version:                           DontTrackOverlapping, // TODO: upgrade to InclusiveBackfillStartTime
 	}
 
@@ -217,12 +243,17 @@ var (
 )
 
 func SchedulerWorkflow(ctx workflow.Context, args *schedspb.StartScheduleArgs) error {
+	return schedulerWorkflowWithDeps(ctx, args, workflowDeps{})
+}
+
+func schedulerWorkflowWithDeps(ctx workflow.Context, args *schedspb.StartScheduleArgs, deps workflowDeps) error {
 	scheduler := &scheduler{
 		StartScheduleArgs: args,
 		ctx:               ctx,
 		a:                 nil,
 		logger:            sdklog.With(workflow.GetLogger(ctx), "wf-namespace", args.State.Namespace, "schedule-id", args.State.ScheduleId),
 		metrics:           workflow.GetMetricsHandler(ctx).WithTags(map[string]string{"namespace": args.State.Namespace}),
+		deps:              deps,
 	}
 	return scheduler.run()
 }
@@ -239,6 +270,14 @@ func (s *scheduler) run() error {
 		return err
 	}
 
+	if err := workflow.SetQueryHandler(s.ctx, QueryNameBackfillPreview, s.handleBackfillPreviewQuery); err != nil {
+		return err
+	}
+
+	if err := workflow.SetQueryHandler(s.ctx, QueryNameRunHistory, s.handleRunHistoryQuery); err != nil {
+		return err
+	}
+
 	if s.State.LastProcessedTime == nil {
 		// log these as json since it's more readable than the Go representation
 		specJson, _ := protojson.Marshal(s.Schedule.Spec)
@@ -293,6 +332,7 @@ func (s *scheduler) run() error {
 		//nolint:revive
 		for s.processBuffer() {
 		}
+		s.watchUpstreamSchedule()
 		s.updateMemoAndSearchAttributes()
 
 		// if schedule is not paused and out of actions or do not have anything scheduled, exit the schedule workflow after retention period has passed
@@ -304,6 +344,7 @@ func (s *scheduler) run() error {
 		// 1. requested time elapsed
 		// 2. we got a signal (update, request, refresh)
 		// 3. a workflow that we were watching finished
+		// 4. a run of the upstream schedule that we depend on finished
 		s.sleep(nextWakeup)
 		s.updateTweakables()
 	}
@@ -348,7 +389,7 @@ func (s *scheduler) compileSpec() {
 	s.nextTimeCacheV1 = nil
 	s.nextTimeCacheV2.clear()
 
-	cspec, err := NewCompiledSpec(s.Schedule.Spec)
+	cspec, err := NewCompiledSpecWithHolidays(s.Schedule.Spec, s.deps.holidays)
 	if err != nil {
 		if s.logger != nil {
 			s.logger.Error("Invalid schedule", "error", err)
@@ -391,17 +432,8 @@ func (s *scheduler) processPatch(patch *schedpb.SchedulePatch) {
 	}
 
 	for _, bfr := range patch.BackfillRequest {
-		startTime := timestamp.TimeValue(bfr.GetStartTime())
-
-		// In previous versions the backfill start time was exclusive, ie when
-		// the start time of the backfill matched the schedule's spec, it would
-		// not be executed. This new version makes it inclusive instead.
-		if s.hasMinVersion(InclusiveBackfillStartTime) {
-			startTime = startTime.Add(-1 * time.Millisecond)
-		}
-
 		s.processTimeRange(
-			startTime,
+			s.backfillStartTime(bfr),
 			timestamp.TimeValue(bfr.GetEndTime()),
 			bfr.GetOverlapPolicy(),
 			true,
@@ -420,6 +452,18 @@ func (s *scheduler) processPatch(patch *schedpb.SchedulePatch) {
 	}
 }
 
+func (s *scheduler) backfillStartTime(bfr *schedpb.BackfillRequest) time.Time {
+	startTime := timestamp.TimeValue(bfr.GetStartTime())
+
+	// In previous versions the backfill start time was exclusive, ie when
+	// the start time of the backfill matched the schedule's spec, it would
+	// not be executed. This new version makes it inclusive instead.
+	if s.hasMinVersion(InclusiveBackfillStartTime) {
+		startTime = startTime.Add(-1 * time.Millisecond)
+	}
+	return startTime
+}
+
 func (s *scheduler) getNextTimeV1(after time.Time) getNextTimeResult {
 	// we populate the map sequentially, if after is not in the map, it means we either exhausted
 	// all items, or we jumped through time (forward or backward), in either case, refresh the cache
@@ -645,6 +689,10 @@ func (s *scheduler) sleep(nextWakeup time.Time) {
 		sel.AddFuture(s.watchingFuture, s.wfWatcherReturned)
 	}
 
+	if s.upstreamFuture != nil {
+		sel.AddFuture(s.upstreamFuture, s.upstreamWatcherReturned)
+	}
+
 	s.logger.Debug("sleeping", "next-wakeup", nextWakeup, "watching", s.watchingFuture != nil)
 	sel.Select(s.ctx)
 	for sel.HasPending() {
@@ -680,6 +728,7 @@ func (s *scheduler) processWatcherResult(id string, f workflow.Future) {
 	} else {
 		s.logger.Error("closed workflow not found in running list", "workflow", id)
 	}
+	s.recordRunClosed(id, &res)
 
 	// handle pause-on-failure
 	failedStatus := res.Status == enumspb.WORKFLOW_EXECUTION_STATUS_FAILED ||
@@ -711,6 +760,57 @@ func (s *scheduler) processWatcherResult(id string, f workflow.Future) {
 	s.logger.Debug("started workflow finished", "workflow", id, "status", res.Status, "pause-after-failure", pauseOnFailure)
 }
 
+func (s *scheduler) upstreamWatcherReturned(f workflow.Future) {
+	s.upstreamFuture = nil
+	s.upstreamCancel = nil
+	s.processUpstreamResult(f)
+}
+
+func (s *scheduler) processUpstreamResult(f workflow.Future) {
+	var res schedspb.WatchUpstreamScheduleResponse
+	if err := f.Get(s.ctx, &res); err != nil {
+		s.logger.Error("error from upstream schedule watcher future", "upstream", s.State.UpstreamScheduleId, "error", err)
+		return
+	}
+	s.State.UpstreamLastActionTime = res.ActionTime
+	s.State.UpstreamLastActionId = res.ActionId
+	if res.MissedActions > 0 {
+		s.metrics.Counter(metrics.ScheduleUpstreamMissedActions.Name()).Inc(res.MissedActions)
+		s.State.UpstreamMissedActions += res.MissedActions
+		s.logger.Warn("upstream schedule actions were missed", "upstream", s.State.UpstreamScheduleId, "missed", res.MissedActions)
+	}
+
+	id := res.Execution.GetWorkflowId()
+	switch res.Result.GetStatus() {
+	case enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED:
+		if s.cspec == nil || s.cspec.dependency == nil || !s.cspec.dependency.matchesResult(res.Result.GetResult()) {
+			s.logger.Debug("upstream workflow result doesn't match", "upstream", s.State.UpstreamScheduleId, "workflow", id)
+			return
+		}
+		if !s.canTakeScheduledAction(false, true) {
+			return
+		}
+		now := s.now()
+		s.addStart(now, now, enumspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED, false)
+	case enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+		enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT,
+		enumspb.WORKFLOW_EXECUTION_STATUS_CANCELED,
+		enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED:
+		s.metrics.Counter(metrics.ScheduleUpstreamFailures.Name()).Inc(1)
+		s.State.UpstreamLastFailure = &schedspb.RunRecord{
+			Id:             res.ActionId,
+			ActualTime:     res.ActionTime,
+			Execution:      res.Execution,
+			Status:         res.Result.Status,
+			FailureMessage: truncateFailureMessage(res.Result.GetFailure().GetMessage()),
+		}
+		s.logger.Debug("upstream workflow did not complete", "upstream", s.State.UpstreamScheduleId, "workflow", id, "status", res.Result.Status)
+	default:
+		// the upstream workflow is gone, skip it
+		s.logger.Debug("upstream workflow not found", "upstream", s.State.UpstreamScheduleId, "workflow", id)
+	}
+}
+
 func (s *scheduler) processUpdate(req *schedspb.FullUpdateRequest) {
 	if err := s.checkConflict(req.ConflictToken); err != nil {
 		s.logger.Warn("Update conflicted with concurrent change")
@@ -801,9 +901,11 @@ func (s *scheduler) handleDescribeQuery() (*schedspb.DescribeResponse, error) {
 	infoCopy.BufferSize = int64(len(s.State.BufferedStarts))
 
 	return &schedspb.DescribeResponse{
-		Schedule:      s.Schedule,
-		Info:          infoCopy,
-		ConflictToken: s.State.ConflictToken,
+		Schedule:              s.Schedule,
+		Info:                  infoCopy,
+		ConflictToken:         s.State.ConflictToken,
+		UpstreamLastFailure:   s.State.UpstreamLastFailure,
+		UpstreamMissedActions: s.State.UpstreamMissedActions,
 	}, nil
 }
 
@@ -828,6 +930,128 @@ func (s *scheduler) handleListMatchingTimesQuery(req *workflowservice.ListSchedu
 	return &workflowservice.ListScheduleMatchingTimesResponse{StartTime: out}, nil
 }
 
+func (s *scheduler) handleBackfillPreviewQuery(req *schedspb.BackfillPreviewRequest) (*schedspb.BackfillPreviewResponse, error) {
+	if req == nil {
+		return nil, errors.New("missing or invalid query")
+	}
+	if s.cspec == nil {
+		return nil, fmt.Errorf("invalid schedule: %s", s.Info.InvalidScheduleError)
+	}
+
+	// this is a query handler, don't modify s.State directly. Buffer the backfilled starts
+	// after the existing buffer the same way processPatch would.
+	buffer := slices.Clone(s.State.BufferedStarts)
+	entries := make(map[*schedspb.BufferedStart]*schedspb.BackfillPreviewEntry)
+	res := &schedspb.BackfillPreviewResponse{}
+BackfillLoop:
+	for _, bfr := range req.BackfillRequest {
+		t1, t2 := s.backfillStartTime(bfr), timestamp.TimeValue(bfr.GetEndTime())
+		for {
+			// don't need to call getNextTime in SideEffect because this is just a query
+			next := s.cspec.getNextTime(s.jitterSeed(), t1)
+			t1 = next.Next
+			if t1.IsZero() || t1.After(t2) {
+				break
+			}
+			if len(res.Entries) >= maxListMatchingTimesCount {
+				res.Truncated = true
+				break BackfillLoop
+			}
+			entry := &schedspb.BackfillPreviewEntry{
+				NominalTime:   timestamppb.New(next.Nominal),
+				ActualTime:    timestamppb.New(next.Next),
+				OverlapPolicy: s.resolveOverlapPolicy(bfr.GetOverlapPolicy()),
+				Outcome:       enumsspb.BACKFILL_PREVIEW_OUTCOME_SKIP,
+			}
+			res.Entries = append(res.Entries, entry)
+			if s.tweakables.MaxBufferSize > 0 && len(buffer) >= s.tweakables.MaxBufferSize {
+				entry.Outcome = enumsspb.BACKFILL_PREVIEW_OUTCOME_DROP_BUFFER_FULL
+				continue
+			}
+			start := &schedspb.BufferedStart{
+				NominalTime:   entry.NominalTime,
+				ActualTime:    entry.ActualTime,
+				OverlapPolicy: bfr.GetOverlapPolicy(),
+				Manual:        true,
+			}
+			buffer = append(buffer, start)
+			entries[start] = entry
+		}
+	}
+
+	// Drain the buffer. The first pass sees the currently running workflow, every later pass
+	// happens when the workflow started by the previous one closes. Anything that is never
+	// started is skipped.
+	isRunning := len(s.Info.RunningWorkflows) > 0
+	interrupting := make(map[*schedspb.BufferedStart]enumsspb.BackfillPreviewOutcome)
+	for pass := 0; len(buffer) > 0; pass++ {
+		action := processBuffer(buffer, isRunning, s.resolveOverlapPolicy)
+		started := action.overlappingStarts
+		if action.nonOverlappingStart != nil {
+			started = append(started, action.nonOverlappingStart)
+		}
+		for _, start := range started {
+			entry, ok := entries[start]
+			if !ok || entry.Outcome != enumsspb.BACKFILL_PREVIEW_OUTCOME_SKIP {
+				continue
+			}
+			switch {
+			case interrupting[start] != enumsspb.BACKFILL_PREVIEW_OUTCOME_UNSPECIFIED:
+				entry.Outcome = interrupting[start]
+			case pass > 0:
+				entry.Outcome = enumsspb.BACKFILL_PREVIEW_OUTCOME_BUFFER
+			case start != action.nonOverlappingStart:
+				entry.Outcome = enumsspb.BACKFILL_PREVIEW_OUTCOME_START_OVERLAPPING
+			default:
+				entry.Outcome = enumsspb.BACKFILL_PREVIEW_OUTCOME_START
+			}
+		}
+		if pass == 0 && (action.needCancel || action.needTerminate) {
+			// these wait for the running workflow to be cancelled or terminated
+			for _, start := range action.newBuffer {
+				switch s.resolveOverlapPolicy(start.OverlapPolicy) {
+				case enumspb.SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER:
+					interrupting[start] = enumsspb.BACKFILL_PREVIEW_OUTCOME_CANCEL_OTHER
+				case enumspb.SCHEDULE_OVERLAP_POLICY_TERMINATE_OTHER:
+					interrupting[start] = enumsspb.BACKFILL_PREVIEW_OUTCOME_TERMINATE_OTHER
+				}
+			}
+		}
+		buffer = action.newBuffer
+		isRunning = false
+	}
+	return res, nil
+}
+
+func (s *scheduler) handleRunHistoryQuery(req *schedspb.RunHistoryRequest) (*schedspb.RunHistoryResponse, error) {
+	before := int64(math.MaxInt64)
+	if len(req.GetNextPageToken()) > 0 {
+		var err error
+		if before, err = strconv.ParseInt(string(req.NextPageToken), 10, 64); err != nil {
+			return nil, errors.New("invalid next page token")
+		}
+	}
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = len(s.State.RunHistory)
+	}
+
+	resp := &schedspb.RunHistoryResponse{}
+	for i := len(s.State.RunHistory) - 1; i >= 0; i-- {
+		run := s.State.RunHistory[i]
+		if run.Id >= before {
+			continue
+		}
+		if len(resp.Runs) >= pageSize {
+			last := resp.Runs[len(resp.Runs)-1]
+			resp.NextPageToken = []byte(strconv.FormatInt(last.Id, 10))
+			break
+		}
+		resp.Runs = append(resp.Runs, run)
+	}
+	return resp, nil
+}
+
 func (s *scheduler) incSeqNo() {
 	s.State.ConflictToken++
 }
@@ -899,7 +1123,13 @@ func (s *scheduler) checkConflict(token int64) error {
 
 func (s *scheduler) updateTweakables() {
 	// Use MutableSideEffect so that we can change the defaults without breaking determinism.
-	get := func(ctx workflow.Context) interface{} { return currentTweakablePolicies }
+	get := func(ctx workflow.Context) interface{} {
+		tweakables := currentTweakablePolicies
+		if s.deps.runHistorySize != nil {
+			tweakables.RunHistorySize = s.deps.runHistorySize()
+		}
+		return tweakables
+	}
 	eq := func(a, b interface{}) bool { return a.(tweakablePolicies) == b.(tweakablePolicies) }
 	if err := workflow.MutableSideEffect(s.ctx, "tweakables", get, eq).Get(&s.tweakables); err != nil {
 		panic("can't decode tweakablePolicies:" + err.Error())
@@ -1003,6 +1233,7 @@ func (s *scheduler) processBuffer() bool {
 		metricsWithTag.Counter(metrics.ScheduleActionSuccess.Name()).Inc(1)
 		nonOverlapping := start == action.nonOverlappingStart
 		s.recordAction(result, nonOverlapping)
+		s.recordRun(start, result)
 	}
 
 	// Terminate or cancel if required (terminate overrides cancel if both are present)
@@ -1040,6 +1271,44 @@ func (s *scheduler) recordAction(result *schedpb.ScheduleActionResult, nonOverla
 	}
 }
 
+func (s *scheduler) recordRun(start *schedspb.BufferedStart, result *schedpb.ScheduleActionResult) {
+	if s.tweakables.RunHistorySize <= 0 {
+		s.State.RunHistory = nil
+		return
+	}
+	run := &schedspb.RunRecord{
+		Id:          s.Info.ActionCount,
+		NominalTime: start.NominalTime,
+		ActualTime:  result.ActualTime,
+		Execution:   result.StartWorkflowResult,
+		Status:      enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	}
+	s.State.RunHistory = util.SliceTail(append(s.State.RunHistory, run), s.tweakables.RunHistorySize)
+}
+
+func (s *scheduler) recordRunClosed(id string, res *schedspb.WatchWorkflowResponse) {
+	for i := len(s.State.RunHistory) - 1; i >= 0; i-- {
+		run := s.State.RunHistory[i]
+		if run.Execution.GetWorkflowId() != id || run.Status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			continue
+		}
+		run.Status = res.Status
+		run.FailureMessage = truncateFailureMessage(res.GetFailure().GetMessage())
+		if res.CloseTime != nil && run.ActualTime != nil {
+			run.Duration = durationpb.New(res.CloseTime.AsTime().Sub(run.ActualTime.AsTime()))
+		}
+		return
+	}
+}
+
+func truncateFailureMessage(msg string) string {
+	if len(msg) <= maxRunHistoryFailureMessageLength {
+		return msg
+	}
+	// don't cut a multi-byte character in half
+	return strings.ToValidUTF8(msg[:maxRunHistoryFailureMessageLength], "")
+}
+
 func (s *scheduler) startWorkflow(
 	start *schedspb.BufferedStart,
 	newWorkflow *workflowpb.NewWorkflowExecutionInfo,
@@ -1150,9 +1419,13 @@ func (s *scheduler) addSearchAttributes(
 }
 
 func (s *scheduler) refreshWorkflows(executions []*commonpb.WorkflowExecution) {
+	// runs that aren't tracked in RunningWorkflows, i.e. ALLOW_ALL runs, are refreshed too,
+	// but only to close their entries in the run history
+	untracked := s.untrackedRunningRuns(executions)
+
 	ctx := workflow.WithLocalActivityOptions(s.ctx, defaultLocalActivityOptions)
-	futures := make([]workflow.Future, len(executions))
-	for i, ex := range executions {
+	futures := make([]workflow.Future, len(executions)+len(untracked))
+	for i, ex := range append(slices.Clone(executions), untracked...) {
 		req := &schedspb.WatchWorkflowRequest{
 			// Note: do not send runid here so that we always get the latest one
 			Execution:           &commonpb.WorkflowExecution{WorkflowId: ex.WorkflowId},
@@ -1164,6 +1437,36 @@ func (s *scheduler) refreshWorkflows(executions []*commonpb.WorkflowExecution) {
 	for i, ex := range executions {
 		s.processWatcherResult(ex.WorkflowId, futures[i])
 	}
+	for i, ex := range untracked {
+		s.processUntrackedWatcherResult(ex.WorkflowId, futures[len(executions)+i])
+	}
+}
+
+// untrackedRunningRuns returns the runs in the run history that are still running and not
+// in executions.
+func (s *scheduler) untrackedRunningRuns(executions []*commonpb.WorkflowExecution) []*commonpb.WorkflowExecution {
+	var untracked []*commonpb.WorkflowExecution
+	for _, run := range s.State.RunHistory {
+		if run.Status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING || run.Execution == nil {
+			continue
+		}
+		match := func(ex *commonpb.WorkflowExecution) bool { return ex.WorkflowId == run.Execution.WorkflowId }
+		if !slices.ContainsFunc(executions, match) {
+			untracked = append(untracked, run.Execution)
+		}
+	}
+	return untracked
+}
+
+func (s *scheduler) processUntrackedWatcherResult(id string, f workflow.Future) {
+	var res schedspb.WatchWorkflowResponse
+	if err := f.Get(s.ctx, &res); err != nil {
+		s.logger.Error("error from workflow watcher future", "workflow", id, "error", err)
+		return
+	}
+	if res.Status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		s.recordRunClosed(id, &res)
+	}
 }
 
 func (s *scheduler) startLongPollWatcher(ex *commonpb.WorkflowExecution) {
@@ -1190,6 +1493,48 @@ func (s *scheduler) startLongPollWatcher(ex *commonpb.WorkflowExecution) {
 	s.watchingWorkflowId = ex.WorkflowId
 }
 
+// watchUpstreamSchedule starts or stops the upstream schedule watcher to match the spec.
+func (s *scheduler) watchUpstreamSchedule() {
+	var upstream string
+	if s.cspec != nil && s.cspec.dependency != nil {
+		upstream = s.cspec.dependency.scheduleID
+	}
+	if upstream != s.State.UpstreamScheduleId {
+		if s.upstreamCancel != nil {
+			s.upstreamCancel()
+		}
+		s.upstreamFuture = nil
+		s.upstreamCancel = nil
+		s.State.UpstreamScheduleId = upstream
+		// only runs of the upstream schedule that start after the dependency was added count
+		s.State.UpstreamLastActionTime = timestamppb.New(s.now())
+		s.State.UpstreamLastActionId = 0
+		s.State.UpstreamLastFailure = nil
+		s.State.UpstreamMissedActions = 0
+	}
+	if upstream == "" || s.upstreamFuture != nil {
+		return
+	}
+
+	ctx, cancel := workflow.WithCancel(s.ctx)
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 365 * 24 * time.Hour,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: 1 * time.Second,
+			MaximumInterval: 30 * time.Second,
+		},
+		HeartbeatTimeout: 65 * time.Second,
+	})
+	req := &schedspb.WatchUpstreamScheduleRequest{
+		ScheduleId:    upstream,
+		After:         s.State.UpstreamLastActionTime,
+		AfterActionId: s.State.UpstreamLastActionId,
+		PollInterval:  durationpb.New(s.tweakables.UpstreamPollInterval),
+	}
+	s.upstreamFuture = workflow.ExecuteActivity(ctx, s.a.WatchUpstreamSchedule, req)
+	s.upstreamCancel = cancel
+}
+
 func (s *scheduler) cancelWorkflow(ex *commonpb.WorkflowExecution) {
 	ctx := workflow.WithLocalActivityOptions(s.ctx, defaultLocalActivityOptions)
 	areq := &schedspb.CancelWorkflowRequest{
@@ -1232,6 +1577,11 @@ func (s *scheduler) getRetentionExpiration(nextWakeup time.Time) time.Time {
 	if s.tweakables.RetentionTime == 0 || s.Schedule.State.Paused || (!nextWakeup.IsZero() && s.canTakeScheduledAction(false, false)) {
 		return time.Time{}
 	}
+	// schedules that depend on another schedule don't have a next wakeup, but can still take
+	// actions
+	if s.cspec != nil && s.cspec.dependency != nil && s.canTakeScheduledAction(false, false) {
+		return time.Time{}
+	}
 
 	var lastActionTime time.Time
 	if len(s.Info.RecentActions) > 0 {
@@ -28,6 +28,7 @@ import (
 	"context"
 	"errors"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
@@ -46,6 +47,7 @@ import (
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/workflow"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	schedspb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
@@ -1105,6 +1107,251 @@ func (s *workflowSuite) TestPauseOnFailure() {
 	// doesn't end properly since it sleeps forever after pausing
 }
 
+func (s *workflowSuite) TestRunHistory() {
+	// written using low-level mocks so we can return failures
+
+	longMessage := strings.Repeat("é", maxRunHistoryFailureMessageLength)
+	s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+		s.Equal("myid-2022-06-01T00:05:00Z", req.Request.WorkflowId)
+		return &schedspb.StartWorkflowResponse{RunId: "run1", RealStartTime: timestamppb.New(s.now())}, nil
+	})
+	s.expectWatch(func(req *schedspb.WatchWorkflowRequest) (*schedspb.WatchWorkflowResponse, error) {
+		s.Equal("myid-2022-06-01T00:05:00Z", req.Execution.WorkflowId)
+		return &schedspb.WatchWorkflowResponse{
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			ResultFailure: &schedspb.WatchWorkflowResponse_Failure{
+				Failure: &failurepb.Failure{Message: longMessage},
+			},
+			CloseTime: timestamppb.New(time.Date(2022, 6, 1, 0, 8, 0, 0, time.UTC)),
+		}, nil
+	})
+	s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+		s.Equal("myid-2022-06-01T00:10:00Z", req.Request.WorkflowId)
+		return &schedspb.StartWorkflowResponse{RunId: "run2", RealStartTime: timestamppb.New(s.now())}, nil
+	})
+
+	query := func(req *schedspb.RunHistoryRequest) *schedspb.RunHistoryResponse {
+		encoded, err := s.env.QueryWorkflow(QueryNameRunHistory, req)
+		s.NoError(err)
+		var resp schedspb.RunHistoryResponse
+		s.NoError(encoded.Get(&resp))
+		return &resp
+	}
+	s.env.RegisterDelayedCallback(func() {
+		first := query(&schedspb.RunHistoryRequest{PageSize: 1})
+		s.Len(first.Runs, 1)
+		s.NotEmpty(first.NextPageToken)
+		run := first.Runs[0]
+		s.Equal("myid-2022-06-01T00:10:00Z", run.Execution.WorkflowId)
+		s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, run.Status)
+		s.Nil(run.Duration)
+
+		second := query(&schedspb.RunHistoryRequest{PageSize: 1, NextPageToken: first.NextPageToken})
+		s.Len(second.Runs, 1)
+		s.Empty(second.NextPageToken)
+		run = second.Runs[0]
+		s.Equal("myid-2022-06-01T00:05:00Z", run.Execution.WorkflowId)
+		s.Equal("run1", run.Execution.RunId)
+		s.True(time.Date(2022, 6, 1, 0, 5, 0, 0, time.UTC).Equal(run.NominalTime.AsTime()))
+		s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED, run.Status)
+		s.Equal(longMessage[:maxRunHistoryFailureMessageLength], run.FailureMessage)
+		s.Equal(3*time.Minute, run.Duration.AsDuration())
+
+		s.Len(query(&schedspb.RunHistoryRequest{}).Runs, 2)
+	}, 11*time.Minute)
+
+	s.run(&schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			Interval: []*schedpb.IntervalSpec{{
+				Interval: durationpb.New(5 * time.Minute),
+			}},
+		},
+	}, 3)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) TestRunHistorySize() {
+	prevSize := currentTweakablePolicies.RunHistorySize
+	defer func() { currentTweakablePolicies.RunHistorySize = prevSize }()
+	currentTweakablePolicies.RunHistorySize = 2
+
+	for _, id := range []string{"myid-2022-06-01T00:05:00Z", "myid-2022-06-01T00:10:00Z", "myid-2022-06-01T00:15:00Z"} {
+		id := id
+		s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+			s.Equal(id, req.Request.WorkflowId)
+			return nil, nil
+		})
+	}
+	s.env.OnActivity(new(activities).WatchWorkflow, mock.Anything, mock.Anything).Maybe().Return(
+		&schedspb.WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED}, nil)
+
+	s.env.RegisterDelayedCallback(func() {
+		encoded, err := s.env.QueryWorkflow(QueryNameRunHistory, &schedspb.RunHistoryRequest{})
+		s.NoError(err)
+		var resp schedspb.RunHistoryResponse
+		s.NoError(encoded.Get(&resp))
+		s.Len(resp.Runs, 2)
+		s.Equal("myid-2022-06-01T00:15:00Z", resp.Runs[0].Execution.WorkflowId)
+		s.Equal("myid-2022-06-01T00:10:00Z", resp.Runs[1].Execution.WorkflowId)
+		s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, resp.Runs[1].Status)
+	}, 16*time.Minute)
+
+	s.run(&schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			Interval: []*schedpb.IntervalSpec{{
+				Interval: durationpb.New(5 * time.Minute),
+			}},
+		},
+	}, 4)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) TestRunHistoryAllowAll() {
+	// runs started under ALLOW_ALL aren't in RunningWorkflows, their run history entries
+	// are closed when the schedule refreshes its workflows
+
+	for _, id := range []string{"myid-2022-06-01T00:05:00Z", "myid-2022-06-01T00:10:00Z"} {
+		id := id
+		s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+			s.Equal(id, req.Request.WorkflowId)
+			return nil, nil
+		})
+	}
+	s.expectWatch(func(req *schedspb.WatchWorkflowRequest) (*schedspb.WatchWorkflowResponse, error) {
+		s.Equal("myid-2022-06-01T00:05:00Z", req.Execution.WorkflowId)
+		s.False(req.LongPoll)
+		return &schedspb.WatchWorkflowResponse{
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			CloseTime: timestamppb.New(time.Date(2022, 6, 1, 0, 7, 0, 0, time.UTC)),
+		}, nil
+	})
+
+	s.env.RegisterDelayedCallback(func() {
+		encoded, err := s.env.QueryWorkflow(QueryNameRunHistory, &schedspb.RunHistoryRequest{})
+		s.NoError(err)
+		var resp schedspb.RunHistoryResponse
+		s.NoError(encoded.Get(&resp))
+		s.Len(resp.Runs, 2)
+		s.Equal("myid-2022-06-01T00:10:00Z", resp.Runs[0].Execution.WorkflowId)
+		s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, resp.Runs[0].Status)
+		s.Equal("myid-2022-06-01T00:05:00Z", resp.Runs[1].Execution.WorkflowId)
+		s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, resp.Runs[1].Status)
+		s.Equal(2*time.Minute, resp.Runs[1].Duration.AsDuration())
+		s.Empty(s.runningWorkflows())
+	}, 11*time.Minute)
+
+	s.run(&schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			Interval: []*schedpb.IntervalSpec{{
+				Interval: durationpb.New(5 * time.Minute),
+			}},
+		},
+		Policies: &schedpb.SchedulePolicies{
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+		},
+	}, 3)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) TestScheduleDependency() {
+	// written using low-level mocks so we can control the upstream schedule
+
+	upstreamRun := func(id string) *commonpb.WorkflowExecution {
+		return &commonpb.WorkflowExecution{WorkflowId: id, RunId: id + "-run"}
+	}
+	completed := func(result any) *schedspb.WatchWorkflowResponse {
+		res, err := payloads.Encode(result)
+		s.NoError(err)
+		return &schedspb.WatchWorkflowResponse{
+			Status:        enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			ResultFailure: &schedspb.WatchWorkflowResponse_Result{Result: res},
+		}
+	}
+	expectUpstream := func(after time.Time, afterID int64, delay time.Duration, res *schedspb.WatchUpstreamScheduleResponse) {
+		s.env.OnActivity(new(activities).WatchUpstreamSchedule, mock.Anything, mock.Anything).Once().Return(
+			func(_ context.Context, req *schedspb.WatchUpstreamScheduleRequest) (*schedspb.WatchUpstreamScheduleResponse, error) {
+				s.Equal("upstream", req.ScheduleId)
+				s.True(after.Equal(req.After.AsTime()))
+				s.Equal(afterID, req.AfterActionId)
+				return res, nil
+			}).After(delay)
+	}
+
+	// matching result: start
+	expectUpstream(baseStartTime, 0, 3*time.Minute, &schedspb.WatchUpstreamScheduleResponse{
+		ActionTime: timestamppb.New(baseStartTime.Add(1 * time.Minute)),
+		Execution:  upstreamRun("up1"),
+		Result:     completed(map[string]any{"ok": true}),
+		ActionId:   1,
+	})
+	// failure: record it
+	expectUpstream(baseStartTime.Add(1*time.Minute), 1, 2*time.Minute, &schedspb.WatchUpstreamScheduleResponse{
+		ActionTime: timestamppb.New(baseStartTime.Add(4 * time.Minute)),
+		Execution:  upstreamRun("up2"),
+		ActionId:   2,
+		Result: &schedspb.WatchWorkflowResponse{
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			ResultFailure: &schedspb.WatchWorkflowResponse_Failure{
+				Failure: &failurepb.Failure{Message: "boom"},
+			},
+		},
+	})
+	// non-matching result after missed actions: nothing
+	expectUpstream(baseStartTime.Add(4*time.Minute), 2, 2*time.Minute, &schedspb.WatchUpstreamScheduleResponse{
+		ActionTime:    timestamppb.New(baseStartTime.Add(6 * time.Minute)),
+		Execution:     upstreamRun("up3"),
+		Result:        completed(map[string]any{"ok": false}),
+		ActionId:      5,
+		MissedActions: 2,
+	})
+	// upstream workflow is gone
+	expectUpstream(baseStartTime.Add(6*time.Minute), 5, 2*time.Minute, &schedspb.WatchUpstreamScheduleResponse{
+		ActionTime: timestamppb.New(baseStartTime.Add(8 * time.Minute)),
+		Execution:  upstreamRun("up4"),
+		Result:     &schedspb.WatchWorkflowResponse{},
+		ActionId:   6,
+	})
+
+	s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+		s.True(baseStartTime.Add(3 * time.Minute).Equal(s.now()))
+		s.Equal("myid-2022-06-01T00:03:00Z", req.Request.WorkflowId)
+		return nil, nil
+	})
+	s.env.OnActivity(new(activities).WatchWorkflow, mock.Anything, mock.Anything).Maybe().Return(
+		&schedspb.WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING}, nil,
+	).After(time.Hour)
+
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.EqualValues(1, desc.Info.ActionCount)
+		s.Nil(desc.UpstreamLastFailure)
+	}, 4*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.Equal("up2", desc.UpstreamLastFailure.GetExecution().GetWorkflowId())
+		s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED, desc.UpstreamLastFailure.GetStatus())
+		s.Equal("boom", desc.UpstreamLastFailure.GetFailureMessage())
+		// the schedule itself is untouched
+		s.Empty(desc.Schedule.State.Notes)
+	}, 6*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.EqualValues(1, desc.Info.ActionCount)
+		s.EqualValues(2, desc.UpstreamMissedActions)
+	}, 8*time.Minute)
+
+	s.run(&schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			CronString: []string{`@after upstream {"ok": true}`},
+		},
+	}, 4)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
 func (s *workflowSuite) TestCompileError() {
 	// written using low-level mocks since it sleeps forever
 
@@ -1245,6 +1492,91 @@ func (s *workflowSuite) TestBackfill() {
 	)
 }
 
+func (s *workflowSuite) TestBackfillPreview() {
+	// written using low-level mocks so we can query while a workflow is running
+
+	originalMaxBufferSize := currentTweakablePolicies.MaxBufferSize
+	currentTweakablePolicies.MaxBufferSize = 2
+	defer func() { currentTweakablePolicies.MaxBufferSize = originalMaxBufferSize }()
+
+	s.expectStart(func(req *schedspb.StartWorkflowRequest) (*schedspb.StartWorkflowResponse, error) {
+		s.Equal("myid-2022-06-01T00:15:00Z", req.Request.WorkflowId)
+		return nil, nil
+	})
+
+	preview := func(overlapPolicy enumspb.ScheduleOverlapPolicy) []enumsspb.BackfillPreviewOutcome {
+		encoded, err := s.env.QueryWorkflow(QueryNameBackfillPreview, &schedspb.BackfillPreviewRequest{
+			BackfillRequest: []*schedpb.BackfillRequest{{
+				StartTime:     timestamppb.New(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)),
+				EndTime:       timestamppb.New(time.Date(2022, 6, 1, 2, 30, 0, 0, time.UTC)),
+				OverlapPolicy: overlapPolicy,
+			}},
+		})
+		s.NoError(err)
+		var resp schedspb.BackfillPreviewResponse
+		s.NoError(encoded.Get(&resp))
+		s.False(resp.Truncated)
+		var outcomes []enumsspb.BackfillPreviewOutcome
+		for _, entry := range resp.Entries {
+			outcomes = append(outcomes, entry.Outcome)
+		}
+		s.Len(resp.Entries, 3)
+		s.True(time.Date(2022, 6, 1, 0, 15, 0, 0, time.UTC).Equal(resp.Entries[0].NominalTime.AsTime()))
+		s.Equal(s.resolvedPolicy(overlapPolicy), resp.Entries[0].OverlapPolicy)
+		return outcomes
+	}
+
+	s.env.RegisterDelayedCallback(func() {
+		s.Equal([]string{"myid-2022-06-01T00:15:00Z"}, s.runningWorkflows())
+		// the buffer only has room for two starts
+		s.Equal([]enumsspb.BackfillPreviewOutcome{
+			enumsspb.BACKFILL_PREVIEW_OUTCOME_SKIP,
+			enumsspb.BACKFILL_PREVIEW_OUTCOME_SKIP,
+			enumsspb.BACKFILL_PREVIEW_OUTCOME_DROP_BUFFER_FULL,
+		}, preview(enumspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED))
+		s.Equal([]enumsspb.BackfillPreviewOutcome{
+			enumsspb.BACKFILL_PREVIEW_OUTCOME_BUFFER,
+			enumsspb.BACKFILL_PREVIEW_OUTCOME_SKIP,
+			enumsspb.BACKFILL_PREVIEW_OUTCOME_DROP_BUFFER_FULL,
+		}, preview(enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE))
+		s.Equal([]enumsspb.BackfillPreviewOutcome{
+			enumsspb.BACKFILL_PREVIEW_OUTCOME_BUFFER,
+			enumsspb.BACKFILL_PREVIEW_OUTCOME_BUFFER,
+			enumsspb.BACKFILL_PREVIEW_OUTCOME_DROP_BUFFER_FULL,
+		}, preview(enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ALL))
+		s.Equal([]enumsspb.BackfillPreviewOutcome{
+			enumsspb.BACKFILL_PREVIEW_OUTCOME_SKIP,
+			enumsspb.BACKFILL_PREVIEW_OUTCOME_CANCEL_OTHER,
+			enumsspb.BACKFILL_PREVIEW_OUTCOME_DROP_BUFFER_FULL,
+		}, preview(enumspb.SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER))
+		s.Equal([]enumsspb.BackfillPreviewOutcome{
+			enumsspb.BACKFILL_PREVIEW_OUTCOME_START_OVERLAPPING,
+			enumsspb.BACKFILL_PREVIEW_OUTCOME_START_OVERLAPPING,
+			enumsspb.BACKFILL_PREVIEW_OUTCOME_DROP_BUFFER_FULL,
+		}, preview(enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL))
+		// nothing was started or buffered
+		s.Equal([]string{"myid-2022-06-01T00:15:00Z"}, s.runningWorkflows())
+		s.Zero(s.describe().Info.BufferSize)
+	}, 30*time.Minute)
+
+	s.run(&schedpb.Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			Interval: []*schedpb.IntervalSpec{{
+				Interval: durationpb.New(55 * time.Minute),
+			}},
+		},
+	}, 2)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) resolvedPolicy(overlapPolicy enumspb.ScheduleOverlapPolicy) enumspb.ScheduleOverlapPolicy {
+	if overlapPolicy == enumspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+		return enumspb.SCHEDULE_OVERLAP_POLICY_SKIP
+	}
+	return overlapPolicy
+}
+
 func (s *workflowSuite) TestBackfillInclusiveStartEnd() {
 	// TODO: remove once default version is InclusiveBackfillStartTime
 	currentVersion := currentTweakablePolicies.Version
@@ -1783,6 +2115,9 @@ func (s *workflowSuite) TestCANByIterations() {
 		s.Fail("too many starts")
 		return nil, nil
 	}).Times(0).Maybe()
+	// runs under ALLOW_ALL aren't tracked, but are watched on refresh to close their run history entries
+	s.env.OnActivity(new(activities).WatchWorkflow, mock.Anything, mock.Anything).Maybe().Return(
+		&schedspb.WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING}, nil)
 
 	// this is ignored because we set iters explicitly
 	s.env.RegisterDelayedCallback(func() {
@@ -1820,6 +2155,8 @@ func (s *workflowSuite) TestCANBySuggested() {
 		s.Fail("too many starts", req.Request.WorkflowId)
 		return nil, nil
 	}).Times(0).Maybe()
+	s.env.OnActivity(new(activities).WatchWorkflow, mock.Anything, mock.Anything).Maybe().Return(
+		&schedspb.WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING}, nil)
 
 	s.env.RegisterDelayedCallback(func() {
 		s.env.SetContinueAsNewSuggested(true)
@@ -1856,6 +2193,8 @@ func (s *workflowSuite) TestCANBySignal() {
 		s.Fail("too many starts", req.Request.WorkflowId)
 		return nil, nil
 	}).Times(0).Maybe()
+	s.env.OnActivity(new(activities).WatchWorkflow, mock.Anything, mock.Anything).Maybe().Return(
+		&schedspb.WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING}, nil)
 
 	s.env.RegisterDelayedCallback(func() {
 		s.env.SignalWorkflow(SignalNameForceCAN, nil)
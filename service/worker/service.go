@@ -116,6 +116,15 @@ type (
 		EnableReadFromSecondaryVisibility dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		VisibilityDisableOrderByClause    dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		VisibilityEnableManualPagination  dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		VisibilityMaxCountGroups          dynamicconfig.IntPropertyFnWithNamespaceFilter
+
+		// ES processor is only used by the visibility migration workflow to backfill secondary visibility.
+		IndexerConcurrency       dynamicconfig.IntPropertyFn
+		ESProcessorNumOfWorkers  dynamicconfig.IntPropertyFn
+		ESProcessorBulkActions   dynamicconfig.IntPropertyFn // max number of requests in bulk
+		ESProcessorBulkSize      dynamicconfig.IntPropertyFn // max total size of bytes in bulk
+		ESProcessorFlushInterval dynamicconfig.DurationPropertyFn
+		ESProcessorAckTimeout    dynamicconfig.DurationPropertyFn
 	}
 )
 
@@ -334,6 +343,14 @@ func NewConfig(
 		EnableReadFromSecondaryVisibility: visibility.GetEnableReadFromSecondaryVisibilityConfig(dc, visibilityStoreConfigExist, enableReadFromES),
 		VisibilityDisableOrderByClause:    dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.VisibilityDisableOrderByClause, true),
 		VisibilityEnableManualPagination:  dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.VisibilityEnableManualPagination, true),
+		VisibilityMaxCountGroups:          dc.GetIntPropertyFilteredByNamespace(dynamicconfig.VisibilityMaxCountGroups, 1000),
+
+		IndexerConcurrency:       dc.GetIntProperty(dynamicconfig.WorkerIndexerConcurrency, 100),
+		ESProcessorNumOfWorkers:  dc.GetIntProperty(dynamicconfig.WorkerESProcessorNumOfWorkers, 2),
+		ESProcessorBulkActions:   dc.GetIntProperty(dynamicconfig.WorkerESProcessorBulkActions, 500),
+		ESProcessorBulkSize:      dc.GetIntProperty(dynamicconfig.WorkerESProcessorBulkSize, 16*1024*1024),
+		ESProcessorFlushInterval: dc.GetDurationProperty(dynamicconfig.WorkerESProcessorFlushInterval, 1*time.Second),
+		ESProcessorAckTimeout:    dc.GetDurationProperty(dynamicconfig.WorkerESProcessorAckTimeout, 30*time.Second),
 	}
 	return config
 }
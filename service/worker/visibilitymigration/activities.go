@@ -0,0 +1,448 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	// maxReportedDivergences limits the number of divergent executions described in VerifyActivityResult.
+	maxReportedDivergences = 10
+
+	divergenceMissing = "missing in secondary visibility"
+)
+
+type (
+	activities struct {
+		// primaryVisibility and secondaryVisibility are nil if secondary visibility is not configured.
+		primaryVisibility   manager.VisibilityManager
+		secondaryVisibility manager.VisibilityManager
+		metadataManager     persistence.MetadataManager
+		namespaceRegistry   namespace.Registry
+		autoCutover         dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		metricsHandler      metrics.Handler
+		logger              log.Logger
+	}
+
+	BackfillActivityParams struct {
+		Namespace     namespace.Name
+		PageSize      int
+		RPS           int
+		NextPageToken []byte
+	}
+
+	BackfillActivityResult struct {
+		SuccessCount  int
+		ErrorCount    int
+		NextPageToken []byte
+	}
+
+	VerifyActivityParams struct {
+		Namespace      namespace.Name
+		SampleSize     int
+		CountTolerance float64
+	}
+
+	VerifyActivityResult struct {
+		SampledCount    int
+		MissingCount    int
+		MismatchedCount int
+		// CountCompared is false if one of the stores doesn't support CountWorkflowExecutions.
+		CountCompared  bool
+		CountDiverged  bool
+		PrimaryCount   int64
+		SecondaryCount int64
+		// Divergences describes up to maxReportedDivergences divergent executions.
+		Divergences []string
+	}
+)
+
+var (
+	errSecondaryVisibilityNotConfigured = temporal.NewNonRetryableApplicationError("secondary visibility store is not configured", "", nil)
+)
+
+func newActivities(
+	visibilityManager manager.VisibilityManager,
+	metadataManager persistence.MetadataManager,
+	namespaceRegistry namespace.Registry,
+	autoCutover dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *activities {
+	a := &activities{
+		metadataManager:   metadataManager,
+		namespaceRegistry: namespaceRegistry,
+		autoCutover:       autoCutover,
+		metricsHandler:    metricsHandler.WithTags(metrics.OperationTag(metrics.VisibilityMigrationWorkflowScope)),
+		logger:            logger,
+	}
+	if dualVisibilityManager, ok := visibilityManager.(*visibility.VisibilityManagerDual); ok {
+		a.primaryVisibility = dualVisibilityManager.GetPrimaryVisibility()
+		a.secondaryVisibility = dualVisibilityManager.GetSecondaryVisibility()
+	}
+	return a
+}
+
+func (r VerifyActivityResult) IsClean() bool {
+	return r.MissingCount == 0 && r.MismatchedCount == 0 && !r.CountDiverged
+}
+
+func (a *activities) BackfillActivity(ctx context.Context, params BackfillActivityParams) (BackfillActivityResult, error) {
+	ctx = headers.SetCallerName(ctx, params.Namespace.String())
+
+	var result BackfillActivityResult
+	if a.secondaryVisibility == nil {
+		return result, errSecondaryVisibilityNotConfigured
+	}
+	nsID, err := a.namespaceRegistry.GetNamespaceID(params.Namespace)
+	if err != nil {
+		return result, err
+	}
+
+	req := &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   nsID,
+		Namespace:     params.Namespace,
+		PageSize:      params.PageSize,
+		NextPageToken: params.NextPageToken,
+	}
+	resp, err := a.primaryVisibility.ScanWorkflowExecutions(ctx, req)
+	if errors.Is(err, store.OperationNotSupportedErr) {
+		// Standard visibility doesn't support scan, but lists all executions if query is empty.
+		resp, err = a.primaryVisibility.ListWorkflowExecutions(ctx, req)
+	}
+	if err != nil {
+		a.metricsHandler.Counter(metrics.ListExecutionsFailuresCount.Name()).Record(1)
+		a.logger.Error("Unable to scan primary visibility.", tag.WorkflowNamespace(params.Namespace.String()), tag.Error(err))
+		return result, err
+	}
+
+	rateLimiter := quotas.NewRateLimiter(float64(params.RPS), params.RPS)
+	for _, execution := range resp.Executions {
+		err = rateLimiter.Wait(ctx)
+		if err != nil {
+			a.metricsHandler.Counter(metrics.RateLimiterFailuresCount.Name()).Record(1)
+			a.logger.Error("Backfill rate limiter error.", tag.WorkflowNamespace(params.Namespace.String()), tag.Error(err))
+			return result, err
+		}
+
+		err = a.backfillExecution(ctx, nsID, params.Namespace, execution)
+		switch err.(type) {
+		case nil:
+			result.SuccessCount++
+			a.metricsHandler.Counter(metrics.VisibilityMigrationBackfillSuccessCount.Name()).Record(1)
+		case *serviceerror.InvalidArgument:
+			// Record is rejected by secondary visibility and retry won't help. It will be reported by verification.
+			result.ErrorCount++
+			a.metricsHandler.Counter(metrics.VisibilityMigrationBackfillFailuresCount.Name()).Record(1)
+			a.logger.Warn("Unable to backfill workflow execution.", tag.WorkflowNamespace(params.Namespace.String()), tag.WorkflowID(execution.Execution.GetWorkflowId()), tag.WorkflowRunID(execution.Execution.GetRunId()), tag.Error(err))
+		default:
+			// Writes are idempotent, therefore the whole page is retried.
+			a.metricsHandler.Counter(metrics.VisibilityMigrationBackfillFailuresCount.Name()).Record(1)
+			a.logger.Error("Unable to backfill workflow execution.", tag.WorkflowNamespace(params.Namespace.String()), tag.WorkflowID(execution.Execution.GetWorkflowId()), tag.WorkflowRunID(execution.Execution.GetRunId()), tag.Error(err))
+			return result, err
+		}
+		activity.RecordHeartbeat(ctx, result)
+	}
+
+	result.NextPageToken = resp.NextPageToken
+	return result, nil
+}
+
+func (a *activities) backfillExecution(
+	ctx context.Context,
+	nsID namespace.ID,
+	nsName namespace.Name,
+	execution *workflowpb.WorkflowExecutionInfo,
+) error {
+	// TaskID is left empty: Elasticsearch rejects versions which are not greater than the existing one,
+	// so records written by history service are never overwritten with backfilled ones.
+	requestBase := &manager.VisibilityRequestBase{
+		NamespaceID:      nsID,
+		Namespace:        nsName,
+		Execution:        execution.GetExecution(),
+		WorkflowTypeName: execution.GetType().GetName(),
+		StartTime:        execution.GetStartTime().AsTime(),
+		Status:           execution.GetStatus(),
+		ExecutionTime:    execution.GetExecutionTime().AsTime(),
+		Memo:             execution.GetMemo(),
+		TaskQueue:        execution.GetTaskQueue(),
+		SearchAttributes: execution.GetSearchAttributes(),
+		ParentExecution:  execution.GetParentExecution(),
+	}
+
+	if execution.GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		// Started record is only inserted if it doesn't exist yet.
+		return a.secondaryVisibility.RecordWorkflowExecutionStarted(ctx, &manager.RecordWorkflowExecutionStartedRequest{
+			VisibilityRequestBase: requestBase,
+		})
+	}
+	return a.secondaryVisibility.RecordWorkflowExecutionClosed(ctx, &manager.RecordWorkflowExecutionClosedRequest{
+		VisibilityRequestBase: requestBase,
+		CloseTime:             execution.GetCloseTime().AsTime(),
+		HistoryLength:         execution.GetHistoryLength(),
+		HistorySizeBytes:      execution.GetHistorySizeBytes(),
+		StateTransitionCount:  execution.GetStateTransitionCount(),
+	})
+}
+
+func (a *activities) VerifyActivity(ctx context.Context, params VerifyActivityParams) (VerifyActivityResult, error) {
+	ctx = headers.SetCallerName(ctx, params.Namespace.String())
+
+	var result VerifyActivityResult
+	if a.secondaryVisibility == nil {
+		return result, errSecondaryVisibilityNotConfigured
+	}
+	nsID, err := a.namespaceRegistry.GetNamespaceID(params.Namespace)
+	if err != nil {
+		return result, err
+	}
+
+	// Sample the most recent executions: they are most likely to be affected by dual write issues.
+	resp, err := a.primaryVisibility.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: nsID,
+		Namespace:   params.Namespace,
+		PageSize:    params.SampleSize,
+	})
+	if err != nil {
+		a.metricsHandler.Counter(metrics.ListExecutionsFailuresCount.Name()).Record(1)
+		a.logger.Error("Unable to list primary visibility.", tag.WorkflowNamespace(params.Namespace.String()), tag.Error(err))
+		return result, err
+	}
+
+	for _, execution := range resp.Executions {
+		result.SampledCount++
+		divergence, err := a.verifyExecution(ctx, nsID, params.Namespace, execution)
+		if err != nil {
+			return result, err
+		}
+		switch divergence {
+		case "":
+		case divergenceMissing:
+			result.MissingCount++
+		default:
+			result.MismatchedCount++
+		}
+		if divergence != "" && len(result.Divergences) < maxReportedDivergences {
+			result.Divergences = append(result.Divergences, fmt.Sprintf("%s/%s: %s", execution.Execution.GetWorkflowId(), execution.Execution.GetRunId(), divergence))
+		}
+		activity.RecordHeartbeat(ctx, result.SampledCount)
+	}
+
+	if err = a.verifyCount(ctx, nsID, params, &result); err != nil {
+		return result, err
+	}
+
+	a.metricsHandler.Counter(metrics.VisibilityMigrationSampledCount.Name()).Record(int64(result.SampledCount))
+	if !result.IsClean() {
+		divergenceCount := result.MissingCount + result.MismatchedCount
+		if result.CountDiverged {
+			divergenceCount++
+		}
+		a.metricsHandler.Counter(metrics.VisibilityMigrationDivergenceCount.Name()).Record(int64(divergenceCount))
+		a.logger.Warn("Secondary visibility diverged from primary visibility.",
+			tag.WorkflowNamespace(params.Namespace.String()),
+			tag.NewInt("missing", result.MissingCount),
+			tag.NewInt("mismatched", result.MismatchedCount),
+			tag.NewInt64("primary-count", result.PrimaryCount),
+			tag.NewInt64("secondary-count", result.SecondaryCount),
+			tag.NewStringsTag("divergences", result.Divergences))
+	}
+	return result, nil
+}
+
+// verifyExecution returns description of divergence between primary and secondary record of the execution or empty string.
+func (a *activities) verifyExecution(
+	ctx context.Context,
+	nsID namespace.ID,
+	nsName namespace.Name,
+	execution *workflowpb.WorkflowExecutionInfo,
+) (string, error) {
+	req := &manager.GetWorkflowExecutionRequest{
+		NamespaceID: nsID,
+		Namespace:   nsName,
+		WorkflowID:  execution.Execution.GetWorkflowId(),
+		RunID:       execution.Execution.GetRunId(),
+		StartTime:   execution.GetStartTime().AsTime(),
+	}
+	if execution.GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		req.CloseTime = execution.GetCloseTime().AsTime()
+	}
+
+	secondaryResp, err := a.secondaryVisibility.GetWorkflowExecution(ctx, req)
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		return divergenceMissing, nil
+	default:
+		return "", err
+	}
+
+	mismatch := diffExecutions(execution, secondaryResp.Execution)
+	if mismatch == "" {
+		return "", nil
+	}
+
+	// Execution might have been updated after it was listed: compare with its latest primary record.
+	primaryResp, err := a.primaryVisibility.GetWorkflowExecution(ctx, req)
+	switch err.(type) {
+	case nil:
+		return diffExecutions(primaryResp.Execution, secondaryResp.Execution), nil
+	case *serviceerror.NotFound, *serviceerror.InvalidArgument:
+		// Execution was deleted or primary visibility doesn't support GetWorkflowExecution for this record.
+		return mismatch, nil
+	default:
+		return "", err
+	}
+}
+
+func (a *activities) verifyCount(
+	ctx context.Context,
+	nsID namespace.ID,
+	params VerifyActivityParams,
+	result *VerifyActivityResult,
+) error {
+	req := &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: nsID,
+		Namespace:   params.Namespace,
+	}
+	primaryResp, err := a.primaryVisibility.CountWorkflowExecutions(ctx, req)
+	if errors.Is(err, store.OperationNotSupportedErr) {
+		return nil
+	}
+	if err != nil {
+		a.metricsHandler.Counter(metrics.CountExecutionsFailuresCount.Name()).Record(1)
+		return err
+	}
+	secondaryResp, err := a.secondaryVisibility.CountWorkflowExecutions(ctx, req)
+	if errors.Is(err, store.OperationNotSupportedErr) {
+		return nil
+	}
+	if err != nil {
+		a.metricsHandler.Counter(metrics.CountExecutionsFailuresCount.Name()).Record(1)
+		return err
+	}
+
+	result.CountCompared = true
+	result.PrimaryCount = primaryResp.Count
+	result.SecondaryCount = secondaryResp.Count
+	maxDiff := params.CountTolerance * float64(primaryResp.Count)
+	result.CountDiverged = math.Abs(float64(primaryResp.Count-secondaryResp.Count)) > maxDiff
+	return nil
+}
+
+// diffExecutions compares fields which are set by all visibility stores.
+func diffExecutions(primary *workflowpb.WorkflowExecutionInfo, secondary *workflowpb.WorkflowExecutionInfo) string {
+	switch {
+	case primary.GetType().GetName() != secondary.GetType().GetName():
+		return fmt.Sprintf("workflow type %q != %q", primary.GetType().GetName(), secondary.GetType().GetName())
+	case primary.GetStatus() != secondary.GetStatus():
+		return fmt.Sprintf("status %v != %v", primary.GetStatus(), secondary.GetStatus())
+	case !equalTime(primary.GetStartTime().AsTime(), secondary.GetStartTime().AsTime()):
+		return fmt.Sprintf("start time %v != %v", primary.GetStartTime().AsTime(), secondary.GetStartTime().AsTime())
+	case primary.GetTaskQueue() != secondary.GetTaskQueue():
+		return fmt.Sprintf("task queue %q != %q", primary.GetTaskQueue(), secondary.GetTaskQueue())
+	}
+	if primary.GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return ""
+	}
+	switch {
+	case !equalTime(primary.GetCloseTime().AsTime(), secondary.GetCloseTime().AsTime()):
+		return fmt.Sprintf("close time %v != %v", primary.GetCloseTime().AsTime(), secondary.GetCloseTime().AsTime())
+	case primary.GetHistoryLength() != secondary.GetHistoryLength():
+		return fmt.Sprintf("history length %d != %d", primary.GetHistoryLength(), secondary.GetHistoryLength())
+	}
+	return ""
+}
+
+// equalTime compares timestamps with millisecond precision which is supported by all visibility stores.
+func equalTime(t1 time.Time, t2 time.Time) bool {
+	return t1.Truncate(time.Millisecond).Equal(t2.Truncate(time.Millisecond))
+}
+
+func (a *activities) CutoverActivity(ctx context.Context, nsName namespace.Name) (bool, error) {
+	ctx = headers.SetCallerName(ctx, nsName.String())
+
+	if !a.autoCutover(nsName.String()) {
+		a.logger.Info("Visibility stores are in parity, but auto cutover is disabled.", tag.WorkflowNamespace(nsName.String()))
+		return false, nil
+	}
+
+	metadata, err := a.metadataManager.GetMetadata(ctx)
+	if err != nil {
+		a.metricsHandler.Counter(metrics.ReadNamespaceFailuresCount.Name()).Record(1)
+		a.logger.Error("Unable to get cluster metadata.", tag.WorkflowNamespace(nsName.String()), tag.Error(err))
+		return false, err
+	}
+
+	ns, err := a.metadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{
+		Name: nsName.String(),
+	})
+	if err != nil {
+		a.metricsHandler.Counter(metrics.ReadNamespaceFailuresCount.Name()).Record(1)
+		a.logger.Error("Unable to get namespace details.", tag.WorkflowNamespace(nsName.String()), tag.Error(err))
+		return false, err
+	}
+
+	if ns.Namespace.Info.Data == nil {
+		ns.Namespace.Info.Data = make(map[string]string)
+	}
+	ns.Namespace.Info.Data[visibility.SecondaryVisibilityReadCutoverDataKey] = "true"
+
+	err = a.metadataManager.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace:           ns.Namespace,
+		IsGlobalNamespace:   ns.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	if err != nil {
+		a.metricsHandler.Counter(metrics.UpdateNamespaceFailuresCount.Name()).Record(1)
+		a.logger.Error("Unable to switch namespace reads to secondary visibility.", tag.WorkflowNamespace(nsName.String()), tag.Error(err))
+		return false, err
+	}
+
+	a.metricsHandler.Counter(metrics.VisibilityMigrationCutoverCount.Name()).Record(1)
+	a.logger.Info("Namespace reads switched to secondary visibility.", tag.WorkflowNamespace(nsName.String()))
+	return true, nil
+}
@@ -0,0 +1,296 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/testsuite"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
+)
+
+type testActivities struct {
+	*activities
+	primaryVisibility   *manager.MockVisibilityManager
+	secondaryVisibility *manager.MockVisibilityManager
+	metadataManager     *persistence.MockMetadataManager
+}
+
+func newTestActivities(t *testing.T, autoCutover bool) *testActivities {
+	ctrl := gomock.NewController(t)
+	primaryVisibility := manager.NewMockVisibilityManager(ctrl)
+	secondaryVisibility := manager.NewMockVisibilityManager(ctrl)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespaceID(namespace.Name("namespace")).Return(namespace.ID("namespace-id"), nil).AnyTimes()
+
+	return &testActivities{
+		activities: newActivities(
+			visibility.NewVisibilityManagerDual(primaryVisibility, secondaryVisibility, nil),
+			metadataManager,
+			namespaceRegistry,
+			dynamicconfig.GetBoolPropertyFnFilteredByNamespace(autoCutover),
+			metrics.NoopMetricsHandler,
+			log.NewNoopLogger(),
+		),
+		primaryVisibility:   primaryVisibility,
+		secondaryVisibility: secondaryVisibility,
+		metadataManager:     metadataManager,
+	}
+}
+
+func newExecution(workflowID string, status enumspb.WorkflowExecutionStatus, startTime time.Time) *workflowpb.WorkflowExecutionInfo {
+	execution := &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: workflowID + "-run"},
+		Type:      &commonpb.WorkflowType{Name: "workflow-type"},
+		StartTime: timestamppb.New(startTime),
+		Status:    status,
+		TaskQueue: "task-queue",
+	}
+	if status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		execution.CloseTime = timestamppb.New(startTime.Add(time.Minute))
+		execution.HistoryLength = 10
+	}
+	return execution
+}
+
+func Test_BackfillActivity(t *testing.T) {
+	a := newTestActivities(t, false)
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	running := newExecution("wf-1", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, startTime)
+	completed := newExecution("wf-2", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, startTime)
+	rejected := newExecution("wf-3", enumspb.WORKFLOW_EXECUTION_STATUS_FAILED, startTime)
+
+	listRequest := &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   "namespace-id",
+		Namespace:     "namespace",
+		PageSize:      3,
+		NextPageToken: []byte{1},
+	}
+	a.primaryVisibility.EXPECT().ScanWorkflowExecutions(gomock.Any(), listRequest).Return(nil, store.OperationNotSupportedErr)
+	a.primaryVisibility.EXPECT().ListWorkflowExecutions(gomock.Any(), listRequest).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions:    []*workflowpb.WorkflowExecutionInfo{running, completed, rejected},
+		NextPageToken: []byte{2},
+	}, nil)
+	a.secondaryVisibility.EXPECT().RecordWorkflowExecutionStarted(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.RecordWorkflowExecutionStartedRequest) error {
+			require.Equal(t, namespace.ID("namespace-id"), request.NamespaceID)
+			require.Equal(t, "wf-1", request.Execution.GetWorkflowId())
+			require.Equal(t, "workflow-type", request.WorkflowTypeName)
+			require.Equal(t, startTime, request.StartTime)
+			return nil
+		})
+	a.secondaryVisibility.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.RecordWorkflowExecutionClosedRequest) error {
+			require.Equal(t, "wf-2", request.Execution.GetWorkflowId())
+			require.Equal(t, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, request.Status)
+			require.Equal(t, startTime.Add(time.Minute), request.CloseTime)
+			require.Equal(t, int64(10), request.HistoryLength)
+			return nil
+		})
+	a.secondaryVisibility.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).Return(serviceerror.NewInvalidArgument("invalid search attribute"))
+
+	env := (&testsuite.WorkflowTestSuite{}).NewTestActivityEnvironment()
+	env.RegisterActivity(a.activities)
+	val, err := env.ExecuteActivity(a.BackfillActivity, BackfillActivityParams{
+		Namespace:     "namespace",
+		PageSize:      3,
+		RPS:           100,
+		NextPageToken: []byte{1},
+	})
+	require.NoError(t, err)
+	var result BackfillActivityResult
+	require.NoError(t, val.Get(&result))
+	require.Equal(t, BackfillActivityResult{SuccessCount: 2, ErrorCount: 1, NextPageToken: []byte{2}}, result)
+}
+
+func Test_BackfillActivity_SecondaryVisibilityNotConfigured(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	a := newActivities(
+		manager.NewMockVisibilityManager(ctrl),
+		persistence.NewMockMetadataManager(ctrl),
+		namespace.NewMockRegistry(ctrl),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+
+	_, err := a.BackfillActivity(context.Background(), BackfillActivityParams{Namespace: "namespace"})
+	require.ErrorIs(t, err, errSecondaryVisibilityNotConfigured)
+}
+
+func Test_VerifyActivity(t *testing.T) {
+	a := newTestActivities(t, false)
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	inParity := newExecution("wf-1", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, startTime)
+	missing := newExecution("wf-2", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, startTime)
+	mismatched := newExecution("wf-3", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, startTime)
+	updated := newExecution("wf-4", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, startTime)
+
+	a.primaryVisibility.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: "namespace-id",
+		Namespace:   "namespace",
+		PageSize:    4,
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{inParity, missing, mismatched, updated},
+	}, nil)
+
+	getRequest := func(execution *workflowpb.WorkflowExecutionInfo) *manager.GetWorkflowExecutionRequest {
+		request := &manager.GetWorkflowExecutionRequest{
+			NamespaceID: "namespace-id",
+			Namespace:   "namespace",
+			WorkflowID:  execution.Execution.GetWorkflowId(),
+			RunID:       execution.Execution.GetRunId(),
+			StartTime:   startTime,
+		}
+		if execution.Status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			request.CloseTime = execution.CloseTime.AsTime()
+		}
+		return request
+	}
+
+	// Secondary visibility stores timestamps with lower precision.
+	inParitySecondary := newExecution("wf-1", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, startTime)
+	inParitySecondary.StartTime = timestamppb.New(startTime.Add(100 * time.Microsecond))
+	a.secondaryVisibility.EXPECT().GetWorkflowExecution(gomock.Any(), getRequest(inParity)).Return(
+		&manager.GetWorkflowExecutionResponse{Execution: inParitySecondary}, nil)
+
+	a.secondaryVisibility.EXPECT().GetWorkflowExecution(gomock.Any(), getRequest(missing)).Return(
+		nil, serviceerror.NewNotFound("not found"))
+
+	mismatchedSecondary := newExecution("wf-3", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, startTime)
+	mismatchedSecondary.HistoryLength = 5
+	a.secondaryVisibility.EXPECT().GetWorkflowExecution(gomock.Any(), getRequest(mismatched)).Return(
+		&manager.GetWorkflowExecutionResponse{Execution: mismatchedSecondary}, nil)
+	a.primaryVisibility.EXPECT().GetWorkflowExecution(gomock.Any(), getRequest(mismatched)).Return(
+		&manager.GetWorkflowExecutionResponse{Execution: mismatched}, nil)
+
+	// Execution was closed after it was listed from primary visibility.
+	updatedClosed := newExecution("wf-4", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, startTime)
+	a.secondaryVisibility.EXPECT().GetWorkflowExecution(gomock.Any(), getRequest(updated)).Return(
+		&manager.GetWorkflowExecutionResponse{Execution: updatedClosed}, nil)
+	a.primaryVisibility.EXPECT().GetWorkflowExecution(gomock.Any(), getRequest(updated)).Return(
+		&manager.GetWorkflowExecutionResponse{Execution: updatedClosed}, nil)
+
+	countRequest := &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: "namespace-id",
+		Namespace:   "namespace",
+	}
+	a.primaryVisibility.EXPECT().CountWorkflowExecutions(gomock.Any(), countRequest).Return(&manager.CountWorkflowExecutionsResponse{Count: 1000}, nil)
+	a.secondaryVisibility.EXPECT().CountWorkflowExecutions(gomock.Any(), countRequest).Return(&manager.CountWorkflowExecutionsResponse{Count: 999}, nil)
+
+	env := (&testsuite.WorkflowTestSuite{}).NewTestActivityEnvironment()
+	env.RegisterActivity(a.activities)
+	val, err := env.ExecuteActivity(a.VerifyActivity, VerifyActivityParams{
+		Namespace:      "namespace",
+		SampleSize:     4,
+		CountTolerance: 0.01,
+	})
+	require.NoError(t, err)
+	var result VerifyActivityResult
+	require.NoError(t, val.Get(&result))
+	require.Equal(t, VerifyActivityResult{
+		SampledCount:    4,
+		MissingCount:    1,
+		MismatchedCount: 1,
+		CountCompared:   true,
+		CountDiverged:   false,
+		PrimaryCount:    1000,
+		SecondaryCount:  999,
+		Divergences: []string{
+			"wf-2/wf-2-run: missing in secondary visibility",
+			"wf-3/wf-3-run: history length 10 != 5",
+		},
+	}, result)
+	require.False(t, result.IsClean())
+}
+
+func Test_VerifyActivity_CountNotSupported(t *testing.T) {
+	a := newTestActivities(t, false)
+
+	a.primaryVisibility.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.ListWorkflowExecutionsResponse{}, nil)
+	a.primaryVisibility.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(nil, store.OperationNotSupportedErr)
+
+	env := (&testsuite.WorkflowTestSuite{}).NewTestActivityEnvironment()
+	env.RegisterActivity(a.activities)
+	val, err := env.ExecuteActivity(a.VerifyActivity, VerifyActivityParams{
+		Namespace:  "namespace",
+		SampleSize: 100,
+	})
+	require.NoError(t, err)
+	var result VerifyActivityResult
+	require.NoError(t, val.Get(&result))
+	require.False(t, result.CountCompared)
+	require.True(t, result.IsClean())
+}
+
+func Test_CutoverActivity(t *testing.T) {
+	a := newTestActivities(t, true)
+
+	a.metadataManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	a.metadataManager.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: "namespace",
+	}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{Id: "namespace-id", Name: "namespace"},
+		},
+		IsGlobalNamespace: true,
+	}, nil)
+	a.metadataManager.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			require.Equal(t, "true", request.Namespace.Info.Data[visibility.SecondaryVisibilityReadCutoverDataKey])
+			require.True(t, request.IsGlobalNamespace)
+			require.Equal(t, int64(7), request.NotificationVersion)
+			return nil
+		})
+
+	cutover, err := a.CutoverActivity(context.Background(), "namespace")
+	require.NoError(t, err)
+	require.True(t, cutover)
+}
+
+func Test_CutoverActivity_Disabled(t *testing.T) {
+	a := newTestActivities(t, false)
+
+	cutover, err := a.CutoverActivity(context.Background(), "namespace")
+	require.NoError(t, err)
+	require.False(t, cutover)
+}
@@ -0,0 +1,241 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives"
+)
+
+const (
+	WorkflowName = "temporal-sys-visibility-migration-workflow"
+
+	// ProgressQueryType returns MigrationProgress of the running workflow.
+	ProgressQueryType = "progress"
+
+	defaultPageSize                   = 1000
+	defaultBackfillRPS                = 100
+	defaultPagesPerExecution          = 256
+	defaultSampleSize                 = 100
+	defaultVerifyInterval             = 10 * time.Minute
+	defaultRequiredCleanVerifications = 6
+	defaultVerificationsPerExecution  = 100
+)
+
+type (
+	VisibilityMigrationParams struct {
+		Namespace namespace.Name
+
+		// Page size to read executions from primary visibility during backfill.
+		PageSize int
+		// Max number of records written to secondary visibility per second during backfill.
+		BackfillRPS int
+		// Number of backfilled pages before returning ContinueAsNew.
+		PagesPerExecution int
+
+		// Number of the most recent executions which are compared between both stores on every verification.
+		SampleSize int
+		// Interval between two verifications.
+		VerifyInterval time.Duration
+		// Number of consecutive verifications without divergence required before reads are switched
+		// to secondary visibility. Cutover also requires worker.visibilityMigrationAutoCutover to be enabled.
+		RequiredCleanVerifications int
+		// Max relative difference between primary and secondary execution counts which is not reported as divergence.
+		// Default is 0, means, counts must be equal.
+		CountTolerance float64
+		// Number of verifications before returning ContinueAsNew.
+		VerificationsPerExecution int
+
+		// Progress is carried over ContinueAsNew and must not be set by the caller.
+		Progress MigrationProgress
+	}
+
+	MigrationProgress struct {
+		BackfillCompleted     bool
+		BackfillNextPageToken []byte
+		BackfillSuccessCount  int
+		BackfillErrorCount    int
+
+		VerificationCount             int
+		ConsecutiveCleanVerifications int
+		LastVerification              *VerifyActivityResult
+
+		CutoverCompleted bool
+	}
+)
+
+var (
+	activityRetryPolicy = &temporal.RetryPolicy{
+		InitialInterval: 1 * time.Second,
+		MaximumInterval: 1 * time.Minute,
+	}
+
+	backfillActivityOptions = workflow.ActivityOptions{
+		RetryPolicy:         activityRetryPolicy,
+		StartToCloseTimeout: 10 * time.Minute,
+		HeartbeatTimeout:    30 * time.Second,
+	}
+
+	verifyActivityOptions = workflow.ActivityOptions{
+		RetryPolicy:         activityRetryPolicy,
+		StartToCloseTimeout: 5 * time.Minute,
+		HeartbeatTimeout:    30 * time.Second,
+	}
+
+	cutoverActivityOptions = workflow.ActivityOptions{
+		RetryPolicy:            activityRetryPolicy,
+		StartToCloseTimeout:    30 * time.Second,
+		ScheduleToCloseTimeout: 5 * time.Minute,
+	}
+)
+
+func validateParams(params *VisibilityMigrationParams) error {
+	if params.Namespace.IsEmpty() {
+		return temporal.NewNonRetryableApplicationError("namespace is required", "", nil)
+	}
+	if params.CountTolerance < 0 || params.CountTolerance >= 1 {
+		return temporal.NewNonRetryableApplicationError("count tolerance must be in [0, 1) range", "", nil)
+	}
+
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+	if params.BackfillRPS <= 0 {
+		params.BackfillRPS = defaultBackfillRPS
+	}
+	if params.PagesPerExecution <= 0 {
+		params.PagesPerExecution = defaultPagesPerExecution
+	}
+	if params.SampleSize <= 0 {
+		params.SampleSize = defaultSampleSize
+	}
+	if params.VerifyInterval <= 0 {
+		params.VerifyInterval = defaultVerifyInterval
+	}
+	if params.RequiredCleanVerifications <= 0 {
+		params.RequiredCleanVerifications = defaultRequiredCleanVerifications
+	}
+	if params.VerificationsPerExecution <= 0 {
+		params.VerificationsPerExecution = defaultVerificationsPerExecution
+	}
+	return nil
+}
+
+// VisibilityMigrationWorkflow backfills secondary visibility of a namespace from primary visibility,
+// then keeps comparing records between both stores and, once they are in parity, switches reads
+// of the namespace to secondary visibility.
+func VisibilityMigrationWorkflow(ctx workflow.Context, params VisibilityMigrationParams) (MigrationProgress, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Workflow started.", tag.WorkflowType(WorkflowName), tag.WorkflowNamespace(params.Namespace.String()))
+
+	if err := validateParams(&params); err != nil {
+		return params.Progress, err
+	}
+	progress := &params.Progress
+
+	if err := workflow.SetQueryHandler(ctx, ProgressQueryType, func() (MigrationProgress, error) {
+		return *progress, nil
+	}); err != nil {
+		return *progress, err
+	}
+
+	ctx = workflow.WithTaskQueue(ctx, primitives.VisibilityMigrationActivityTQ)
+
+	var a *activities
+
+	// Step 1. Backfill secondary visibility from primary visibility.
+	for page := 0; !progress.BackfillCompleted; page++ {
+		if page >= params.PagesPerExecution {
+			logger.Info("Backfill is not completed yet. Starting new run.", tag.Counter(progress.BackfillSuccessCount))
+			return *progress, workflow.NewContinueAsNewError(ctx, VisibilityMigrationWorkflow, params)
+		}
+
+		ctx1 := workflow.WithActivityOptions(ctx, backfillActivityOptions)
+		var backfillResult BackfillActivityResult
+		err := workflow.ExecuteActivity(ctx1, a.BackfillActivity, BackfillActivityParams{
+			Namespace:     params.Namespace,
+			PageSize:      params.PageSize,
+			RPS:           params.BackfillRPS,
+			NextPageToken: progress.BackfillNextPageToken,
+		}).Get(ctx, &backfillResult)
+		if err != nil {
+			return *progress, err
+		}
+
+		progress.BackfillSuccessCount += backfillResult.SuccessCount
+		progress.BackfillErrorCount += backfillResult.ErrorCount
+		progress.BackfillNextPageToken = backfillResult.NextPageToken
+		progress.BackfillCompleted = len(backfillResult.NextPageToken) == 0
+	}
+
+	// Step 2. Verify parity between both stores and cut reads over to secondary visibility.
+	for verification := 0; !progress.CutoverCompleted; verification++ {
+		if verification >= params.VerificationsPerExecution {
+			return *progress, workflow.NewContinueAsNewError(ctx, VisibilityMigrationWorkflow, params)
+		}
+
+		ctx2 := workflow.WithActivityOptions(ctx, verifyActivityOptions)
+		var verifyResult VerifyActivityResult
+		err := workflow.ExecuteActivity(ctx2, a.VerifyActivity, VerifyActivityParams{
+			Namespace:      params.Namespace,
+			SampleSize:     params.SampleSize,
+			CountTolerance: params.CountTolerance,
+		}).Get(ctx, &verifyResult)
+		if err != nil {
+			return *progress, err
+		}
+
+		progress.VerificationCount++
+		progress.LastVerification = &verifyResult
+		if verifyResult.IsClean() {
+			progress.ConsecutiveCleanVerifications++
+		} else {
+			progress.ConsecutiveCleanVerifications = 0
+			logger.Warn("Visibility stores diverged.", tag.Counter(verifyResult.MissingCount+verifyResult.MismatchedCount))
+		}
+
+		if progress.ConsecutiveCleanVerifications >= params.RequiredCleanVerifications {
+			ctx3 := workflow.WithActivityOptions(ctx, cutoverActivityOptions)
+			err = workflow.ExecuteActivity(ctx3, a.CutoverActivity, params.Namespace).Get(ctx, &progress.CutoverCompleted)
+			if err != nil {
+				return *progress, err
+			}
+			if progress.CutoverCompleted {
+				break
+			}
+		}
+
+		if err = workflow.Sleep(ctx, params.VerifyInterval); err != nil {
+			return *progress, err
+		}
+	}
+
+	logger.Info("Workflow finished successfully.", tag.WorkflowType(WorkflowName), tag.WorkflowNamespace(params.Namespace.String()))
+	return *progress, nil
+}
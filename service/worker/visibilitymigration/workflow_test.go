@@ -0,0 +1,109 @@
+// The MIT License
+//
+// Copyright (c) 2023 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/namespace"
+)
+
+func Test_VisibilityMigrationWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	env.OnActivity(a.BackfillActivity, mock.Anything, BackfillActivityParams{
+		Namespace: "namespace",
+		PageSize:  1000,
+		RPS:       100,
+	}).Return(BackfillActivityResult{SuccessCount: 1000, NextPageToken: []byte{1}}, nil).Once()
+	env.OnActivity(a.BackfillActivity, mock.Anything, BackfillActivityParams{
+		Namespace:     "namespace",
+		PageSize:      1000,
+		RPS:           100,
+		NextPageToken: []byte{1},
+	}).Return(BackfillActivityResult{SuccessCount: 10, ErrorCount: 1}, nil).Once()
+
+	verifyParams := VerifyActivityParams{
+		Namespace:  "namespace",
+		SampleSize: 100,
+	}
+	env.OnActivity(a.VerifyActivity, mock.Anything, verifyParams).Return(VerifyActivityResult{SampledCount: 100, MissingCount: 1}, nil).Once()
+	env.OnActivity(a.VerifyActivity, mock.Anything, verifyParams).Return(VerifyActivityResult{SampledCount: 100}, nil).Times(2)
+	env.OnActivity(a.CutoverActivity, mock.Anything, namespace.Name("namespace")).Return(true, nil).Once()
+
+	env.ExecuteWorkflow(VisibilityMigrationWorkflow, VisibilityMigrationParams{
+		Namespace:                  "namespace",
+		VerifyInterval:             time.Minute,
+		RequiredCleanVerifications: 2,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var progress MigrationProgress
+	require.NoError(t, env.GetWorkflowResult(&progress))
+	require.True(t, progress.BackfillCompleted)
+	require.Equal(t, 1010, progress.BackfillSuccessCount)
+	require.Equal(t, 1, progress.BackfillErrorCount)
+	require.Equal(t, 3, progress.VerificationCount)
+	require.Equal(t, 2, progress.ConsecutiveCleanVerifications)
+	require.True(t, progress.CutoverCompleted)
+	env.AssertExpectations(t)
+}
+
+func Test_VisibilityMigrationWorkflow_CutoverDisabled(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	var a *activities
+
+	env.OnActivity(a.BackfillActivity, mock.Anything, mock.Anything).Return(BackfillActivityResult{}, nil).Once()
+	env.OnActivity(a.VerifyActivity, mock.Anything, mock.Anything).Return(VerifyActivityResult{SampledCount: 100}, nil).Times(3)
+	env.OnActivity(a.CutoverActivity, mock.Anything, namespace.Name("namespace")).Return(false, nil).Times(2)
+
+	env.ExecuteWorkflow(VisibilityMigrationWorkflow, VisibilityMigrationParams{
+		Namespace:                  "namespace",
+		RequiredCleanVerifications: 2,
+		VerificationsPerExecution:  3,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+	env.AssertExpectations(t)
+}
+
+func Test_VisibilityMigrationWorkflow_InvalidParams(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(VisibilityMigrationWorkflow, VisibilityMigrationParams{})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), "namespace is required")
+}
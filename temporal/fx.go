@@ -128,6 +128,7 @@ type (
 		Authorizer             authorization.Authorizer
 		ClaimMapper            authorization.ClaimMapper
 		AudienceGetter         authorization.JWTAudienceMapper
+		AuditSinks             []authorization.AuditSink
 
 		// below are things that could be over write by server options or may have default if not supplied by serverOptions.
 		Logger                log.Logger
@@ -291,6 +292,7 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 		Authorizer:             so.authorizer,
 		ClaimMapper:            so.claimMapper,
 		AudienceGetter:         so.audienceGetter,
+		AuditSinks:             so.auditSinks,
 
 		Logger:                logger,
 		ClientFactoryProvider: clientFactoryProvider,
@@ -357,6 +359,7 @@ type (
 		CustomInterceptors         []grpc.UnaryServerInterceptor `group:"frontendInterceptors"`
 		Authorizer                 authorization.Authorizer
 		ClaimMapper                authorization.ClaimMapper
+		AuditSinks                 []authorization.AuditSink
 		DataStoreFactory           persistenceClient.AbstractDataStoreFactory
 		VisibilityStoreFactory     visibility.VisibilityStoreFactory
 		SpanExporters              []otelsdktrace.SpanExporter
@@ -409,6 +412,9 @@ func (params ServiceProviderParamsCommon) GetCommonServiceOptions(serviceName pr
 			func() authorization.ClaimMapper {
 				return params.ClaimMapper
 			},
+			func() []authorization.AuditSink {
+				return params.AuditSinks
+			},
 			func() encryption.TLSConfigProvider {
 				return params.TlsConfigProvider
 			},
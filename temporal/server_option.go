@@ -120,6 +120,13 @@ func WithClaimMapper(claimMapper func(cfg *config.Config) authorization.ClaimMap
 	})
 }
 
+// WithAuditSinks adds sinks receiving a record of every authorization decision made by the frontend
+func WithAuditSinks(sinks ...authorization.AuditSink) ServerOption {
+	return applyFunc(func(s *serverOptions) {
+		s.auditSinks = append(s.auditSinks, sinks...)
+	})
+}
+
 // WithAudienceGetter configures JWT audience getter for authorization
 func WithAudienceGetter(audienceGetter func(cfg *config.Config) authorization.JWTAudienceMapper) ServerOption {
 	return applyFunc(func(s *serverOptions) {
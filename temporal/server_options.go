@@ -67,6 +67,7 @@ type (
 		tlsConfigProvider            encryption.TLSConfigProvider
 		claimMapper                  authorization.ClaimMapper
 		audienceGetter               authorization.JWTAudienceMapper
+		auditSinks                   []authorization.AuditSink
 		persistenceServiceResolver   resolver.ServiceResolver
 		elasticsearchHttpClient      *http.Client
 		dynamicConfigClient          dynamicconfig.Client
@@ -441,6 +441,7 @@ func (c *temporalImpl) startFrontend(hosts map[primitives.ServiceName][]string,
 		fx.Provide(func() authorization.Authorizer { return c }),
 		fx.Provide(func() authorization.ClaimMapper { return c }),
 		fx.Provide(func() authorization.JWTAudienceMapper { return nil }),
+		fx.Provide(func() []authorization.AuditSink { return nil }),
 		fx.Provide(func() client.FactoryProvider { return client.NewFactoryProvider() }),
 		fx.Provide(func() searchattribute.Mapper { return nil }),
 		// Comment the line above and uncomment the line below to test with search attributes mapper.
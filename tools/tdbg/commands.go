@@ -693,3 +693,53 @@ func AdminRebuildMutableState(c *cli.Context, clientFactory ClientFactory) error
 	}
 	return nil
 }
+
+// AdminRestoreWorkflowFromArchive imports the archived history of a closed workflow execution back into the cluster
+func AdminRestoreWorkflowFromArchive(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid, err := getRequiredOption(c, FlagRunID)
+	if err != nil {
+		return err
+	}
+
+	// a large history is restored over several calls, each continuing from the token of the previous one
+	var nextPageToken []byte
+	for {
+		resp, err := restoreWorkflowFromArchive(c, adminClient, &adminservice.RestoreWorkflowExecutionFromArchiveRequest{
+			Namespace: namespace,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: wid,
+				RunId:      rid,
+			},
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("restore workflow from archive failed: %s", err)
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	fmt.Println("restore workflow from archive succeeded.")
+	return nil
+}
+
+func restoreWorkflowFromArchive(
+	c *cli.Context,
+	adminClient adminservice.AdminServiceClient,
+	request *adminservice.RestoreWorkflowExecutionFromArchiveRequest,
+) (*adminservice.RestoreWorkflowExecutionFromArchiveResponse, error) {
+	ctx, cancel := newContext(c)
+	defer cancel()
+	return adminClient.RestoreWorkflowExecutionFromArchive(ctx, request)
+}
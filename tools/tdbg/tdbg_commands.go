@@ -219,6 +219,28 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 				return AdminRebuildMutableState(c, clientFactory)
 			},
 		},
+		{
+			Name:    "restore-from-archive",
+			Aliases: []string{},
+			Usage:   "Restore a closed workflow execution from its archived history",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagWorkflowID,
+					Aliases:  FlagWorkflowIDAlias,
+					Usage:    "Workflow ID",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagRunID,
+					Aliases:  FlagRunIDAlias,
+					Usage:    "Run ID",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRestoreWorkflowFromArchive(c, clientFactory)
+			},
+		},
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},